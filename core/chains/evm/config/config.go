@@ -3,7 +3,6 @@ package config
 import (
 	"fmt"
 	"math/big"
-	"os"
 	"sync"
 	"time"
 
@@ -94,6 +93,7 @@ type ChainScopedConfig interface {
 	// Both Configure() and PersistedConfig() should be accessed through ChainSet methods only.
 	Configure(config evmtypes.ChainCfg) error
 	PersistedConfig() evmtypes.ChainCfg
+	Provenance() []config.Provenance
 }
 
 var _ ChainScopedConfig = &chainScopedConfig{}
@@ -1147,7 +1147,7 @@ func (c *chainScopedConfig) NodePollInterval() time.Duration {
 }
 
 func lookupEnv[T any](c *chainScopedConfig, k string, parse func(string) (T, error)) (t T, ok bool) {
-	s, ok := os.LookupEnv(k)
+	s, ok := c.GeneralConfig.LookupEnv(k)
 	if !ok {
 		return
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -276,3 +278,56 @@ func (f fakeChainConfigORM) Clear(chainID utils.Big, key string) error {
 	}
 	return nil
 }
+
+func TestChainScopedConfig_Provenance(t *testing.T) {
+	t.Setenv("ETH_FINALITY_DEPTH", "20")
+
+	chainID := big.NewInt(rand.Int63())
+	gcfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	cfg := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{
+		EvmFinalityDepth:  null.IntFrom(30),
+		EvmGasBumpPercent: null.IntFrom(25),
+	}, make(fakeChainConfigORM), lggr, gcfg)
+
+	ps := make(map[string]config.Provenance)
+	for _, p := range cfg.Provenance() {
+		ps[p.Name] = p
+	}
+
+	assert.Equal(t, config.Provenance{
+		Name:   "ETH_FINALITY_DEPTH",
+		Value:  "20",
+		Source: config.SourceEnv,
+		Shadowed: []config.SourcedValue{
+			{Source: config.SourceDB, Value: "30"},
+			{Source: config.SourceChainDefault, Value: "50"},
+		},
+	}, ps["ETH_FINALITY_DEPTH"])
+
+	assert.Equal(t, config.Provenance{
+		Name:     "ETH_GAS_BUMP_PERCENT",
+		Value:    "25",
+		Source:   config.SourceDB,
+		Shadowed: []config.SourcedValue{{Source: config.SourceChainDefault, Value: "20"}},
+	}, ps["ETH_GAS_BUMP_PERCENT"])
+
+	assert.Equal(t, config.Provenance{
+		Name:     "ETH_GAS_PRICE_DEFAULT",
+		Value:    "20000000000",
+		Source:   config.SourceChainDefault,
+		Shadowed: []config.SourcedValue{},
+	}, ps["ETH_GAS_PRICE_DEFAULT"])
+}
+
+func TestChainScopedConfig_LegacyKeysInConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chainlink.toml")
+	require.NoError(t, os.WriteFile(path, []byte("GAS_UPDATER_BLOCK_DELAY = 7\n"), 0600))
+
+	chainID := big.NewInt(rand.Int63())
+	gcfg := configtest.NewTestGeneralConfig(t)
+	require.NoError(t, gcfg.LoadConfigFile(path))
+	cfg := evmconfig.NewChainScopedConfig(chainID, evmtypes.ChainCfg{}, make(fakeChainConfigORM), logger.TestLogger(t), gcfg)
+
+	assert.Equal(t, uint16(7), cfg.BlockHistoryEstimatorBlockDelay())
+}
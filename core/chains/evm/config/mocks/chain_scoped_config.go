@@ -103,6 +103,20 @@ func (_m *ChainScopedConfig) AllowOrigins() string {
 	return r0
 }
 
+// AuditLogFile provides a mock function with given fields:
+func (_m *ChainScopedConfig) AuditLogFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AppID provides a mock function with given fields:
 func (_m *ChainScopedConfig) AppID() uuid.UUID {
 	ret := _m.Called()
@@ -515,6 +529,20 @@ func (_m *ChainScopedConfig) ClientNodeURL() string {
 	return r0
 }
 
+// ConfigFile provides a mock function with given fields:
+func (_m *ChainScopedConfig) ConfigFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // Configure provides a mock function with given fields: _a0
 func (_m *ChainScopedConfig) Configure(_a0 types.ChainCfg) error {
 	ret := _m.Called(_a0)
@@ -771,6 +799,50 @@ func (_m *ChainScopedConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EthRemoteSignerRetries provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthRemoteSignerRetries() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthRemoteSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthRemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -873,6 +945,20 @@ func (_m *ChainScopedConfig) EthereumURL() string {
 	return r0
 }
 
+// EventsEthTxEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EventsEthTxEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
@@ -2620,6 +2706,20 @@ func (_m *ChainScopedConfig) KeeperBaseFeeBufferPercent() uint32 {
 	return r0
 }
 
+// KeeperCheckUpkeepBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperCheckUpkeepBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	ret := _m.Called()
@@ -2648,6 +2748,20 @@ func (_m *ChainScopedConfig) KeeperDefaultTransactionQueueDepth() uint32 {
 	return r0
 }
 
+// KeeperExecutionQueueSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperExecutionQueueSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // KeeperGasPriceBufferPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeeperGasPriceBufferPercent() uint32 {
 	ret := _m.Called()
@@ -2846,6 +2960,20 @@ func (_m *ChainScopedConfig) LinkContractAddress() string {
 	return r0
 }
 
+// LoadConfigFile provides a mock function with given fields: path
+func (_m *ChainScopedConfig) LoadConfigFile(path string) error {
+	ret := _m.Called(path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // LogFileDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) LogFileDir() string {
 	ret := _m.Called()
@@ -3870,6 +3998,22 @@ func (_m *ChainScopedConfig) Port() uint16 {
 	return r0
 }
 
+// Provenance provides a mock function with given fields:
+func (_m *ChainScopedConfig) Provenance() []coreconfig.Provenance {
+	ret := _m.Called()
+
+	var r0 []coreconfig.Provenance
+	if rf, ok := ret.Get(0).(func() []coreconfig.Provenance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]coreconfig.Provenance)
+		}
+	}
+
+	return r0
+}
+
 // RPID provides a mock function with given fields:
 func (_m *ChainScopedConfig) RPID() string {
 	ret := _m.Called()
@@ -3912,6 +4056,29 @@ func (_m *ChainScopedConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// Reload provides a mock function with given fields:
+func (_m *ChainScopedConfig) Reload() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) RootDir() string {
 	ret := _m.Called()
@@ -4361,3 +4528,19 @@ func (_m *ChainScopedConfig) Validate() error {
 
 	return r0
 }
+
+// ValueSources provides a mock function with given fields: name
+func (_m *ChainScopedConfig) ValueSources(name string) []coreconfig.SourcedValue {
+	ret := _m.Called(name)
+
+	var r0 []coreconfig.SourcedValue
+	if rf, ok := ret.Get(0).(func(string) []coreconfig.SourcedValue); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]coreconfig.SourcedValue)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+)
+
+// chainSetting is a setting that can be overridden per chain. name is both
+// the evmtypes.ChainCfg field and the envvar.ConfigSchema field of the global
+// override.
+type chainSetting struct {
+	name         string
+	value        func(c *chainScopedConfig) interface{}
+	chainDefault func(c *chainScopedConfig) interface{}
+}
+
+var chainSettings = []chainSetting{
+	{"BlockHistoryEstimatorBlockDelay",
+		func(c *chainScopedConfig) interface{} { return c.BlockHistoryEstimatorBlockDelay() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.blockHistoryEstimatorBlockDelay }},
+	{"BlockHistoryEstimatorBlockHistorySize",
+		func(c *chainScopedConfig) interface{} { return c.BlockHistoryEstimatorBlockHistorySize() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.blockHistoryEstimatorBlockHistorySize }},
+	{"BlockHistoryEstimatorEIP1559FeeCapBufferBlocks",
+		func(c *chainScopedConfig) interface{} { return c.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks() },
+		func(c *chainScopedConfig) interface{} {
+			if c.defaultSet.blockHistoryEstimatorEIP1559FeeCapBufferBlocks != nil {
+				return *c.defaultSet.blockHistoryEstimatorEIP1559FeeCapBufferBlocks
+			}
+			return uint16(c.EvmGasBumpThreshold() + 1)
+		}},
+	{"ChainType",
+		func(c *chainScopedConfig) interface{} { return c.ChainType() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.chainType }},
+	{"EthTxReaperThreshold",
+		func(c *chainScopedConfig) interface{} { return c.EthTxReaperThreshold() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.ethTxReaperThreshold }},
+	{"EthTxResendAfterThreshold",
+		func(c *chainScopedConfig) interface{} { return c.EthTxResendAfterThreshold() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.ethTxResendAfterThreshold }},
+	{"EvmEIP1559DynamicFees",
+		func(c *chainScopedConfig) interface{} { return c.EvmEIP1559DynamicFees() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.eip1559DynamicFees }},
+	{"EvmFinalityDepth",
+		func(c *chainScopedConfig) interface{} { return c.EvmFinalityDepth() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.finalityDepth }},
+	{"EvmGasBumpPercent",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasBumpPercent() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.gasBumpPercent }},
+	{"EvmGasBumpTxDepth",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasBumpTxDepth() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.gasBumpTxDepth }},
+	{"EvmGasBumpWei",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasBumpWei() },
+		func(c *chainScopedConfig) interface{} { return &c.defaultSet.gasBumpWei }},
+	{"EvmGasFeeCapDefault",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasFeeCapDefault() },
+		func(c *chainScopedConfig) interface{} { return &c.defaultSet.gasFeeCapDefault }},
+	{"EvmGasLimitDefault",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasLimitDefault() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.gasLimitDefault }},
+	{"EvmGasLimitMultiplier",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasLimitMultiplier() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.gasLimitMultiplier }},
+	{"EvmGasPriceDefault",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasPriceDefault() },
+		func(c *chainScopedConfig) interface{} { return &c.defaultSet.gasPriceDefault }},
+	{"EvmGasTipCapDefault",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasTipCapDefault() },
+		func(c *chainScopedConfig) interface{} { return &c.defaultSet.gasTipCapDefault }},
+	{"EvmGasTipCapMinimum",
+		func(c *chainScopedConfig) interface{} { return c.EvmGasTipCapMinimum() },
+		func(c *chainScopedConfig) interface{} { return &c.defaultSet.gasTipCapMinimum }},
+	{"EvmHeadTrackerHistoryDepth",
+		func(c *chainScopedConfig) interface{} { return c.EvmHeadTrackerHistoryDepth() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.headTrackerHistoryDepth }},
+	{"EvmHeadTrackerMaxBufferSize",
+		func(c *chainScopedConfig) interface{} { return c.EvmHeadTrackerMaxBufferSize() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.headTrackerMaxBufferSize }},
+	{"EvmHeadTrackerSamplingInterval",
+		func(c *chainScopedConfig) interface{} { return c.EvmHeadTrackerSamplingInterval() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.headTrackerSamplingInterval }},
+	{"EvmLogBackfillBatchSize",
+		func(c *chainScopedConfig) interface{} { return c.EvmLogBackfillBatchSize() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.logBackfillBatchSize }},
+	{"EvmLogPollInterval",
+		func(c *chainScopedConfig) interface{} { return c.EvmLogPollInterval() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.logPollInterval }},
+	{"EvmMaxGasPriceWei",
+		func(c *chainScopedConfig) interface{} { return c.EvmMaxGasPriceWei() },
+		func(c *chainScopedConfig) interface{} { return &c.defaultSet.maxGasPriceWei }},
+	{"EvmNonceAutoSync",
+		func(c *chainScopedConfig) interface{} { return c.EvmNonceAutoSync() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.nonceAutoSync }},
+	{"EvmUseForwarders",
+		func(c *chainScopedConfig) interface{} { return c.EvmUseForwarders() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.useForwarders }},
+	{"EvmRPCDefaultBatchSize",
+		func(c *chainScopedConfig) interface{} { return c.EvmRPCDefaultBatchSize() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.rpcDefaultBatchSize }},
+	{"FlagsContractAddress",
+		func(c *chainScopedConfig) interface{} { return c.FlagsContractAddress() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.flagsContractAddress }},
+	{"GasEstimatorMode",
+		func(c *chainScopedConfig) interface{} { return c.GasEstimatorMode() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.gasEstimatorMode }},
+	{"LinkContractAddress",
+		func(c *chainScopedConfig) interface{} { return c.LinkContractAddress() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.linkContractAddress }},
+	{"MinIncomingConfirmations",
+		func(c *chainScopedConfig) interface{} { return c.MinIncomingConfirmations() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.minIncomingConfirmations }},
+	{"MinRequiredOutgoingConfirmations",
+		func(c *chainScopedConfig) interface{} { return c.MinRequiredOutgoingConfirmations() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.minRequiredOutgoingConfirmations }},
+	{"MinimumContractPayment",
+		func(c *chainScopedConfig) interface{} { return c.MinimumContractPayment() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.minimumContractPayment }},
+	{"NodeNoNewHeadsThreshold",
+		func(c *chainScopedConfig) interface{} { return c.NodeNoNewHeadsThreshold() },
+		func(c *chainScopedConfig) interface{} { return c.defaultSet.nodeDeadAfterNoNewHeadersThreshold }},
+}
+
+// Provenance returns the effective value of each setting that can be
+// overridden per chain, along with its source: a global override, the
+// chain's persisted config, or the chain-specific default.
+func (c *chainScopedConfig) Provenance() (ps []config.Provenance) {
+	c.persistMu.RLock()
+	persisted := c.persistedCfg
+	c.persistMu.RUnlock()
+
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	for _, s := range chainSettings {
+		var sources []config.SourcedValue
+		name := s.name
+		if schemaItem, ok := schemaT.FieldByName(s.name); ok {
+			name = schemaItem.Tag.Get("env")
+			for _, sv := range c.GeneralConfig.ValueSources(name) {
+				// Global defaults do not apply to chain scoped settings
+				if sv.Source != config.SourceDefault {
+					sources = append(sources, sv)
+				}
+			}
+		}
+		if v, ok := persistedValue(persisted, s.name); ok {
+			sources = append(sources, config.SourcedValue{Source: config.SourceDB, Value: v})
+		}
+		sources = append(sources, config.SourcedValue{Source: config.SourceChainDefault, Value: config.FormatValue(s.chainDefault(c))})
+
+		ps = append(ps, config.NewProvenance(name, config.FormatValue(s.value(c)), sources))
+	}
+	return
+}
+
+// persistedValue returns the value of field in cfg, if set.
+func persistedValue(cfg evmtypes.ChainCfg, field string) (string, bool) {
+	f := reflect.ValueOf(cfg).FieldByName(field)
+	if !f.IsValid() {
+		return "", false
+	}
+	b, err := json.Marshal(f.Interface())
+	if err != nil || string(b) == "null" {
+		return "", false
+	}
+	if s, err := strconv.Unquote(string(b)); err == nil {
+		return s, true
+	}
+	return string(b), true
+}
@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -131,6 +133,42 @@ func SetupNodes(db *sqlx.DB, cfg LegacyEthNodeConfig, lggr logger.Logger) (err e
 	return orm.SetupNodes(nodes, uniqueIDs(nodes))
 }
 
+// ClobberDBFromConfigFile writes chains and nodes loaded from a config file to
+// the database. Each chain is created if missing, and its enabled flag and
+// config are overwritten. If any nodes are given, they replace all existing
+// nodes, as with EVM_NODES.
+func ClobberDBFromConfigFile(db *sqlx.DB, chains []evmtypes.Chain, nodes []evmtypes.Node, cfg pg.LogConfig, lggr logger.Logger) error {
+	orm := NewORM(db, lggr, cfg)
+
+	if len(nodes) > 0 {
+		sort.Slice(nodes, func(i, j int) bool {
+			return nodes[i].Name < nodes[j].Name
+		})
+		ids := make([]utils.Big, len(chains))
+		for i, c := range chains {
+			ids[i] = c.ID
+		}
+		lggr.Info("EVM nodes were set in the config file; clobbering evm_nodes table")
+		if err := orm.SetupNodes(nodes, ids); err != nil {
+			return errors.Wrap(err, "failed to setup EVM nodes")
+		}
+	}
+
+	for _, c := range chains {
+		if _, err := orm.Chain(c.ID); errors.Is(err, sql.ErrNoRows) {
+			if _, err = orm.CreateChain(c.ID, c.Cfg); err != nil {
+				return errors.Wrapf(err, "failed to create chain %s", c.ID.String())
+			}
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to load chain %s", c.ID.String())
+		}
+		if _, err := orm.UpdateChain(c.ID, c.Enabled, c.Cfg); err != nil {
+			return errors.Wrapf(err, "failed to update chain %s", c.ID.String())
+		}
+	}
+	return nil
+}
+
 func uniqueIDs(ns []evmtypes.Node) (ids []utils.Big) {
 	m := make(map[string]struct{})
 	for _, n := range ns {
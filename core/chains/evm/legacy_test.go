@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type legacyEthNodeConfig struct {
@@ -205,3 +207,44 @@ func TestSetupNodes(t *testing.T) {
 	assert.Equal(t, "sendonly_1337_1", nodes[5].Name)
 	assert.Equal(t, "sendonly_43_1", nodes[6].Name)
 }
+
+func TestClobberDBFromConfigFile(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := legacyEthNodeConfig{}
+
+	// Insert existing node which will be erased
+	pgtest.MustExec(t, db, `INSERT INTO evm_nodes (name, evm_chain_id, ws_url, http_url, send_only, created_at, updated_at) VALUES ('foo','0','ws://example.com',null,false,NOW(),NOW())`)
+
+	chains := []evmtypes.Chain{
+		{ID: *utils.NewBigI(0), Enabled: false, Cfg: evmtypes.ChainCfg{EvmFinalityDepth: null.IntFrom(50)}},
+		{ID: *utils.NewBigI(1337), Enabled: true},
+	}
+	nodes := []evmtypes.Node{
+		{Name: "primary_1337_1", EVMChainID: *utils.NewBigI(1337), WSURL: null.StringFrom("ws://test1.invalid")},
+		{Name: "sendonly_1337_1", EVMChainID: *utils.NewBigI(1337), HTTPURL: null.StringFrom("http://test2.invalid"), SendOnly: true},
+	}
+
+	err := evm.ClobberDBFromConfigFile(db, chains, nodes, cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	cltest.AssertCount(t, db, "evm_nodes", 2)
+
+	orm := evm.NewORM(db, logger.TestLogger(t), cfg)
+	chain, err := orm.Chain(*utils.NewBigI(0))
+	require.NoError(t, err)
+	assert.False(t, chain.Enabled)
+	assert.Equal(t, int64(50), chain.Cfg.EvmFinalityDepth.Int64)
+
+	chain, err = orm.Chain(*utils.NewBigI(1337))
+	require.NoError(t, err)
+	assert.True(t, chain.Enabled)
+
+	// Without nodes, existing nodes are kept
+	chains = []evmtypes.Chain{{ID: *utils.NewBigI(42), Enabled: true}}
+	err = evm.ClobberDBFromConfigFile(db, chains, nil, cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	cltest.AssertCount(t, db, "evm_nodes", 2)
+	_, err = orm.Chain(*utils.NewBigI(42))
+	require.NoError(t, err)
+}
@@ -570,7 +570,9 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []evmtypes.Receipt) (err er
 	//
 	var valueStrs []string
 	var valueArgs []interface{}
-	for _, r := range receipts {
+	hashes := make([]gethCommon.Hash, len(receipts))
+	for i, r := range receipts {
+		hashes[i] = r.TxHash
 		var receiptJSON []byte
 		receiptJSON, err = json.Marshal(r)
 		if err != nil {
@@ -612,8 +614,13 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []evmtypes.Receipt) (err er
 
 	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
 
-	err = ec.q.ExecQ(stmt, valueArgs...)
-	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	err = ec.q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(stmt, valueArgs...); err != nil {
+			return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+		}
+		return errors.Wrap(insertEthKeyUsage(tx, hashes), "saveFetchedReceipts failed to save receipts")
+	})
+	return err
 }
 
 // markAllConfirmedMissingReceipt
@@ -1401,7 +1408,12 @@ USING eth_tx_attempts
 WHERE eth_receipts.tx_hash = eth_tx_attempts.hash
 AND eth_tx_attempts.eth_tx_id = $1
 	`, etxID)
-	return errors.Wrap(err, "deleteAllReceipts failed")
+	if err != nil {
+		return errors.Wrap(err, "deleteAllReceipts failed")
+	}
+	// the transaction is no longer mined, so it has not used any gas yet
+	_, err = q.Exec(`DELETE FROM eth_key_usage WHERE eth_tx_id = $1`, etxID)
+	return errors.Wrap(err, "deleteAllReceipts failed to delete eth key usage")
 }
 
 func unconfirmEthTx(q pg.Queryer, etx EthTx) error {
@@ -323,6 +323,15 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 
 		assert.JSONEq(t, string(receiptJSON), string(ethReceipt.Receipt))
 
+		// and its usage
+		var usage struct {
+			EthTxID int64 `db:"eth_tx_id"`
+			GasUsed int64 `db:"gas_used"`
+		}
+		require.NoError(t, db.Get(&usage, `SELECT eth_tx_id, gas_used FROM eth_key_usage WHERE tx_hash = $1`, attempt1_1.Hash))
+		assert.Equal(t, etx1.ID, usage.EthTxID)
+		assert.Equal(t, int64(txmReceipt.GasUsed), usage.GasUsed)
+
 		ethClient.AssertExpectations(t)
 	})
 
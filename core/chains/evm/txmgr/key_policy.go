@@ -0,0 +1,214 @@
+package txmgr
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgconn"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeyPolicy limits the transactions which an eth sending key can create
+type KeyPolicy struct {
+	Address common.Address
+	// MaxTxValue is the maximum value of a transaction, or unlimited if nil
+	MaxTxValue *assets.Eth
+	// MaxDailyGasSpend is the maximum amount the key can spend on gas over
+	// the last 24 hours, or unlimited if nil
+	MaxDailyGasSpend *assets.Eth
+	// AllowedDestinations are the only addresses the key can send
+	// transactions to, or any address if empty
+	AllowedDestinations []common.Address
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// keyPolicyRow is a helper type for reading and writing key policies, since
+// the bytea[] of allowed destinations is not convertible to []common.Address
+type keyPolicyRow struct {
+	*KeyPolicy
+	AllowedDestinations pq.ByteaArray
+}
+
+func toKeyPolicyRow(policy *KeyPolicy) keyPolicyRow {
+	destinations := make(pq.ByteaArray, len(policy.AllowedDestinations))
+	for i, a := range policy.AllowedDestinations {
+		destinations[i] = a.Bytes()
+	}
+	return keyPolicyRow{KeyPolicy: policy, AllowedDestinations: destinations}
+}
+
+func (r keyPolicyRow) toKeyPolicy() KeyPolicy {
+	r.KeyPolicy.AllowedDestinations = nil
+	for _, a := range r.AllowedDestinations {
+		r.KeyPolicy.AllowedDestinations = append(r.KeyPolicy.AllowedDestinations, common.BytesToAddress(a))
+	}
+	return *r.KeyPolicy
+}
+
+// KeyPolicyViolationError is returned when a transaction is rejected by the
+// policy of its sending key
+type KeyPolicyViolationError struct {
+	Address common.Address
+	Reason  string
+}
+
+func (e *KeyPolicyViolationError) Error() string {
+	return fmt.Sprintf("transaction rejected by the policy of key %s: %s", e.Address.Hex(), e.Reason)
+}
+
+// FindKeyPolicy returns the policy of the key with address, or nil if it has
+// none
+func (o *orm) FindKeyPolicy(address common.Address) (*KeyPolicy, error) {
+	return findKeyPolicy(o.q, address)
+}
+
+// UpsertKeyPolicy sets the policy of a key, replacing its previous policy
+func (o *orm) UpsertKeyPolicy(policy *KeyPolicy) error {
+	row := toKeyPolicyRow(policy)
+	sql := `INSERT INTO eth_key_policies (address, max_tx_value, max_daily_gas_spend, allowed_destinations, created_at, updated_at)
+VALUES (:address, :max_tx_value, :max_daily_gas_spend, :allowed_destinations, NOW(), NOW())
+ON CONFLICT (address) DO UPDATE SET
+max_tx_value = EXCLUDED.max_tx_value,
+max_daily_gas_spend = EXCLUDED.max_daily_gas_spend,
+allowed_destinations = EXCLUDED.allowed_destinations,
+updated_at = EXCLUDED.updated_at
+RETURNING *`
+	err := o.q.GetNamed(sql, &row, row)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return errors.Errorf("no eth key exists with address %s", policy.Address.Hex())
+		}
+		return errors.Wrap(err, "UpsertKeyPolicy failed")
+	}
+	*policy = row.toKeyPolicy()
+	return nil
+}
+
+// DeleteKeyPolicy removes the policy of a key, so that it is unlimited
+func (o *orm) DeleteKeyPolicy(address common.Address) error {
+	_, err := o.q.Exec(`DELETE FROM eth_key_policies WHERE address = $1`, address)
+	return errors.Wrap(err, "DeleteKeyPolicy failed")
+}
+
+func findKeyPolicy(q pg.Queryer, address common.Address) (*KeyPolicy, error) {
+	row := keyPolicyRow{KeyPolicy: &KeyPolicy{}}
+	err := q.Get(&row, `SELECT * FROM eth_key_policies WHERE address = $1`, address)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to load key policy")
+	}
+	policy := row.toKeyPolicy()
+	return &policy, nil
+}
+
+// dailyGasSpend returns the amount the key with address spent on gas in the
+// last 24 hours, including the transactions it has not broadcast yet. The
+// spend of a transaction is the gas used by its receipt, or its gas limit until
+// it has one, times the gas price or fee cap of the attempt, which is an upper
+// bound of the actual fee of dynamic fee transactions. Transactions without an
+// attempt are counted at maxGasPrice.
+func dailyGasSpend(q pg.Queryer, address common.Address, chainID string, maxGasPrice *big.Int) (*assets.Eth, error) {
+	var spend assets.Eth
+	err := q.Get(&spend, `
+SELECT COALESCE(SUM(
+	COALESCE(('x' || lpad(substr(a.receipt->>'gasUsed', 3), 16, '0'))::bit(64)::bigint, eth_txes.gas_limit)
+	* COALESCE(a.gas_price, a.gas_fee_cap, $3)
+), 0)
+FROM eth_txes
+LEFT JOIN LATERAL (
+	SELECT eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap, eth_receipts.receipt
+	FROM eth_tx_attempts
+	LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
+	ORDER BY eth_receipts.id IS NULL, eth_tx_attempts.id DESC
+	LIMIT 1
+) a ON true
+WHERE eth_txes.from_address = $1 AND eth_txes.evm_chain_id = $2
+AND (eth_txes.broadcast_at > NOW() - interval '24 hours' OR (eth_txes.broadcast_at IS NULL AND eth_txes.state IN ('unstarted', 'in_progress')))
+`, address, chainID, utils.NewBig(maxGasPrice))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute daily gas spend")
+	}
+	return &spend, nil
+}
+
+// checkKeyPolicy returns a KeyPolicyViolationError if the policy of the key
+// with address does not allow a transaction to the given address with value
+// and gasLimit. q must be a transaction which the new transaction is inserted
+// in, since the key is locked until it ends so that concurrent transactions
+// cannot exceed the daily gas spend together.
+func (b *Txm) checkKeyPolicy(q pg.Queryer, from, to common.Address, value assets.Eth, gasLimit uint64) error {
+	policy, err := findKeyPolicy(q, from)
+	if err != nil || policy == nil {
+		return err
+	}
+	if len(policy.AllowedDestinations) > 0 {
+		var allowed bool
+		for _, a := range policy.AllowedDestinations {
+			if a == to {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return &KeyPolicyViolationError{from, fmt.Sprintf("destination %s is not allowed", to.Hex())}
+		}
+	}
+	if policy.MaxTxValue != nil && value.Cmp(policy.MaxTxValue) > 0 {
+		return &KeyPolicyViolationError{from, fmt.Sprintf("value %s exceeds the maximum of %s per transaction", value.String(), policy.MaxTxValue.String())}
+	}
+	if policy.MaxDailyGasSpend != nil {
+		if _, err = q.Exec(`SELECT 1 FROM eth_key_states WHERE address = $1 FOR UPDATE`, from); err != nil {
+			return errors.Wrap(err, "failed to lock key state")
+		}
+		maxGasPrice := b.config.EvmMaxGasPriceWei()
+		spend, err := dailyGasSpend(q, from, b.chainID.String(), maxGasPrice)
+		if err != nil {
+			return err
+		}
+		txSpend := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), maxGasPrice)
+		total := (*assets.Eth)(new(big.Int).Add(spend.ToInt(), txSpend))
+		if total.Cmp(policy.MaxDailyGasSpend) > 0 {
+			return &KeyPolicyViolationError{from, fmt.Sprintf("gas spend of %s in the last 24 hours and up to %s for this transaction exceeds the maximum of %s", spend.String(), (*assets.Eth)(txSpend).String(), policy.MaxDailyGasSpend.String())}
+		}
+	}
+	return nil
+}
+
+// reportKeyPolicyViolation logs a rejected transaction, and records it as an
+// error of the job which created it, if any
+func (b *Txm) reportKeyPolicyViolation(err error, to common.Address, value assets.Eth, meta *EthTxMeta) {
+	var violation *KeyPolicyViolationError
+	if !errors.As(err, &violation) {
+		return
+	}
+	var jobID int32
+	if meta != nil {
+		jobID = meta.JobID
+	}
+	b.logger.Errorw("Transaction rejected by key policy", "fromAddress", violation.Address, "toAddress", to, "value", value.String(), "jobID", jobID, "reason", violation.Reason)
+	if jobID == 0 {
+		return
+	}
+	// the same as job.ORM.RecordError, which cannot be used here since the
+	// jobs depend on the chains
+	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
+VALUES ($1, $2, 1, NOW(), NOW())
+ON CONFLICT (job_id, description) DO UPDATE SET
+occurrences = job_spec_errors.occurrences + 1,
+updated_at = excluded.updated_at`
+	if _, err := b.q.Exec(sql, jobID, violation.Error()); err != nil {
+		b.logger.Errorw("Failed to record key policy violation as job error", "jobID", jobID, "err", err)
+	}
+}
@@ -0,0 +1,125 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestTxm_KeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+	allowed := testutils.NewAddress()
+	jb := cltest.MustInsertV2JobSpec(t, db, fromAddress)
+
+	config := newMockConfig(t)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("LogSQL").Return(false)
+	config.On("EvmMaxQueuedTransactions").Return(uint64(0))
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, logger.TestLogger(t), &testCheckerFactory{})
+
+	createEthTransaction := func(to common.Address) error {
+		strategy := newMockTxStrategy(t)
+		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("PruneQueue", mock.Anything).Return(int64(0), nil)
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress: fromAddress,
+			ToAddress:   to,
+			GasLimit:    21000,
+			Meta:        &txmgr.EthTxMeta{JobID: jb.ID},
+			Strategy:    strategy,
+		})
+		return err
+	}
+
+	err := borm.UpsertKeyPolicy(&txmgr.KeyPolicy{Address: testutils.NewAddress()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no eth key exists with address")
+
+	policy := txmgr.KeyPolicy{
+		Address:             fromAddress,
+		MaxTxValue:          assets.NewEth(100),
+		AllowedDestinations: []common.Address{allowed},
+	}
+	require.NoError(t, borm.UpsertKeyPolicy(&policy))
+	found, err := borm.FindKeyPolicy(fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{allowed}, found.AllowedDestinations)
+	assert.Equal(t, assets.NewEth(100), found.MaxTxValue)
+	assert.Nil(t, found.MaxDailyGasSpend)
+
+	t.Run("rejects destinations which are not allowed", func(t *testing.T) {
+		err := createEthTransaction(testutils.NewAddress())
+		var violation *txmgr.KeyPolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		assert.Contains(t, violation.Reason, "is not allowed")
+		cltest.AssertCount(t, db, "job_spec_errors", 1)
+
+		_, err = txm.SendEther(&cltest.FixtureChainID, fromAddress, testutils.NewAddress(), *assets.NewEth(1), 21000)
+		require.ErrorAs(t, err, &violation)
+
+		require.NoError(t, createEthTransaction(allowed))
+	})
+
+	t.Run("rejects values above the maximum", func(t *testing.T) {
+		_, err := txm.SendEther(&cltest.FixtureChainID, fromAddress, allowed, *assets.NewEth(101), 21000)
+		var violation *txmgr.KeyPolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		assert.Contains(t, violation.Reason, "exceeds the maximum")
+
+		_, err = txm.SendEther(&cltest.FixtureChainID, fromAddress, allowed, *assets.NewEth(100), 21000)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects transactions which would exceed the daily gas spend", func(t *testing.T) {
+		// 2 unstarted transactions of 21000 gas from the tests above, at 1 gwei
+		config.On("EvmMaxGasPriceWei").Return(assets.GWei(1))
+		policy.MaxDailyGasSpend = assets.NewEth(105_001_000_000_000)
+		require.NoError(t, borm.UpsertKeyPolicy(&policy))
+		require.NoError(t, createEthTransaction(allowed))
+
+		// broadcast with a gas price of 1 wei, and the spend of a day ago is not counted
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress, time.Now().Add(-25*time.Hour), big.NewInt(0).Set(&cltest.FixtureChainID))
+		require.NoError(t, createEthTransaction(allowed))
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+
+		// reaches the maximum exactly
+		require.NoError(t, createEthTransaction(allowed))
+
+		err := createEthTransaction(allowed)
+		var violation *txmgr.KeyPolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		assert.Contains(t, violation.Reason, "exceeds the maximum of")
+
+		_, err = txm.SendEther(&cltest.FixtureChainID, fromAddress, allowed, *assets.NewEth(1), 21000)
+		require.ErrorAs(t, err, &violation)
+	})
+
+	require.NoError(t, borm.DeleteKeyPolicy(fromAddress))
+	found, err = borm.FindKeyPolicy(fromAddress)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	require.NoError(t, createEthTransaction(testutils.NewAddress()))
+}
@@ -0,0 +1,58 @@
+package txmgr
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// insertEthKeyUsageSQL records the gas used and the fee paid by eth_txes with
+// a receipt in eth_key_usage, which keeps them after the eth_txes are reaped.
+// A transaction is attributed to the job in its meta, the job whose external
+// job ID is its subject, or the job whose pipeline run created it. The fee of
+// a receipt without an effective gas price is the gas price of its attempt, or
+// the fee cap of dynamic fee attempts, in which case it is estimated.
+const insertEthKeyUsageSQL = `
+INSERT INTO eth_key_usage (eth_tx_id, tx_hash, from_address, to_address, value, nonce, evm_chain_id, subject, job_id, job_name, gas_used, effective_gas_price, fee_estimated, broadcast_at, created_at)
+SELECT DISTINCT ON (eth_txes.id) eth_txes.id, eth_tx_attempts.hash, eth_txes.from_address, eth_txes.to_address, eth_txes.value, eth_txes.nonce, eth_txes.evm_chain_id, eth_txes.subject, j.id, j.name,
+	r.gas_used, COALESCE(r.effective_gas_price, eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap),
+	r.effective_gas_price IS NULL AND eth_tx_attempts.gas_price IS NULL,
+	COALESCE(eth_txes.broadcast_at, eth_txes.created_at), NOW()
+FROM eth_receipts
+JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+CROSS JOIN LATERAL (
+	SELECT ('x' || lpad(substr(eth_receipts.receipt->>'gasUsed', 3), 16, '0'))::bit(64)::bigint AS gas_used,
+		('x' || lpad(substr(eth_receipts.receipt->>'effectiveGasPrice', 3), 16, '0'))::bit(64)::bigint::numeric AS effective_gas_price
+) r
+LEFT JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+LEFT JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+LEFT JOIN LATERAL (
+	SELECT jobs.id, jobs.name FROM jobs
+	WHERE jobs.id = (eth_txes.meta->>'JobID')::int
+	OR jobs.external_job_id = eth_txes.subject
+	OR jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+	ORDER BY jobs.id
+	LIMIT 1
+) j ON true
+WHERE eth_receipts.tx_hash = ANY($1)
+ORDER BY eth_txes.id, eth_receipts.block_number DESC
+ON CONFLICT (eth_tx_id) DO UPDATE SET
+tx_hash = EXCLUDED.tx_hash,
+gas_used = EXCLUDED.gas_used,
+effective_gas_price = EXCLUDED.effective_gas_price,
+fee_estimated = EXCLUDED.fee_estimated
+`
+
+// insertEthKeyUsage records the usage of the eth_txes with a receipt for one
+// of hashes
+func insertEthKeyUsage(q pg.Queryer, hashes []common.Hash) error {
+	arr := make(pq.ByteaArray, len(hashes))
+	for i, h := range hashes {
+		arr[i] = h.Bytes()
+	}
+	_, err := q.Exec(insertEthKeyUsageSQL, arr)
+	return errors.Wrap(err, "failed to record eth key usage")
+}
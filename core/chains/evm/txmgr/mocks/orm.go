@@ -14,6 +14,20 @@ type ORM struct {
 	mock.Mock
 }
 
+// DeleteKeyPolicy provides a mock function with given fields: address
+func (_m *ORM) DeleteKeyPolicy(address common.Address) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EthTransactions provides a mock function with given fields: offset, limit
 func (_m *ORM) EthTransactions(offset int, limit int) ([]txmgr.EthTx, int, error) {
 	ret := _m.Called(offset, limit)
@@ -194,6 +208,29 @@ func (_m *ORM) FindEthTxWithAttempts(etxID int64) (txmgr.EthTx, error) {
 	return r0, r1
 }
 
+// FindKeyPolicy provides a mock function with given fields: address
+func (_m *ORM) FindKeyPolicy(address common.Address) (*txmgr.KeyPolicy, error) {
+	ret := _m.Called(address)
+
+	var r0 *txmgr.KeyPolicy
+	if rf, ok := ret.Get(0).(func(common.Address) *txmgr.KeyPolicy); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txmgr.KeyPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertEthReceipt provides a mock function with given fields: receipt
 func (_m *ORM) InsertEthReceipt(receipt *txmgr.EthReceipt) error {
 	ret := _m.Called(receipt)
@@ -235,3 +272,17 @@ func (_m *ORM) InsertEthTxAttempt(attempt *txmgr.EthTxAttempt) error {
 
 	return r0
 }
+
+// UpsertKeyPolicy provides a mock function with given fields: policy
+func (_m *ORM) UpsertKeyPolicy(policy *txmgr.KeyPolicy) error {
+	ret := _m.Called(policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*txmgr.KeyPolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -41,7 +41,7 @@ type EthTxMeta struct {
 	SubID *uint64 `json:"SubId,omitempty"`
 
 	// Used for keepers
-	UpkeepID *int64 `json:"UpkeepID,omitempty"`
+	UpkeepID *utils.Big `json:"UpkeepID,omitempty"`
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -225,7 +225,7 @@ func (e EthTx) GetLogger(lgr logger.Logger) logger.Logger {
 		)
 
 		if meta.UpkeepID != nil {
-			lgr = lgr.With("upkeepID", *meta.UpkeepID)
+			lgr = lgr.With("upkeepID", meta.UpkeepID.String())
 		}
 
 		if meta.SubID != nil {
@@ -25,6 +25,10 @@ type ORM interface {
 	InsertEthTx(etx *EthTx) error
 	InsertEthReceipt(receipt *EthReceipt) error
 	FindEthTxWithAttempts(etxID int64) (etx EthTx, err error)
+
+	FindKeyPolicy(address common.Address) (*KeyPolicy, error)
+	UpsertKeyPolicy(policy *KeyPolicy) error
+	DeleteKeyPolicy(address common.Address) error
 }
 
 type orm struct {
@@ -211,7 +215,16 @@ func (o *orm) InsertEthReceipt(receipt *EthReceipt) error {
 	const insertEthReceiptSQL = `INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at) VALUES (
 :tx_hash, :block_hash, :block_number, :transaction_index, :receipt, NOW()
 ) RETURNING *`
-	err := o.q.GetNamed(insertEthReceiptSQL, receipt, receipt)
+	err := o.q.Transaction(func(tx pg.Queryer) error {
+		query, args, e := tx.BindNamed(insertEthReceiptSQL, receipt)
+		if e != nil {
+			return e
+		}
+		if e = tx.Get(receipt, query, args...); e != nil {
+			return e
+		}
+		return insertEthKeyUsage(tx, []common.Hash{receipt.TxHash})
+	})
 	return errors.Wrap(err, "InsertEthReceipt failed")
 }
 
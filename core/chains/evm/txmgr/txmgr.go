@@ -322,6 +322,9 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 		if err = b.checkStateExists(tx, newTx.FromAddress); err != nil {
 			return err
 		}
+		if err = b.checkKeyPolicy(tx, newTx.FromAddress, newTx.ToAddress, assets.NewEthValue(0), newTx.GasLimit); err != nil {
+			return err
+		}
 		err := tx.Get(&etx, `
 INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
 VALUES (
@@ -342,6 +345,7 @@ RETURNING "eth_txes".*
 		}
 		return nil
 	})
+	b.reportKeyPolicyViolation(err, newTx.ToAddress, assets.NewEthValue(0), newTx.Meta)
 	return
 }
 
@@ -381,8 +385,18 @@ func (b *Txm) SendEther(chainID *big.Int, from, to common.Address, value assets.
 	query := `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, evm_chain_id, created_at) VALUES (
 :from_address, :to_address, :encoded_payload, :value, :gas_limit, :state, :evm_chain_id, NOW()
 ) RETURNING eth_txes.*`
-	err = b.q.GetNamed(query, &etx, etx)
-	return etx, errors.Wrap(err, "SendEther failed to insert eth_tx")
+	err = b.q.Transaction(func(tx pg.Queryer) error {
+		if err := b.checkKeyPolicy(tx, from, to, value, gasLimit); err != nil {
+			return errors.Wrap(err, "SendEther failed")
+		}
+		insertQuery, args, e := tx.BindNamed(query, etx)
+		if e != nil {
+			return errors.Wrap(e, "SendEther failed to BindNamed")
+		}
+		return errors.Wrap(tx.Get(&etx, insertQuery, args...), "SendEther failed to insert eth_tx")
+	})
+	b.reportKeyPolicyViolation(err, to, value, nil)
+	return etx, err
 }
 
 type ChainKeyStore struct {
@@ -267,6 +267,24 @@ func TestTxReceipt_ReceiptIndicatesRunLogFulfillment(t *testing.T) {
 	}
 }
 
+func TestReceipt_EffectiveGasPrice(t *testing.T) {
+	t.Parallel()
+
+	var receipt evmtypes.Receipt
+	require.NoError(t, json.Unmarshal([]byte(`{"transactionHash":"0x1c7d4d39cb6d8a9e2c0c1b2d1b8f6d7e4f0f2d8a8c1b0e6f9a7c3b2d1e0f9a8b","gasUsed":"0x5208","effectiveGasPrice":"0x3b9aca00"}`), &receipt))
+	assert.Equal(t, uint64(21000), receipt.GasUsed)
+	assert.Equal(t, big.NewInt(1000000000), receipt.EffectiveGasPrice)
+
+	b, err := json.Marshal(receipt)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"effectiveGasPrice":"0x3b9aca00"`)
+
+	receipt.EffectiveGasPrice = nil
+	b, err = json.Marshal(receipt)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "effectiveGasPrice")
+}
+
 func TestHead_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type AdminUsersPresenter struct {
+	JAID
+	presenters.UserResource
+}
+
+var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Created at"}
+
+func (p *AdminUsersPresenter) ToRow() []string {
+	row := []string{
+		p.ID,
+		string(p.Role),
+		strconv.FormatBool(p.HasAPIToken),
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminUsersPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(adminUsersTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminUsersPresenters []AdminUsersPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminUsersPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Users\n")); err != nil {
+		return err
+	}
+	renderList(adminUsersTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListUsers renders all API users and their roles
+func (cli *Client) ListUsers(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/users")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &AdminUsersPresenters{})
+}
+
+// CreateUser creates a new user by prompting for email, password, and role
+func (cli *Client) CreateUser(c *cli.Context) (err error) {
+	if !c.IsSet("email") {
+		return cli.errorOut(errors.New("must specify the --email of the new user"))
+	}
+	role, err := sessions.GetUserRole(c.String("role"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreateUserRequest{
+		Email:    c.String("email"),
+		Password: cli.PasswordPrompter.Prompt(),
+		Role:     string(role),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/users", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &AdminUsersPresenter{}, "Successfully created new API user")
+}
+
+// ChangeRole can change a user's role
+func (cli *Client) ChangeRole(c *cli.Context) (err error) {
+	if !c.IsSet("email") {
+		return cli.errorOut(errors.New("must specify the --email of the user"))
+	}
+	role, err := sessions.GetUserRole(c.String("new-role"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateUserRoleRequest{
+		Email:   c.String("email"),
+		NewRole: string(role),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/users", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &AdminUsersPresenter{}, "Successfully updated API user")
+}
+
+// DeleteUser deletes an API user by email
+func (cli *Client) DeleteUser(c *cli.Context) (err error) {
+	if !c.IsSet("email") {
+		return cli.errorOut(errors.New("must specify the --email of the user to delete"))
+	}
+	email := c.String("email")
+
+	resp, err := cli.HTTP.Delete("/v2/users/" + url.PathEscape(email))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Printf("Deleted API user %s\n", email)
+	return nil
+}
+
+type AuditEntryPresenter struct {
+	JAID
+	presenters.AuditEntryResource
+}
+
+var auditEntryTableHeaders = []string{"ID", "Time", "Actor", "Source IP", "Action", "Resource", "Status", "Params"}
+
+// actor returns the user, and API token if any, or external initiator which
+// took the action
+func (p *AuditEntryPresenter) actor() string {
+	switch {
+	case p.UserEmail.Valid && p.APITokenKey.Valid:
+		return fmt.Sprintf("%s (token %s)", p.UserEmail.String, p.APITokenKey.String)
+	case p.UserEmail.Valid:
+		return p.UserEmail.String
+	case p.ExternalInitiator.Valid:
+		return "external initiator " + p.ExternalInitiator.String
+	default:
+		return "unauthenticated"
+	}
+}
+
+func (p *AuditEntryPresenter) ToRow() []string {
+	params, err := json.Marshal(p.Params)
+	if err != nil {
+		params = []byte(err.Error())
+	}
+	row := []string{
+		p.ID,
+		p.CreatedAt.String(),
+		p.actor(),
+		p.SourceIP,
+		p.Action,
+		p.Resource,
+		strconv.Itoa(p.StatusCode),
+		string(params),
+	}
+	return row
+}
+
+type AuditEntryPresenters []AuditEntryPresenter
+
+// RenderTable implements TableRenderer
+func (ps AuditEntryPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Audit log\n")); err != nil {
+		return err
+	}
+	renderList(auditEntryTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// IndexAuditLog lists the audit log entries, most recent first
+func (cli *Client) IndexAuditLog(c *cli.Context) (err error) {
+	return cli.getPage("/v2/audit", c.Int("page"), &AuditEntryPresenters{})
+}
+
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokenTableHeaders = []string{"Name", "Access key", "Secret", "Scopes", "Expires at", "Last used at", "Created at"}
+
+func (p *APITokenPresenter) ToRow() []string {
+	scopes := "all"
+	if len(p.Scopes) > 0 {
+		scopes = strings.Join(p.Scopes, ", ")
+	}
+	secret := p.Secret
+	if secret == "" {
+		secret = "(only shown on creation)"
+	}
+	row := []string{
+		p.Name,
+		p.AccessKey,
+		secret,
+		scopes,
+		formatNullTime(p.ExpiresAt, "never"),
+		formatNullTime(p.LastUsedAt, "never"),
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+func formatNullTime(t null.Time, zero string) string {
+	if !t.Valid {
+		return zero
+	}
+	return t.Time.String()
+}
+
+// RenderTable implements TableRenderer
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(apiTokenTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokenTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens lists your named API tokens
+func (cli *Client) ListAPITokens(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/user/tokens")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token, prompting for your password
+func (cli *Client) CreateAPIToken(c *cli.Context) (err error) {
+	if !c.IsSet("name") {
+		return cli.errorOut(errors.New("must specify the --name of the token"))
+	}
+
+	request, err := json.Marshal(sessions.CreateAPITokenRequest{
+		Password:  cli.PasswordPrompter.Prompt(),
+		Name:      c.String("name"),
+		Scopes:    c.StringSlice("scope"),
+		ExpiresIn: c.String("expires-in"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/user/tokens", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &APITokenPresenter{}, "Created API token, store its secret as it will not be shown again")
+}
+
+// RevokeAPIToken deletes one of your named API tokens
+func (cli *Client) RevokeAPIToken(c *cli.Context) (err error) {
+	if !c.IsSet("name") {
+		return cli.errorOut(errors.New("must specify the --name of the token to revoke"))
+	}
+	name := c.String("name")
+
+	resp, err := cli.HTTP.Delete("/v2/user/tokens/" + url.PathEscape(name))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Printf("Revoked API token %s\n", name)
+	return nil
+}
@@ -0,0 +1,229 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAdminUsersPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		email  = "viewer@chain.link"
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.AdminUsersPresenter{
+		JAID: cmd.JAID{ID: email},
+		UserResource: presenters.UserResource{
+			JAID:      presenters.NewJAID(email),
+			Email:     email,
+			Role:      sessions.UserRoleView,
+			CreatedAt: time.Now(),
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, "view")
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.AdminUsersPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, "view")
+}
+
+func TestClient_AdminUsers(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+	email := "new-user@chain.link"
+
+	// Create
+	set := flag.NewFlagSet("test", 0)
+	set.String("email", email, "")
+	set.String("role", "view", "")
+	require.NoError(t, client.CreateUser(cli.NewContext(nil, set, nil)))
+
+	user, err := app.SessionORM().FindUser(email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, user.Role)
+
+	// Create an existing user
+	require.Error(t, client.CreateUser(cli.NewContext(nil, set, nil)))
+
+	// Create with an invalid role
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", "other@chain.link", "")
+	set.String("role", "superuser", "")
+	require.Error(t, client.CreateUser(cli.NewContext(nil, set, nil)))
+
+	// Change role
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", email, "")
+	set.String("new-role", "edit", "")
+	require.NoError(t, client.ChangeRole(cli.NewContext(nil, set, nil)))
+
+	user, err = app.SessionORM().FindUser(email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, user.Role)
+
+	// Change own role
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", cltest.APIEmail, "")
+	set.String("new-role", "view", "")
+	require.Error(t, client.ChangeRole(cli.NewContext(nil, set, nil)))
+
+	// List
+	r.Renders = nil
+	require.NoError(t, client.ListUsers(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 1)
+	users := *r.Renders[0].(*cmd.AdminUsersPresenters)
+	emails := map[string]sessions.UserRole{}
+	for _, u := range users {
+		emails[u.Email] = u.Role
+	}
+	assert.Equal(t, sessions.UserRoleAdmin, emails[cltest.APIEmail])
+	assert.Equal(t, sessions.UserRoleEdit, emails[email])
+
+	// Delete
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", email, "")
+	require.NoError(t, client.DeleteUser(cli.NewContext(nil, set, nil)))
+	_, err = app.SessionORM().FindUser(email)
+	require.Error(t, err)
+
+	// Delete self
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", cltest.APIEmail, "")
+	require.Error(t, client.DeleteUser(cli.NewContext(nil, set, nil)))
+}
+
+func TestAuditEntryPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.AuditEntryPresenters{
+		{
+			JAID: cmd.JAID{ID: "2"},
+			AuditEntryResource: presenters.AuditEntryResource{
+				UserEmail:   null.StringFrom("user@chain.link"),
+				APITokenKey: null.StringFrom("tokenkey"),
+				SourceIP:    "10.0.0.1",
+				Action:      "DELETE /v2/jobs/:ID",
+				Resource:    "/v2/jobs/1",
+				StatusCode:  204,
+				CreatedAt:   time.Now(),
+			},
+		},
+		{
+			JAID: cmd.JAID{ID: "1"},
+			AuditEntryResource: presenters.AuditEntryResource{
+				ExternalInitiator: null.StringFrom("ei"),
+				SourceIP:          "10.0.0.2",
+				Action:            "POST /v2/jobs/:ID/runs",
+				Resource:          "/v2/jobs/1/runs",
+				Params:            audit.Params{"body": map[string]interface{}{"value": "1"}},
+				StatusCode:        200,
+				CreatedAt:         time.Now(),
+			},
+		},
+	}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "user@chain.link (token tokenkey)")
+	assert.Contains(t, output, "DELETE /v2/jobs/:ID")
+	assert.Contains(t, output, "204")
+	assert.Contains(t, output, "external initiator ei")
+	assert.Contains(t, output, "10.0.0.2")
+	assert.Contains(t, output, `{"body":{"value":"1"}}`)
+}
+
+func TestClient_IndexAuditLog(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("email", "audited@chain.link", "")
+	set.String("role", "view", "")
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+	require.NoError(t, client.CreateUser(cli.NewContext(nil, set, nil)))
+
+	r.Renders = nil
+	require.NoError(t, client.IndexAuditLog(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 1)
+	entries := *r.Renders[0].(*cmd.AuditEntryPresenters)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "POST /v2/users", entries[0].Action)
+	assert.Equal(t, cltest.APIEmail, entries[0].UserEmail.String)
+	assert.Equal(t, "*REDACTED*", entries[0].Params["body"].(map[string]interface{})["password"])
+}
+
+func TestAPITokenPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	created := cmd.APITokenPresenter{
+		JAID: cmd.JAID{ID: "ci"},
+		APITokenResource: presenters.APITokenResource{
+			Name:      "ci",
+			AccessKey: "accesskey",
+			Secret:    "secret",
+			Scopes:    []string{"*:read", "jobs:write"},
+			ExpiresAt: null.TimeFrom(time.Now()),
+			CreatedAt: time.Now(),
+		},
+	}
+	require.NoError(t, created.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "accesskey")
+	assert.Contains(t, output, "secret")
+	assert.Contains(t, output, "*:read, jobs:write")
+
+	buffer.Reset()
+	listed := created
+	listed.Secret = ""
+	listed.Scopes = []string{}
+	listed.ExpiresAt = null.Time{}
+	require.NoError(t, cmd.APITokenPresenters{listed}.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "(only shown on creation)")
+	assert.Contains(t, output, "all")
+	assert.Contains(t, output, "never")
+}
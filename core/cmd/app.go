@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/urfave/cli"
 
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/static"
 )
 
@@ -31,14 +33,27 @@ func NewApp(client *Client) *cli.App {
 			Name:  "json, j",
 			Usage: "json output as opposed to table",
 		},
+		cli.StringFlag{
+			Name:  "config",
+			Usage: "TOML config file; any environment variables that are set take precedence over it",
+		},
+		cli.StringFlag{
+			Name:  "profiles-file",
+			Usage: "TOML file of named nodes for the --profile and --profiles flags of remote commands (default: " + ProfilesFileName + " in the root directory)",
+		},
 	}
 	app.Before = func(c *cli.Context) error {
 		if c.Bool("json") {
 			client.Renderer = RendererJSON{Writer: os.Stdout}
 		}
+		if path := c.String("config"); path != "" {
+			if err := client.Config.LoadConfigFile(path); err != nil {
+				return err
+			}
+		}
 		return nil
 	}
-	app.Commands = removeHidden([]cli.Command{
+	app.Commands = withProfileFlags(client, removeHidden([]cli.Command{
 		{
 			Name:  "admin",
 			Usage: "Commands for remotely taking admin related actions",
@@ -48,6 +63,117 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Change your API password remotely",
 					Action: client.ChangePassword,
 				},
+				{
+					Name:  "users",
+					Usage: "Create, edit permissions, or delete API users",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "Lists all API users and their roles",
+							Action: client.ListUsers,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a new API user, prompting for its password",
+							Action: client.CreateUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "email",
+									Usage:    "Email of new user to create",
+									Required: true,
+								},
+								cli.StringFlag{
+									Name:     "role",
+									Usage:    "Permission level of new user. Options: 'admin', 'edit', 'view'.",
+									Required: true,
+								},
+							},
+						},
+						{
+							Name:   "chrole",
+							Usage:  "Changes an API user's role",
+							Action: client.ChangeRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "email",
+									Usage:    "Email of user to change the role of",
+									Required: true,
+								},
+								cli.StringFlag{
+									Name:     "new-role",
+									Usage:    "New permission level of the user. Options: 'admin', 'edit', 'view'.",
+									Required: true,
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete an API user and end their sessions",
+							Action: client.DeleteUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "email",
+									Usage:    "Email of user to delete",
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:  "tokens",
+					Usage: "Create, list or revoke your named API tokens",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "Lists your named API tokens",
+							Action: client.ListAPITokens,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a named API token, prompting for your password",
+							Action: client.CreateAPIToken,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of the token, unique among your tokens",
+									Required: true,
+								},
+								cli.StringSliceFlag{
+									Name:  "scope",
+									Usage: "Access of the token, as <resource>:<none|read|write> or 'read-only'. Can be repeated. Resources: " + strings.Join(sessions.ScopeResources, ", ") + ", or * for all others. Tokens without scopes have full access",
+								},
+								cli.StringFlag{
+									Name:  "expires-in",
+									Usage: "Duration after which the token expires, e.g. 720h. By default tokens never expire",
+								},
+							},
+						},
+						{
+							Name:   "revoke",
+							Usage:  "Revoke a named API token",
+							Action: client.RevokeAPIToken,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of the token to revoke",
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:   "audit",
+					Usage:  "List the audit log of state-changing API actions, most recent first",
+					Action: client.IndexAuditLog,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
 				{
 					Name:   "login",
 					Usage:  "Login to remote client by creating a session cookie",
@@ -142,6 +268,43 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "bundle",
+			Usage: "Commands for moving the jobs, bridges, external initiators, chains and nodes of a node to another one",
+			Subcommands: []cli.Command{
+				{
+					Name:  "export",
+					Usage: format(`Exports the jobs, bridges, external initiators, EVM chains and nodes of the node to an archive. The archive holds the credentials of bridges and external initiators.`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "Path where the archive will be saved (required)",
+						},
+						cli.BoolFlag{
+							Name:  "keys",
+							Usage: "include the keys of the node, in the format of their export commands",
+						},
+						cli.StringFlag{
+							Name:  "newpassword, p",
+							Usage: "`FILE` containing the password to encrypt the keys (required with --keys)",
+						},
+					},
+					Action: client.ExportBundle,
+				},
+				{
+					Name:  "import",
+					Usage: format(`Imports an archive exported by 'bundle export', skipping the items the node already has. EVM chains and nodes take effect on the next start of the node, so import again after restarting to create the jobs which need them.`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword, p",
+							Usage: "`FILE` containing the password the keys of the archive were encrypted with",
+						},
+					},
+					Action: client.ImportBundle,
+				},
+			},
+		},
+
 		{
 			Name:  "config",
 			Usage: "Commands for the node's configuration",
@@ -151,6 +314,26 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show the node's environment variables",
 					Action: client.GetConfiguration,
 				},
+				{
+					Name:   "provenance",
+					Usage:  "Show the source of each global and per chain setting, and any values it overrides",
+					Action: client.GetConfigProvenance,
+				},
+				{
+					Name:   "reload",
+					Usage:  "Re-read the node's config files and apply the changed settings that do not need a restart",
+					Action: client.ReloadConfiguration,
+				},
+				{
+					Name:   "validate",
+					Usage:  "Report unknown keys and invalid values in a TOML config file, given as an argument or with --config",
+					Action: client.ValidateConfigFile,
+				},
+				{
+					Name:   "dump",
+					Usage:  "Print the effective config as TOML, merging environment variables over the config file and defaults",
+					Action: client.DumpConfigFile,
+				},
 				{
 					Name:   "setgasprice",
 					Usage:  "Set the default gas price to use for outgoing transactions",
@@ -245,12 +428,83 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "replay",
+					Usage:  "Replay a pipeline run with its recorded http and bridge results and diff it against the original",
+					Action: client.ReplayPipelineRun,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "job-id",
+							Usage: "replay against the current pipeline spec of this job",
+						},
+						cli.StringFlag{
+							Name:  "dot",
+							Usage: "replay against the pipeline in this DOT file",
+						},
+					},
+				},
+				{
+					Name:   "lint",
+					Usage:  "Validate job spec files without creating the jobs, and report settings and tasks which would be ignored or fail",
+					Action: client.LintJobs,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "fix",
+							Usage: "rewrite the pipelines in the files in the canonical layout, leaving the rest of the files as they are",
+						},
+						cli.BoolFlag{
+							Name:  "check-bridges",
+							Usage: "check that the bridges used by the pipelines exist on the node",
+						},
+					},
+				},
 			},
 		},
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
 			Subcommands: []cli.Command{
+				{
+					Name:  "rotate-password",
+					Usage: format(`Re-encrypts the keystore with a new password. The node must be started with the new password from then on.`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword",
+							Usage: "`FILE` containing the current keystore password (required)",
+						},
+						cli.StringFlag{
+							Name:  "newpassword",
+							Usage: "`FILE` containing the new keystore password (required)",
+						},
+					},
+					Action: client.RotateKeystorePassword,
+				},
+				{
+					Name:  "backup",
+					Usage: format(`Saves all of the keys of the node, with the states of its ETH keys, to a single password protected file`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "Path where the backup will be saved (required)",
+						},
+						cli.StringFlag{
+							Name:  "newpassword, p",
+							Usage: "`FILE` containing the password to encrypt the backup with (required)",
+						},
+					},
+					Action: client.BackupKeys,
+				},
+				{
+					Name:  "restore",
+					Usage: format(`Restores the keys of a file saved by 'keys backup', skipping the keys the node already has`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword, p",
+							Usage: "`FILE` containing the password the backup was encrypted with (required)",
+						},
+					},
+					Action: client.RestoreKeys,
+				},
 				{
 					Name:  "eth",
 					Usage: "Remote commands for administering the node's Ethereum keys",
@@ -268,6 +522,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "maxGasPriceGWei",
 									Usage: "Optional maximum gas price (GWei) for the creating key.",
 								},
+								cli.StringFlag{
+									Name:  "remoteAddress",
+									Usage: "Optional address of a key held by the remote signer (ETH_REMOTE_SIGNER_URL) to add instead of creating a key.",
+								},
 							},
 						},
 						{
@@ -331,6 +589,101 @@ func NewApp(client *Client) *cli.App {
 							},
 							Action: client.ExportETHKey,
 						},
+						{
+							Name:  "hd",
+							Usage: "Commands for the HD wallet from which new ETH keys are derived, so that they can be recovered from its mnemonic",
+							Subcommands: cli.Commands{
+								{
+									Name:   "show",
+									Usage:  "Show whether new ETH keys are derived from the HD wallet, and the index of the next key",
+									Action: client.ShowETHHDWallet,
+								},
+								{
+									Name:  "create",
+									Usage: format(`Set up the HD wallet, from which all new ETH keys are derived. The mnemonic is only shown once, when it is generated by the node`),
+									Flags: []cli.Flag{
+										cli.StringFlag{
+											Name:  "mnemonic",
+											Usage: "Optional `FILE` containing an existing BIP-39 mnemonic to use instead of generating one",
+										},
+									},
+									Action: client.CreateETHHDWallet,
+								},
+								{
+									Name:  "recover",
+									Usage: format(`Add the first keys derived from the HD wallet which the node does not have`),
+									Flags: []cli.Flag{
+										cli.UintFlag{
+											Name:  "count",
+											Usage: "Number of keys to derive, starting at index 0 (required)",
+										},
+										cli.StringFlag{
+											Name:  "evmChainID",
+											Usage: "Chain ID for the recovered keys. If left blank, default chain will be used.",
+										},
+									},
+									Action: client.RecoverETHHDKeys,
+								},
+							},
+						},
+						{
+							Name:  "policy",
+							Usage: "Commands for the policies which limit the transactions of ETH keys",
+							Subcommands: cli.Commands{
+								{
+									Name:   "show",
+									Usage:  format(`Show the policy of the ETH key with the given address`),
+									Action: client.ShowETHKeyPolicy,
+								},
+								{
+									Name:  "set",
+									Usage: format(`Set the policy of the ETH key with the given address, replacing its current policy. Transactions which violate it are rejected`),
+									Flags: []cli.Flag{
+										cli.StringFlag{
+											Name:  "maxTxValue",
+											Usage: "Optional maximum value (ETH) of each transaction",
+										},
+										cli.StringFlag{
+											Name:  "maxDailyGasSpend",
+											Usage: "Optional maximum amount (ETH) spent on gas in the last 24 hours",
+										},
+										cli.StringSliceFlag{
+											Name:  "allowedDestination",
+											Usage: "Optional address which the key can send transactions to, repeat it to allow several addresses. Any address is allowed if omitted",
+										},
+									},
+									Action: client.SetETHKeyPolicy,
+								},
+								{
+									Name:   "delete",
+									Usage:  format(`Delete the policy of the ETH key with the given address, so that its transactions are not limited`),
+									Action: client.DeleteETHKeyPolicy,
+								},
+							},
+						},
+						{
+							Name:  "usage",
+							Usage: format(`Show the jobs which use the ETH key with the given address, and what its transactions spent on gas per job`),
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "since",
+									Usage: "Optional RFC3339 start of the period, by default 7 days before its end",
+								},
+								cli.StringFlag{
+									Name:  "until",
+									Usage: "Optional RFC3339 end of the period, by default now",
+								},
+								cli.StringFlag{
+									Name:  "evmChainID",
+									Usage: "Optional chain ID to only count the transactions of that chain",
+								},
+								cli.StringFlag{
+									Name:  "csv",
+									Usage: "Save every transaction of the period with its job and fee to this CSV file instead",
+								},
+							},
+							Action: client.ShowETHKeyUsage,
+						},
 					},
 				},
 
@@ -731,6 +1084,11 @@ func NewApp(client *Client) *cli.App {
 			Usage:       "Commands for admin actions that must be run locally",
 			Description: "Commands can only be run from on the same machine as the Chainlink node.",
 			Subcommands: []cli.Command{
+				{
+					Name:   "doctor",
+					Usage:  "Run diagnostic checks against the running node, e.g. of its database, RPC nodes and keys, and suggest fixes for any problems",
+					Action: client.Doctor,
+				},
 				{
 					Name:   "setnextnonce",
 					Usage:  "Manually set the next nonce for a key. This should NEVER be necessary during normal operation. USE WITH CAUTION: Setting this incorrectly can break your node.",
@@ -1283,7 +1641,97 @@ func NewApp(client *Client) *cli.App {
 				},
 			},
 		},
-	}...)
+		{
+			Name:  "keeper",
+			Usage: "Commands for keeper jobs.",
+			Subcommands: []cli.Command{
+				{
+					Name:  "upkeeps",
+					Usage: "Commands for the upkeeps of keeper jobs.",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "List the upkeeps of keeper jobs with their check and perform stats",
+							Action: client.ListKeeperUpkeeps,
+							Flags: []cli.Flag{
+								cli.IntFlag{
+									Name:  "page",
+									Usage: "page of results to display",
+								},
+								cli.StringFlag{
+									Name:  "job",
+									Usage: "only list the upkeeps of the job with this ID",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:   "simulate",
+					Usage:  "Show whether a keeper job would perform an upkeep, and why, without performing it",
+					Action: client.SimulateKeeperUpkeep,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "job",
+							Usage: "the ID of the keeper job",
+						},
+						cli.StringFlag{
+							Name:  "upkeep",
+							Usage: "the ID of the upkeep",
+						},
+						cli.StringFlag{
+							Name:  "block",
+							Usage: "the number of the block to simulate at, if left empty, the latest block is used",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "vrf",
+			Usage: "Commands for VRF requests and subscriptions.",
+			Subcommands: []cli.Command{
+				{
+					Name:  "requests",
+					Usage: "Commands for VRF requests.",
+					Subcommands: []cli.Command{
+						{
+							Name:   "show",
+							Usage:  "Show whether a VRF v1 or v2 request was fulfilled, and if not why",
+							Action: client.ShowVRFRequest,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "request-id",
+									Usage: "the ID of the request, as a decimal or a 0x prefixed hex number",
+								},
+								cli.StringFlag{
+									Name:  "tx-hash",
+									Usage: "the hash of the transaction which sent the request",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:  "subscriptions",
+					Usage: "Commands for VRF v2 subscriptions.",
+					Subcommands: []cli.Command{
+						{
+							Name:   "show",
+							Usage:  "Show the balance, the reserved LINK and the pending requests of a VRF v2 subscription",
+							Action: client.ShowVRFSubscription,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "evm-chain-id",
+									Usage: "the chain ID of the subscription, required if the node has more than one chain",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}...))
 	return app
 }
 
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// BundleImportPresenter implements TableRenderer for a BundleImportResource.
+type BundleImportPresenter struct {
+	webpresenters.BundleImportResource
+}
+
+// RenderTable implements TableRenderer
+func (p BundleImportPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Kind", "ID", "Status", "Error"})
+	for _, item := range p.Items {
+		table.Append([]string{item.Kind, item.ID, string(item.Status), item.Error})
+	}
+	render("Imported bundle", table)
+	return nil
+}
+
+// ExportBundle saves the jobs, bridges, external initiators, EVM chains and
+// nodes of the node, and optionally its keys, to an archive.
+func (cli *Client) ExportBundle(c *clipkg.Context) (err error) {
+	output := c.String("output")
+	if output == "" {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	exportURL := url.URL{Path: "/v2/bundle/export"}
+	if c.Bool("keys") {
+		newPasswordFile := c.String("newpassword")
+		if newPasswordFile == "" {
+			return cli.errorOut(errors.New("Must specify --newpassword/-p flag to export keys"))
+		}
+		newPassword, err := ioutil.ReadFile(newPasswordFile)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+		}
+		query := exportURL.Query()
+		query.Set("includeKeys", "true")
+		query.Set("newpassword", strings.TrimSpace(string(newPassword)))
+		exportURL.RawQuery = query.Encode()
+	}
+
+	resp, err := cli.HTTP.Post(exportURL.String(), nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	archive, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	if err = utils.WriteFileWithMaxPerms(output, archive, 0600); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", output))
+	}
+
+	_, err = os.Stderr.WriteString("📦 Exported bundle to " + output + "\n")
+	return cli.errorOut(err)
+}
+
+// ImportBundle imports an archive saved by ExportBundle, and fails if any of
+// its items failed to import.
+func (cli *Client) ImportBundle(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the bundle to be imported"))
+	}
+	archive, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	importURL := url.URL{Path: "/v2/bundle/import"}
+	if oldPasswordFile := c.String("oldpassword"); oldPasswordFile != "" {
+		oldPassword, err := ioutil.ReadFile(oldPasswordFile)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+		}
+		query := importURL.Query()
+		query.Set("oldpassword", strings.TrimSpace(string(oldPassword)))
+		importURL.RawQuery = query.Encode()
+	}
+
+	resp, err := cli.HTTP.Post(importURL.String(), bytes.NewReader(archive))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var p BundleImportPresenter
+	if err = cli.renderAPIResponse(resp, &p); err != nil {
+		return err
+	}
+	if p.Failed > 0 {
+		return cli.errorOut(fmt.Errorf("%d of %d items failed to import", p.Failed, len(p.Items)))
+	}
+	return nil
+}
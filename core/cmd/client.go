@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -34,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -99,6 +101,15 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 
 	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
 
+	var remoteSigner *remotesigner.Signer
+	if u := cfg.EthRemoteSignerURL(); u != nil {
+		remoteSigner, err = remotesigner.New(u, cfg.EthRemoteSignerTimeout(), cfg.EthRemoteSignerRetries(), appLggr)
+		if err != nil {
+			return nil, err
+		}
+		keyStore.Eth().SetRemoteSigner(remoteSigner)
+	}
+
 	// Set up the versioning ORM
 	verORM := versioning.NewORM(db, appLggr)
 
@@ -142,6 +153,12 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 
 	// Upsert EVM chains/nodes from ENV, necessary for backwards compatibility
 	if cfg.EVMEnabled() {
+		// The config file is applied first, so that ENV continues to take precedence
+		if path := cfg.ConfigFile(); path != "" {
+			if err = setupChainsFromConfigFile(db, cfg, path, appLggr); err != nil {
+				return nil, err
+			}
+		}
 		if err = evm.ClobberDBFromEnv(db, cfg, appLggr); err != nil {
 			return nil, err
 		}
@@ -203,6 +220,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		Config:                   cfg,
 		SqlxDB:                   db,
 		KeyStore:                 keyStore,
+		RemoteSigner:             remoteSigner,
 		Chains:                   chains,
 		EventBroadcaster:         eventBroadcaster,
 		Logger:                   appLggr,
@@ -384,6 +402,9 @@ func newHttpClient(config SessionCookieAuthenticatorConfig) *http.Client {
 		// #nosec G402
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify()},
 	}
+	if c, ok := config.(rootCAsConfig); ok {
+		tr.TLSClientConfig.RootCAs = c.RootCAs()
+	}
 	if config.InsecureSkipVerify() {
 		fmt.Println("WARNING: INSECURE_SKIP_VERIFY is set to true, skipping SSL certificate verification.")
 	}
@@ -472,6 +493,12 @@ type SessionCookieAuthenticatorConfig interface {
 	InsecureSkipVerify() bool
 }
 
+// rootCAsConfig is optionally implemented by a SessionCookieAuthenticatorConfig
+// to trust certificate authorities other than the system ones.
+type rootCAsConfig interface {
+	RootCAs() *x509.CertPool
+}
+
 // SessionCookieAuthenticator is a concrete implementation of CookieAuthenticator
 // that retrieves a session id for the user with credentials from the session request.
 type SessionCookieAuthenticator struct {
@@ -566,6 +593,8 @@ type DiskCookieConfig interface {
 // DiskCookieStore saves a single cookie in the local cli working directory.
 type DiskCookieStore struct {
 	Config DiskCookieConfig
+	// Profile, if set, keeps the cookie of that profile apart from the others.
+	Profile string
 }
 
 // Save stores a cookie.
@@ -599,6 +628,9 @@ func (d DiskCookieStore) Retrieve() (*http.Cookie, error) {
 }
 
 func (d DiskCookieStore) cookiePath() string {
+	if d.Profile != "" {
+		return path.Join(d.Config.RootDir(), "cookie-"+d.Profile)
+	}
 	return path.Join(d.Config.RootDir(), "cookie")
 }
 
@@ -639,9 +671,10 @@ func (f *fileSessionRequestBuilder) Build(file string) (sessions.SessionRequest,
 }
 
 // APIInitializer is the interface used to create the API User credentials
-// needed to access the API. Does nothing if API user already exists.
+// needed to access the API. Does nothing if an API user already exists.
 type APIInitializer interface {
-	// Initialize creates a new user for API access, or does nothing if one exists.
+	// Initialize creates a new admin user for API access, or returns the
+	// most recently created user if one exists.
 	Initialize(orm sessions.ORM) (sessions.User, error)
 }
 
@@ -657,8 +690,8 @@ func NewPromptingAPIInitializer(prompter Prompter) APIInitializer {
 
 // Initialize uses the terminal to get credentials that it then saves in the store.
 func (t *promptingAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
-		return user, err
+	if users, err := orm.ListUsers(); err == nil && len(users) > 0 {
+		return users[len(users)-1], err
 	}
 
 	if !t.prompter.IsTerminal() {
@@ -668,7 +701,7 @@ func (t *promptingAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, e
 	for {
 		email := t.prompter.Prompt("Enter API Email: ")
 		pwd := t.prompter.PasswordPrompt("Enter API Password: ")
-		user, err := sessions.NewUser(email, pwd)
+		user, err := sessions.NewUser(email, pwd, sessions.UserRoleAdmin)
 		if err != nil {
 			fmt.Println("Error creating API user: ", err)
 			continue
@@ -692,8 +725,8 @@ func NewFileAPIInitializer(file string, lggr logger.Logger) APIInitializer {
 }
 
 func (f fileAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
-		return user, err
+	if users, err := orm.ListUsers(); err == nil && len(users) > 0 {
+		return users[len(users)-1], err
 	}
 
 	request, err := credentialsFromFile(f.file, f.lggr)
@@ -701,7 +734,7 @@ func (f fileAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error)
 		return sessions.User{}, err
 	}
 
-	user, err := sessions.NewUser(request.Email, request.Password)
+	user, err := sessions.NewUser(request.Email, request.Password, sessions.UserRoleAdmin)
 	if err != nil {
 		return user, err
 	}
@@ -145,8 +145,8 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			mock := &cltest.MockCountingPrompter{T: t, EnteredStrings: test.enteredStrings, NotTerminal: !test.isTerminal}
 			tai := cmd.NewPromptingAPIInitializer(mock)
 
-			// Remove fixture user
-			err := orm.DeleteUser()
+			// Remove fixture users
+			_, err := db.Exec("DELETE FROM users")
 			require.NoError(t, err)
 
 			user, err := tai.Initialize(orm)
@@ -156,10 +156,11 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, len(test.enteredStrings), mock.Count)
 
-				persistedUser, err := orm.FindUser()
+				persistedUser, err := orm.FindUser(user.Email)
 				assert.NoError(t, err)
 
 				assert.Equal(t, user.Email, persistedUser.Email)
+				assert.Equal(t, sessions.UserRoleAdmin, persistedUser.Role)
 				assert.Equal(t, user.HashedPassword, persistedUser.HashedPassword)
 			}
 		})
@@ -200,8 +201,9 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
 			orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
-			// Clear out fixture user
-			orm.DeleteUser()
+			// Clear out fixture users
+			_, err := db.Exec("DELETE FROM users")
+			require.NoError(t, err)
 
 			tfi := cmd.NewFileAPIInitializer(test.file, logger.TestLogger(t))
 			user, err := tfi.Initialize(orm)
@@ -210,7 +212,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, cltest.APIEmail, user.Email)
-				persistedUser, err := orm.FindUser()
+				persistedUser, err := orm.FindUser(user.Email)
 				assert.NoError(t, err)
 				assert.Equal(t, persistedUser.Email, user.Email)
 			}
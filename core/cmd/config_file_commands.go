@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/config/tomlconfig"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ValidateConfigFile reports any unknown keys and invalid values in a TOML
+// config file, given as an argument or with --config.
+func (cli *Client) ValidateConfigFile(c *clipkg.Context) error {
+	path := configFileArg(c)
+	if path == "" {
+		return cli.errorOut(errors.New("must pass the path of the config file as an argument, or with --config"))
+	}
+	if err := validateConfigFile(path); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("%s is valid\n", path)
+	return nil
+}
+
+// DumpConfigFile prints the effective config as TOML, merging environment
+// variables over the config file, if any, over the defaults.
+func (cli *Client) DumpConfigFile(c *clipkg.Context) error {
+	var f *tomlconfig.File
+	if path := configFileArg(c); path != "" {
+		var err error
+		if f, err = tomlconfig.Load(path); err != nil {
+			return cli.errorOut(err)
+		}
+	}
+	out, err := tomlconfig.Dump(f)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Print(out)
+	return nil
+}
+
+func configFileArg(c *clipkg.Context) string {
+	if path := c.Args().First(); path != "" {
+		return path
+	}
+	return c.GlobalString("config")
+}
+
+// validateConfigFile returns an error listing every problem in the config
+// file at path, each prefixed with its path, line and column.
+func validateConfigFile(path string) error {
+	f, err := tomlconfig.Load(path)
+	if err != nil {
+		return err
+	}
+	ps := f.Validate()
+	if len(ps) == 0 {
+		return nil
+	}
+	lines := make([]string, len(ps))
+	for i, p := range ps {
+		lines[i] = fmt.Sprintf("%s:%s", path, p)
+	}
+	return errors.Errorf("found %d problem(s) in config file:\n%s", len(ps), strings.Join(lines, "\n"))
+}
+
+func setupChainsFromConfigFile(db *sqlx.DB, cfg config.GeneralConfig, path string, lggr logger.Logger) error {
+	f, err := tomlconfig.Load(path)
+	if err != nil {
+		return err
+	}
+	chains, nodes, err := f.EVMChains()
+	if err != nil {
+		return errors.Wrapf(err, "invalid EVM chains in config file %s", path)
+	}
+	if len(chains) == 0 {
+		return nil
+	}
+	return evm.ClobberDBFromConfigFile(db, chains, nodes, cfg, lggr)
+}
@@ -0,0 +1,56 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+)
+
+func TestClient_ValidateConfigFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.toml")
+	require.NoError(t, os.WriteFile(valid, []byte("LOG_LEVEL = \"warn\"\n"), 0600))
+	invalid := filepath.Join(dir, "invalid.toml")
+	require.NoError(t, os.WriteFile(invalid, []byte("LOG_LEVEL = \"loud\"\nFOO = 1\n"), 0600))
+
+	client := &cmd.Client{}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"valid", []string{valid}, ""},
+		{"invalid", []string{invalid}, "found 2 problem(s) in config file:\n" +
+			invalid + `:1:1: LOG_LEVEL: invalid value "loud": unrecognized level: "loud"` + "\n" +
+			invalid + ":2:1: FOO: unknown key"},
+		{"missing file", []string{filepath.Join(dir, "missing.toml")}, "failed to read config file"},
+		{"no file", nil, "must pass the path of the config file"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := flag.NewFlagSet("test", 0)
+			require.NoError(t, set.Parse(tt.args))
+
+			err := client.ValidateConfigFile(cli.NewContext(nil, set, nil))
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
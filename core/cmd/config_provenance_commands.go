@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ConfigProvenancePresenter implements TableRenderer for a
+// ConfigProvenanceResource.
+type ConfigProvenancePresenter struct {
+	presenters.ConfigProvenanceResource
+}
+
+// ToRows presents each setting of the ConfigProvenanceResource as a slice of
+// strings.
+func (p *ConfigProvenancePresenter) ToRows() [][]string {
+	var rows [][]string
+	for _, s := range p.Settings {
+		var shadowed []string
+		for _, sv := range s.Shadowed {
+			shadowed = append(shadowed, fmt.Sprintf("%s (%s)", sv.Value, sv.Source))
+		}
+		rows = append(rows, []string{s.Name, s.Value, string(s.Source), strings.Join(shadowed, ", ")})
+	}
+	return rows
+}
+
+// RenderTable implements TableRenderer
+func (p ConfigProvenancePresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Key", "Value", "Source", "Shadowed"})
+	table.AppendBulk(p.ToRows())
+
+	name := "Global"
+	if p.EVMChainID != nil {
+		name = "EVM chain " + p.EVMChainID.String()
+	}
+	render(name, table)
+	return nil
+}
+
+// ConfigProvenancePresenters implements TableRenderer for a slice of
+// ConfigProvenancePresenters.
+type ConfigProvenancePresenters []ConfigProvenancePresenter
+
+// RenderTable implements TableRenderer
+func (ps ConfigProvenancePresenters) RenderTable(rt RendererTable) error {
+	for _, p := range ps {
+		if err := p.RenderTable(rt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetConfigProvenance shows the effective global and per chain settings of the
+// node, along with the source of each and any values it shadows.
+func (cli *Client) GetConfigProvenance(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/config/provenance")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ConfigProvenancePresenters{})
+}
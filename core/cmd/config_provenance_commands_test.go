@@ -0,0 +1,38 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestConfigProvenancePresenter_ToRows(t *testing.T) {
+	t.Parallel()
+
+	p := cmd.ConfigProvenancePresenter{
+		ConfigProvenanceResource: presenters.NewEVMChainConfigProvenanceResource(*utils.NewBigI(42), []config.Provenance{
+			config.NewProvenance("ETH_FINALITY_DEPTH", "20", []config.SourcedValue{
+				{Source: config.SourceEnv, Value: "20"},
+				{Source: config.SourceDB, Value: "30"},
+				{Source: config.SourceChainDefault, Value: "50"},
+			}),
+			config.NewProvenance("MIN_INCOMING_CONFIRMATIONS", "3", nil),
+		}),
+	}
+
+	assert.Equal(t, [][]string{
+		{"ETH_FINALITY_DEPTH", "20", "env", "30 (db), 50 (chain default)"},
+		{"MIN_INCOMING_CONFIRMATIONS", "3", "default", ""},
+	}, p.ToRows())
+
+	var b bytes.Buffer
+	require.NoError(t, cmd.ConfigProvenancePresenters{p}.RenderTable(cmd.RendererTable{Writer: &b}))
+	assert.Contains(t, b.String(), "30 (db), 50 (chain default)")
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/services/diagnostics"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// DiagnosticPresenter implements TableRenderer for a DiagnosticResource.
+type DiagnosticPresenter struct {
+	presenters.DiagnosticResource
+}
+
+// ToRow presents the DiagnosticResource as a slice of strings.
+func (p *DiagnosticPresenter) ToRow() []string {
+	return []string{strings.ToUpper(string(p.Status)), p.Check, p.Subject, p.Message, p.Hint}
+}
+
+// DiagnosticPresenters implements TableRenderer for a slice of
+// DiagnosticPresenters.
+type DiagnosticPresenters []DiagnosticPresenter
+
+// RenderTable implements TableRenderer
+func (ps DiagnosticPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Status", "Check", "Subject", "Message", "Hint"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("Diagnostics", table)
+	return nil
+}
+
+// Doctor runs diagnostic checks against the node and reports their results. It
+// returns an error if any check failed.
+func (cli *Client) Doctor(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/diagnostics")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var ps DiagnosticPresenters
+	if err = cli.renderAPIResponse(resp, &ps); err != nil {
+		return err
+	}
+
+	var failed, warned int
+	for _, p := range ps {
+		switch p.Status {
+		case diagnostics.StatusFail:
+			failed++
+		case diagnostics.StatusWarn:
+			warned++
+		}
+	}
+	if failed > 0 {
+		return cli.errorOut(errors.Errorf("%d check(s) failed and %d produced warnings", failed, warned))
+	}
+	return nil
+}
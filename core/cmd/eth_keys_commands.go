@@ -2,15 +2,20 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -27,13 +32,14 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.EthBalance.String(),
 		p.LinkBalance.String(),
 		fmt.Sprintf("%v", p.IsFunding),
+		fmt.Sprintf("%v", p.IsRemote),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		p.MaxGasPriceWei.String(),
 	}
 }
 
-var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated", "Max Gas Price Wei"}
+var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Is remote", "Created", "Updated", "Max Gas Price Wei"}
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
@@ -75,7 +81,8 @@ func (cli *Client) ListETHKeys(c *cli.Context) (err error) {
 }
 
 // CreateETHKey creates a new ethereum key with the same password
-// as the one used to unlock the existing key.
+// as the one used to unlock the existing key, or adds a key held by the
+// remote signer.
 func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	createUrl := url.URL{
 		Path: "/v2/keys/eth",
@@ -88,6 +95,9 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	if c.IsSet("maxGasPriceGWei") {
 		query.Set("maxGasPriceGWei", c.String("maxGasPriceGWei"))
 	}
+	if c.IsSet("remoteAddress") {
+		query.Set("remoteAddress", c.String("remoteAddress"))
+	}
 
 	createUrl.RawQuery = query.Encode()
 	resp, err := cli.HTTP.Post(createUrl.String(), nil)
@@ -280,3 +290,283 @@ func (cli *Client) ExportETHKey(c *cli.Context) (err error) {
 
 	return nil
 }
+
+// EthHDWalletPresenter implements TableRenderer for an ETHHDWalletResource
+type EthHDWalletPresenter struct {
+	presenters.ETHHDWalletResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EthHDWalletPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{{fmt.Sprintf("%v", p.Enabled), fmt.Sprintf("%d", p.NextIndex)}}
+	renderList([]string{"Enabled", "Next index"}, rows, rt.Writer)
+
+	if p.Mnemonic != "" {
+		msg := fmt.Sprintf("\nMnemonic of the HD wallet, this is the only time it is shown. Write it down and keep it safe,\n"+
+			"it is needed to recover the ETH keys derived from the HD wallet:\n\n%s\n\n", p.Mnemonic)
+		if _, err := rt.Write([]byte(msg)); err != nil {
+			return err
+		}
+	}
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ShowETHHDWallet shows whether new ETH keys are derived from an HD wallet
+func (cli *Client) ShowETHHDWallet(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/keys/eth/hd")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthHDWalletPresenter{}, "🔑 ETH HD wallet")
+}
+
+// CreateETHHDWallet sets up the HD wallet from which new ETH keys are
+// derived, with the mnemonic of the given file or a new one
+func (cli *Client) CreateETHHDWallet(c *cli.Context) (err error) {
+	var request web.CreateETHHDWalletRequest
+	if c.IsSet("mnemonic") {
+		mnemonic, err2 := ioutil.ReadFile(c.String("mnemonic"))
+		if err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "Could not read mnemonic file"))
+		}
+		request.Mnemonic = strings.TrimSpace(string(mnemonic))
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/eth/hd", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthHDWalletPresenter{}, "ETH HD wallet created, new ETH keys are derived from it.\n\n🔑 HD wallet")
+}
+
+// RecoverETHHDKeys adds the first keys derived from the HD wallet which the
+// node does not have yet
+func (cli *Client) RecoverETHHDKeys(c *cli.Context) (err error) {
+	if !c.IsSet("count") {
+		return cli.errorOut(errors.New("Must specify --count flag"))
+	}
+	recoverUrl := url.URL{
+		Path: "/v2/keys/eth/hd/recover",
+	}
+	query := recoverUrl.Query()
+	query.Set("count", c.String("count"))
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	recoverUrl.RawQuery = query.Encode()
+	resp, err := cli.HTTP.Post(recoverUrl.String(), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthKeyPresenters{}, "🔑 Recovered ETH keys")
+}
+
+// EthKeyPolicyPresenter implements TableRenderer for an ETHKeyPolicyResource
+type EthKeyPolicyPresenter struct {
+	presenters.ETHKeyPolicyResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EthKeyPolicyPresenter) RenderTable(rt RendererTable) error {
+	unlimited := func(limit *assets.Eth) string {
+		if limit == nil {
+			return "unlimited"
+		}
+		return limit.String()
+	}
+	destinations := "any"
+	if len(p.AllowedDestinations) > 0 {
+		destinations = strings.Join(p.AllowedDestinations, "\n")
+	}
+	rows := [][]string{{
+		p.Address,
+		unlimited(p.MaxTxValue),
+		unlimited(p.MaxDailyGasSpend),
+		destinations,
+		p.UpdatedAt.String(),
+	}}
+	renderList([]string{"Address", "Max tx value (ETH)", "Max daily gas spend (ETH)", "Allowed destinations", "Updated"}, rows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ShowETHKeyPolicy shows the policy of an ETH key
+func (cli *Client) ShowETHKeyPolicy(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key"))
+	}
+	resp, err := cli.HTTP.Get("/v2/keys/eth/" + c.Args().First() + "/policy")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthKeyPolicyPresenter{}, "🔑 ETH key policy")
+}
+
+// SetETHKeyPolicy sets the policy which limits the transactions of an ETH key
+func (cli *Client) SetETHKeyPolicy(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key"))
+	}
+	parseLimit := func(flag string) (*assets.Eth, error) {
+		if !c.IsSet(flag) {
+			return nil, nil
+		}
+		value, err2 := assets.NewEthValueS(c.String(flag))
+		if err2 != nil {
+			return nil, errors.Wrapf(err2, "invalid --%s", flag)
+		}
+		return &value, nil
+	}
+	var request web.UpdateETHKeyPolicyRequest
+	if request.MaxTxValue, err = parseLimit("maxTxValue"); err != nil {
+		return cli.errorOut(err)
+	}
+	if request.MaxDailyGasSpend, err = parseLimit("maxDailyGasSpend"); err != nil {
+		return cli.errorOut(err)
+	}
+	for _, destination := range c.StringSlice("allowedDestination") {
+		if !common.IsHexAddress(destination) {
+			return cli.errorOut(errors.Errorf("invalid --allowedDestination %s", destination))
+		}
+		request.AllowedDestinations = append(request.AllowedDestinations, common.HexToAddress(destination))
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Put("/v2/keys/eth/"+c.Args().First()+"/policy", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthKeyPolicyPresenter{}, "ETH key policy updated.\n\n🔑 ETH key policy")
+}
+
+// DeleteETHKeyPolicy removes the policy of an ETH key
+func (cli *Client) DeleteETHKeyPolicy(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/keys/eth/" + c.Args().First() + "/policy")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Printf("Policy of ETH key %s deleted\n", c.Args().First())
+	return nil
+}
+
+// EthKeyUsagePresenter implements TableRenderer for an ETHKeyUsageResource
+type EthKeyUsagePresenter struct {
+	presenters.ETHKeyUsageResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EthKeyUsagePresenter) RenderTable(rt RendererTable) error {
+	if _, err := rt.Write([]byte(fmt.Sprintf("%s from %s to %s\n\n", p.Address, p.Since.Format(time.RFC3339), p.Until.Format(time.RFC3339)))); err != nil {
+		return err
+	}
+
+	jobRows := [][]string{}
+	for _, j := range p.Jobs {
+		jobRows = append(jobRows, []string{fmt.Sprint(j.ID), j.Name.ValueOrZero(), string(j.Type), j.ExternalJobID})
+	}
+	renderList([]string{"Job ID", "Name", "Type", "External Job ID"}, jobRows, rt.Writer)
+
+	spendRows := [][]string{}
+	for _, s := range p.Spend {
+		jobID := "none"
+		if s.JobID != nil {
+			jobID = fmt.Sprint(*s.JobID)
+		}
+		spendRows = append(spendRows, []string{jobID, s.JobName.ValueOrZero(), fmt.Sprint(s.TxCount), fmt.Sprint(s.GasUsed), s.Fee.String()})
+	}
+	spendRows = append(spendRows, []string{"total", "", fmt.Sprint(len(p.Transactions)), "", p.TotalFee.String()})
+	renderList([]string{"Job ID", "Name", "Transactions", "Gas used", "Fee (ETH)"}, spendRows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ShowETHKeyUsage shows the jobs which use an ETH key and what its
+// transactions spent on gas, or saves the transactions as CSV with --csv
+func (cli *Client) ShowETHKeyUsage(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key"))
+	}
+	usageURL := url.URL{Path: "/v2/keys/eth/" + c.Args().First() + "/usage"}
+	query := usageURL.Query()
+	for _, flag := range []string{"since", "until", "evmChainID"} {
+		if c.IsSet(flag) {
+			query.Set(flag, c.String(flag))
+		}
+	}
+	output := c.String("csv")
+	if output != "" {
+		query.Set("format", "csv")
+	}
+	usageURL.RawQuery = query.Encode()
+
+	resp, err := cli.HTTP.Get(usageURL.String())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if output != "" {
+		var data []byte
+		if data, err = cli.parseResponse(resp); err != nil {
+			return err
+		}
+		if err = utils.WriteFileWithMaxPerms(output, data, 0644); err != nil {
+			return cli.errorOut(errors.Wrapf(err, "Could not write %v", output))
+		}
+		fmt.Printf("Saved the transactions of ETH key %s to %s\n", c.Args().First(), output)
+		return nil
+	}
+	return cli.renderAPIResponse(resp, &EthKeyUsagePresenter{}, "🔑 ETH key usage")
+}
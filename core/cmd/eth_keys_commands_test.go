@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -159,6 +160,91 @@ func TestClient_CreateETHKey(t *testing.T) {
 	// require.Len(t, states, 1)
 }
 
+func TestClient_ETHHDWallet(t *testing.T) {
+	t.Parallel()
+
+	ethClient := newEthMock(t)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(42), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+	app := startNewApplication(t,
+		withKey(),
+		withMocks(ethClient),
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMEnabled = null.BoolFrom(true)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		}),
+	)
+	client, r := app.NewClientAndRenderer()
+
+	// the development mnemonic of hardhat, whose first account is 0xf39F...2266
+	mnemonicFile := filepath.Join(t.TempDir(), "mnemonic")
+	require.NoError(t, os.WriteFile(mnemonicFile, []byte("test test test test test test test test test test test junk\n"), 0600))
+	set := flag.NewFlagSet("test", 0)
+	set.String("mnemonic", mnemonicFile, "")
+	require.NoError(t, set.Set("mnemonic", mnemonicFile))
+	require.NoError(t, client.CreateETHHDWallet(cli.NewContext(nil, set, nil)))
+	hdWallet := *r.Renders[len(r.Renders)-1].(*cmd.EthHDWalletPresenter)
+	assert.True(t, hdWallet.Enabled)
+	assert.Empty(t, hdWallet.Mnemonic)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Uint("count", 0, "")
+	require.NoError(t, set.Set("count", "2"))
+	require.NoError(t, client.RecoverETHHDKeys(cli.NewContext(nil, set, nil)))
+	keys := *r.Renders[len(r.Renders)-1].(*cmd.EthKeyPresenters)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", keys[0].Address)
+
+	require.NoError(t, client.ShowETHHDWallet(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+	hdWallet = *r.Renders[len(r.Renders)-1].(*cmd.EthHDWalletPresenter)
+	assert.Equal(t, uint32(2), hdWallet.NextIndex)
+}
+
+func TestClient_ETHKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	ethClient := newEthMock(t)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(42), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+	app := startNewApplication(t,
+		withKey(),
+		withMocks(ethClient),
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMEnabled = null.BoolFrom(true)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		}),
+	)
+	client, r := app.NewClientAndRenderer()
+
+	key, err := app.GetKeyStore().Eth().Create(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	borm := cltest.NewTxmORM(t, app.GetSqlxDB(), app.GetConfig())
+	etx := cltest.MustInsertConfirmedEthTxWithReceipt(t, borm, key.Address.Address(), 0, 1)
+
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{key.Address.Hex()}))
+	require.NoError(t, client.ShowETHKeyUsage(cli.NewContext(nil, set, nil)))
+	usage := *r.Renders[len(r.Renders)-1].(*cmd.EthKeyUsagePresenter)
+	assert.Equal(t, key.Address.Hex(), usage.Address)
+	require.Len(t, usage.Transactions, 1)
+	assert.Equal(t, etx.ID, usage.Transactions[0].ID)
+
+	output := filepath.Join(t.TempDir(), "usage.csv")
+	set = flag.NewFlagSet("test", 0)
+	set.String("csv", "", "")
+	require.NoError(t, set.Set("csv", output))
+	require.NoError(t, set.Parse([]string{key.Address.Hex()}))
+	require.NoError(t, client.ShowETHKeyUsage(cli.NewContext(nil, set, nil)))
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "id,hash,nonce,to,value"))
+	assert.True(t, strings.HasPrefix(lines[1], strconv.FormatInt(etx.ID, 10)+","))
+}
+
 func TestClient_UpdateETHKey(t *testing.T) {
 	t.Parallel()
 
@@ -5,12 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/job/lint"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -257,3 +262,174 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunReplayPresenter wraps the JSONAPI pipeline run replay resource
+// and renders the per-task diff.
+type PipelineRunReplayPresenter struct {
+	JAID
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Recorded", "Original", "Replayed", "Changed"})
+	for _, diff := range p.Diffs {
+		table.Append([]string{
+			diff.DotID,
+			string(diff.Type),
+			strconv.FormatBool(diff.Recorded),
+			friendlyTaskRunResult(diff.Original),
+			friendlyTaskRunResult(diff.Replayed),
+			strconv.FormatBool(diff.Changed),
+		})
+	}
+
+	render(fmt.Sprintf("Replay of Pipeline Run %s", p.GetID()), table)
+	return nil
+}
+
+func friendlyTaskRunResult(tr *presenters.PipelineTaskRunResource) string {
+	switch {
+	case tr == nil:
+		return "N/A"
+	case tr.Error != nil:
+		return "error: " + *tr.Error
+	case tr.Output != nil:
+		return *tr.Output
+	default:
+		return "null"
+	}
+}
+
+// ReplayPipelineRun re-executes a stored pipeline run with the recorded
+// results of its external tasks and shows a diff against the original run
+func (cli *Client) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the pipeline run id to replay"))
+	}
+
+	var request web.ReplayPipelineRunRequest
+	if c.IsSet("job-id") {
+		jobID := int32(c.Int("job-id"))
+		request.JobID = &jobID
+	}
+	if dotFile := c.String("dot"); dotFile != "" {
+		buf, err2 := ioutil.ReadFile(dotFile)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrapf(err2, "error reading from file '%s'", dotFile))
+		}
+		request.DotDagSource = string(buf)
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/pipeline/runs/"+c.Args().First()+"/replay", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &PipelineRunReplayPresenter{})
+	return err
+}
+
+// JobLintProblem is a problem found in a job spec file.
+type JobLintProblem struct {
+	File string `json:"file"`
+	lint.Problem
+}
+
+// JobLintPresenter renders the problems found in job spec files.
+type JobLintPresenter []JobLintProblem
+
+// RenderTable implements TableRenderer
+func (ps JobLintPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"File", "Severity", "Problem"})
+	for _, p := range ps {
+		table.Append([]string{p.File, string(p.Severity), p.Message})
+	}
+
+	render("Job Spec Problems", table)
+	return nil
+}
+
+// LintJobs validates job spec files without creating the jobs, and reports
+// settings and pipeline tasks which would be ignored or fail at run time. With
+// --fix, the pipelines in the files are rewritten in the canonical layout
+// first.
+func (cli *Client) LintJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of at least one job spec"))
+	}
+
+	opts := lint.Opts{Config: lint.Config{General: cli.Config}}
+	if c.Bool("check-bridges") {
+		opts.BridgeExists = cli.bridgeExists
+	}
+	var problems JobLintPresenter
+	var failed int
+	for _, file := range c.Args() {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			return cli.errorOut(errors.Wrapf(err, "error reading from file '%s'", file))
+		}
+		spec := string(buf)
+		var found []lint.Problem
+		if c.Bool("fix") {
+			formatted, ferr := job.FormatTOML(spec)
+			if ferr != nil {
+				found = append(found, lint.Problem{Severity: lint.SeverityError, Message: "failed to format: " + ferr.Error()})
+			} else if formatted != spec {
+				if err = ioutil.WriteFile(file, []byte(formatted), 0600); err != nil {
+					return cli.errorOut(errors.Wrapf(err, "error writing to file '%s'", file))
+				}
+				spec = formatted
+			}
+		}
+		found = append(found, lint.Lint(spec, opts)...)
+		hasErrors := false
+		for _, p := range found {
+			problems = append(problems, JobLintProblem{File: file, Problem: p})
+			hasErrors = hasErrors || p.Severity == lint.SeverityError
+		}
+		if hasErrors {
+			failed++
+		}
+	}
+
+	if err = cli.Render(problems); err != nil {
+		return cli.errorOut(err)
+	}
+	if failed > 0 {
+		return cli.errorOut(errors.Errorf("%d of %d job specs have errors", failed, len(c.Args())))
+	}
+	return nil
+}
+
+// bridgeExists asks the node whether the bridge called name exists.
+func (cli *Client) bridgeExists(name string) (exists bool, err error) {
+	resp, err := cli.HTTP.Get("/v2/bridge_types/" + url.PathEscape(name))
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		_, err = cli.parseResponse(resp)
+		return false, err
+	}
+}
@@ -3,6 +3,8 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/job/lint"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -405,3 +408,64 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestClient_LintJobs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{Name: "voter_turnout"})
+	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.toml")
+	require.NoError(t, ioutil.WriteFile(valid, []byte(`
+type = "cron"
+schemaVersion = 1
+   schedule = "CRON_TZ=UTC * 0 0 1 1 *"
+observationSource = """
+ds [type=bridge name=voter_turnout]; ds_parse [type=jsonparse path="data"]; ds->ds_parse
+"""
+`), 0600))
+	invalid := filepath.Join(dir, "invalid.toml")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte(`
+type = "cron"
+schemaVersion = 1
+schedule = "CRON_TZ=UTC * 0 0 1 1 *"
+observationSource = """
+ds [type=bridge name=nope];
+"""
+`), 0600))
+
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("fix", true, "")
+	set.Bool("check-bridges", true, "")
+	require.NoError(t, set.Parse([]string{valid}))
+	require.NoError(t, client.LintJobs(cli.NewContext(nil, set, nil)))
+	assert.Empty(t, r.Renders[0])
+
+	formatted, err := ioutil.ReadFile(valid)
+	require.NoError(t, err)
+	assert.Equal(t, `
+type = "cron"
+schemaVersion = 1
+   schedule = "CRON_TZ=UTC * 0 0 1 1 *"
+observationSource = """
+ds       [type=bridge name=voter_turnout];
+ds_parse [type=jsonparse path=data];
+
+ds -> ds_parse;
+"""
+`, string(formatted))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("check-bridges", true, "")
+	require.NoError(t, set.Parse([]string{valid, invalid}))
+	err = client.LintJobs(cli.NewContext(nil, set, nil))
+	assert.EqualError(t, err, "1 of 2 job specs have errors")
+	assert.Equal(t, cmd.JobLintPresenter{{
+		File:    invalid,
+		Problem: lint.Problem{Severity: lint.SeverityError, Message: `observationSource: task ds: bridge "nope" does not exist`},
+	}}, r.Renders[1])
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type KeeperUpkeepPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.KeeperUpkeepResource
+}
+
+var keeperUpkeepsHeaders = []string{"ID", "Job ID", "Registry", "Upkeep ID", "Checks", "Eligible", "Performs", "Success Rate", "Avg Gas Used", "LINK Payment"}
+
+// ToRow presents the KeeperUpkeepResource as a slice of strings.
+func (p *KeeperUpkeepPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		strconv.Itoa(int(p.JobID)),
+		p.RegistryAddress.String(),
+		p.UpkeepID.String(),
+		strconv.FormatInt(p.ChecksCount, 10),
+		strconv.FormatInt(p.EligibleCount, 10),
+		fmt.Sprintf("%d (%d succeeded)", p.PerformsCount, p.PerformsSucceeded),
+		fmt.Sprintf("%.2f%%", p.SuccessRate*100),
+		strconv.FormatInt(p.AverageGasUsed, 10),
+		p.LinkPayment.Link(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *KeeperUpkeepPresenter) RenderTable(rt RendererTable) error {
+	renderList(keeperUpkeepsHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// KeeperUpkeepPresenters implements TableRenderer for a slice of KeeperUpkeepPresenter.
+type KeeperUpkeepPresenters []KeeperUpkeepPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeeperUpkeepPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(keeperUpkeepsHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListKeeperUpkeeps lists the upkeeps of keeper jobs, optionally of a single
+// job, along with their stats
+func (cli *Client) ListKeeperUpkeeps(c *cli.Context) (err error) {
+	requestURI := "/v2/keeper/upkeeps"
+	if c.IsSet("job") {
+		requestURI += "?" + url.Values{"jobID": {c.String("job")}}.Encode()
+	}
+	return cli.getPage(requestURI, c.Int("page"), &KeeperUpkeepPresenters{})
+}
+
+type KeeperUpkeepSimulationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.KeeperUpkeepSimulationResource
+}
+
+var keeperUpkeepSimulationHeaders = []string{
+	"Upkeep ID", "Job ID", "Registry", "Block",
+	"Turn Taking", "Keeper Index", "Turn Keeper Index", "Positioning Constant", "Turn Block", "Last Run Block", "Last Keeper Index", "Grace Period", "Eligible",
+	"Check Gas Limit", "Gas Price", "checkUpkeep", "Max LINK Payment",
+	"Perform Gas Limit", "Perform Gas Estimate",
+	"From Address", "From Balance",
+}
+
+// ToRow presents the KeeperUpkeepSimulationResource as a slice of strings.
+func (p *KeeperUpkeepSimulationPresenter) ToRow() []string {
+	turnTaking := fmt.Sprintf("block count per turn %d", p.BlockCountPerTurn)
+	turnBlock := strconv.FormatInt(p.TurnBlockNumber, 10)
+	if p.TurnFlagEnabled {
+		turnTaking = fmt.Sprintf("block hash (look back %d blocks), %s", p.TurnLookBack, turnTaking)
+		if p.TurnBlockHash != nil {
+			turnBlock = fmt.Sprintf("%s (hash of block %d: %s)", turnBlock, p.TurnBlockNumber-p.TurnLookBack, p.TurnBlockHash.Hex())
+		}
+	}
+
+	gasPrice := "n/a"
+	switch {
+	case p.GasEstimationError != "":
+		gasPrice = "error: " + p.GasEstimationError
+	case p.GasPrice != nil:
+		gasPrice = p.GasPrice.String() + " wei"
+	case p.GasFeeCap != nil:
+		gasPrice = fmt.Sprintf("tip cap %s wei, fee cap %s wei", p.GasTipCap, p.GasFeeCap)
+	}
+
+	checkUpkeep := "not called"
+	switch {
+	case p.CheckUpkeepSuccess:
+		checkUpkeep = fmt.Sprintf("needs perform, performData %s", p.PerformData)
+	case p.CheckUpkeepRevertReason != "":
+		checkUpkeep = "reverted: " + p.CheckUpkeepRevertReason
+	case p.CheckUpkeepError != "":
+		checkUpkeep = "failed: " + p.CheckUpkeepError
+	}
+
+	maxLinkPayment := "n/a"
+	if p.MaxLinkPayment != nil {
+		maxLinkPayment = p.MaxLinkPayment.Link()
+	}
+
+	performGasEstimate := "n/a"
+	switch {
+	case p.PerformUpkeepGasEstimate != nil:
+		performGasEstimate = strconv.FormatUint(*p.PerformUpkeepGasEstimate, 10)
+	case p.PerformUpkeepGasEstimateError != "":
+		performGasEstimate = "error: " + p.PerformUpkeepGasEstimateError
+	}
+
+	fromBalance := "n/a"
+	switch {
+	case p.FromAddressError != "":
+		fromBalance = "error: " + p.FromAddressError
+	case p.FromAddressBalance != nil:
+		fromBalance = p.FromAddressBalance.String()
+	}
+
+	return []string{
+		p.GetID(),
+		strconv.Itoa(int(p.JobID)),
+		p.RegistryAddress.String(),
+		strconv.FormatInt(p.BlockNumber, 10),
+		turnTaking,
+		fmt.Sprintf("%d of %d keepers", p.KeeperIndex, p.NumKeepers),
+		nullInt64String(p.TurnKeeperIndex),
+		strconv.Itoa(int(p.PositioningConstant)),
+		turnBlock,
+		strconv.FormatInt(p.LastRunBlockHeight, 10),
+		nullInt64String(p.LastKeeperIndex),
+		fmt.Sprintf("%d blocks", p.GracePeriod),
+		fmt.Sprintf("%t (%s)", p.Eligible, p.EligibilityReason),
+		strconv.FormatUint(p.CheckUpkeepGasLimit, 10),
+		gasPrice,
+		checkUpkeep,
+		maxLinkPayment,
+		strconv.FormatUint(p.PerformUpkeepGasLimit, 10),
+		performGasEstimate,
+		p.FromAddress.String(),
+		fromBalance,
+	}
+}
+
+func nullInt64String(i null.Int64) string {
+	if !i.Valid {
+		return "none"
+	}
+	return strconv.FormatInt(i.Int64, 10)
+}
+
+// RenderTable implements TableRenderer
+func (p *KeeperUpkeepSimulationPresenter) RenderTable(rt RendererTable) error {
+	renderList(keeperUpkeepSimulationHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// SimulateKeeperUpkeep evaluates, on the node, whether a keeper job would
+// perform an upkeep at the latest or the given block, without performing it
+func (cli *Client) SimulateKeeperUpkeep(c *cli.Context) (err error) {
+	if !c.IsSet("job") || !c.IsSet("upkeep") {
+		return cli.errorOut(errors.New("must pass the --job and --upkeep to simulate"))
+	}
+	query := url.Values{
+		"jobID":    {c.String("job")},
+		"upkeepID": {c.String("upkeep")},
+	}
+	if c.IsSet("block") {
+		query.Set("block", c.String("block"))
+	}
+	resp, err := cli.HTTP.Get("/v2/keeper/simulate?" + query.Encode())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeeperUpkeepSimulationPresenter{})
+}
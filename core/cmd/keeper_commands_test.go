@@ -0,0 +1,110 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestKeeperUpkeepPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id       = "1"
+		registry = ethkey.EIP55Address("0x5431F5F973781809D18643b87B44921b11355d81")
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.KeeperUpkeepPresenter{
+		KeeperUpkeepResource: presenters.KeeperUpkeepResource{
+			JAID:              presenters.NewJAID(id),
+			JobID:             2,
+			RegistryAddress:   registry,
+			UpkeepID:          *utils.NewBigI(3),
+			ChecksCount:       10,
+			EligibleCount:     4,
+			PerformsCount:     4,
+			PerformsSucceeded: 3,
+			SuccessRate:       0.75,
+			AverageGasUsed:    50000,
+			LinkPayment:       assets.NewLinkFromJuels(1000),
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, registry.String())
+	assert.Contains(t, output, "4 (3 succeeded)")
+	assert.Contains(t, output, "75.00%")
+	assert.Contains(t, output, "50000")
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.KeeperUpkeepPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, registry.String())
+	assert.Contains(t, output, "75.00%")
+}
+
+func TestKeeperUpkeepSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer      = bytes.NewBufferString("")
+		r           = cmd.RendererTable{Writer: buffer}
+		turnHash    = common.HexToHash("0x1234")
+		gasEstimate = uint64(120_000)
+	)
+
+	p := cmd.KeeperUpkeepSimulationPresenter{
+		KeeperUpkeepSimulationResource: presenters.KeeperUpkeepSimulationResource{
+			JAID:                     presenters.NewJAID("123"),
+			JobID:                    2,
+			BlockNumber:              105,
+			TurnFlagEnabled:          true,
+			KeeperIndex:              0,
+			NumKeepers:               3,
+			BlockCountPerTurn:        20,
+			TurnBlockNumber:          100,
+			TurnLookBack:             5,
+			TurnBlockHash:            &turnHash,
+			TurnKeeperIndex:          null.Int64From(0),
+			Eligible:                 true,
+			EligibilityReason:        "it is this keeper's turn",
+			GasPrice:                 utils.NewBigI(1000),
+			CheckUpkeepGasLimit:      650_000,
+			CheckUpkeepSuccess:       true,
+			PerformData:              []byte{0x12, 0x34},
+			PerformUpkeepGasLimit:    300_000,
+			PerformUpkeepGasEstimate: &gasEstimate,
+			FromAddressError:         "not a sending key",
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "123")
+	assert.Contains(t, output, "0 of 3 keepers")
+	assert.Contains(t, output, "hash of block 95: "+turnHash.Hex())
+	assert.Contains(t, output, "true (it is this keeper's turn)")
+	assert.Contains(t, output, "1000 wei")
+	assert.Contains(t, output, "needs perform, performData 0x1234")
+	assert.Contains(t, output, "120000")
+	assert.Contains(t, output, "error: not a sending key")
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeyringRestorePresenter implements TableRenderer for a KeyringRestoreResource.
+type KeyringRestorePresenter struct {
+	webpresenters.KeyringRestoreResource
+}
+
+// RenderTable implements TableRenderer
+func (p KeyringRestorePresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Type", "ID", "Status"})
+	appendRows := func(ids map[string][]string, status string) {
+		var types []string
+		for typ := range ids {
+			types = append(types, typ)
+		}
+		sort.Strings(types)
+		for _, typ := range types {
+			for _, id := range ids[typ] {
+				table.Append([]string{typ, id, status})
+			}
+		}
+	}
+	appendRows(p.Restored, "restored")
+	appendRows(p.Skipped, "skipped")
+	render("Restored keys", table)
+	return nil
+}
+
+// readPasswordFlag returns the trimmed content of the password file given by
+// the flag name.
+func readPasswordFlag(c *clipkg.Context, name string) (string, error) {
+	path := c.String(name)
+	if path == "" {
+		return "", errors.Errorf("Must specify --%s flag", name)
+	}
+	password, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "Could not read password file")
+	}
+	return strings.TrimSpace(string(password)), nil
+}
+
+// RotateKeystorePassword re-encrypts the keystore of the node with a new
+// password.
+func (cli *Client) RotateKeystorePassword(c *clipkg.Context) (err error) {
+	oldPassword, err := readPasswordFlag(c, "oldpassword")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	newPassword, err := readPasswordFlag(c, "newpassword")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	request, err := json.Marshal(web.ChangeKeystorePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/keys/password", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Println("Keystore password changed. Start the node with the new password from now on.")
+	return nil
+}
+
+// BackupKeys saves all of the keys of the node to a password protected file.
+func (cli *Client) BackupKeys(c *clipkg.Context) (err error) {
+	output := c.String("output")
+	if output == "" {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+	newPassword, err := readPasswordFlag(c, "newpassword")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	backupURL := url.URL{Path: "/v2/keys/backup"}
+	query := backupURL.Query()
+	query.Set("newpassword", newPassword)
+	backupURL.RawQuery = query.Encode()
+
+	resp, err := cli.HTTP.Post(backupURL.String(), nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	backup, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	if err = utils.WriteFileWithMaxPerms(output, backup, 0600); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", output))
+	}
+
+	_, err = os.Stderr.WriteString("🔑 Saved keys to " + output + "\n")
+	return cli.errorOut(err)
+}
+
+// RestoreKeys restores the keys of a file saved by BackupKeys.
+func (cli *Client) RestoreKeys(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the backup to be restored"))
+	}
+	backup, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	oldPassword, err := readPasswordFlag(c, "oldpassword")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	restoreURL := url.URL{Path: "/v2/keys/restore"}
+	query := restoreURL.Query()
+	query.Set("oldpassword", oldPassword)
+	restoreURL.RawQuery = query.Encode()
+
+	resp, err := cli.HTTP.Post(restoreURL.String(), bytes.NewReader(backup))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyringRestorePresenter{})
+}
@@ -11,11 +11,13 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -33,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/shutdown"
@@ -70,6 +73,11 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "config validation failed")
 	}
+	if path := cli.Config.ConfigFile(); path != "" {
+		if err = validateConfigFile(path); err != nil {
+			return errors.Wrap(err, "config validation failed")
+		}
+	}
 
 	lggr.Infow(fmt.Sprintf("Starting Chainlink Node %s at commit %s", static.Version, static.Sha), "Version", static.Version, "SHA", static.Sha)
 
@@ -246,6 +254,11 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		return nil
 	})
 
+	grp.Go(func() error {
+		reloadConfigOnSIGHUP(grpCtx, app, lggr)
+		return nil
+	})
+
 	lggr.Debug("Environment variables\n", config.NewConfigPrinter(cli.Config))
 
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
@@ -264,6 +277,30 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	return grp.Wait()
 }
 
+// reloadConfigOnSIGHUP reloads the config of app each time the process
+// receives SIGHUP, until ctx is done.
+func reloadConfigOnSIGHUP(ctx context.Context, app chainlink.Application, lggr logger.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			result, err := app.ReloadConfig()
+			if err != nil {
+				lggr.Errorw("Failed to reload config", "err", err)
+				continue
+			}
+			if len(result.RequiresRestart) > 0 {
+				lggr.Warnw("Some changed settings only take effect after a restart", "settings", result.RequiresRestart)
+			}
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure `$CLROOT/tls` directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
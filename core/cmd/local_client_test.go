@@ -136,6 +136,7 @@ EVM_RPC_ENABLED: true
 ETH_HTTP_URL: 
 ETH_SECONDARY_URLS: []
 ETH_URL: 
+EVENTS_ETH_TX_ENABLED: false
 EXPLORER_URL: 
 FM_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
 FEATURE_EXTERNAL_INITIATORS: false
@@ -149,6 +150,8 @@ KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
 KEEPER_GAS_PRICE_BUFFER_PERCENT: 20
 KEEPER_GAS_TIP_CAP_BUFFER_PERCENT: 20
 KEEPER_BASE_FEE_BUFFER_PERCENT: 20
+KEEPER_CHECK_UPKEEP_BATCH_SIZE: 50
+KEEPER_EXECUTION_QUEUE_SIZE: 10
 KEEPER_MAXIMUM_GRACE_PERIOD: 0
 KEEPER_REGISTRY_CHECK_GAS_OVERHEAD: 0
 KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD: 0
@@ -227,7 +230,7 @@ func TestClient_RunNodeWithPasswords(t *testing.T) {
 			keyStore := cltest.NewKeyStore(t, db, cfg)
 			sessionORM := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
 			// Clear out fixture
-			err := sessionORM.DeleteUser()
+			_, err := db.Exec("DELETE FROM users")
 			require.NoError(t, err)
 
 			app := new(mocks.Application)
@@ -341,7 +344,7 @@ func TestClient_RunNodeWithAPICredentialsFile(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
 			sessionORM := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
 			// Clear out fixture
-			err := sessionORM.DeleteUser()
+			_, err := db.Exec("DELETE FROM users")
 			require.NoError(t, err)
 			keyStore := cltest.NewKeyStore(t, db, cfg)
 			_, err = keyStore.Eth().Create(&cltest.FixtureChainID)
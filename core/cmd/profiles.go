@@ -0,0 +1,522 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// ProfilesFileName is the name of the profiles file looked up in the root
+// directory when no --profiles-file is given.
+//
+// Each profile names a node that remote commands can be run against:
+//
+//	[[Profiles]]
+//	Name = "node-1"
+//	URL = "https://node-1.example.com:6689"
+//	CredentialsFile = "/home/me/.chainlink/node-1.credentials"
+//	TLSCAFile = "/home/me/.chainlink/ca.pem"
+const ProfilesFileName = "profiles.toml"
+
+// allProfiles selects every profile in the profiles file.
+const allProfiles = "all"
+
+// profileName keeps profile names usable as file name suffixes, for the cookie of each.
+var profileName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Profile is a named node that remote commands can be run against. It
+// implements HTTPClientConfig.
+type Profile struct {
+	Name string `toml:"Name"`
+	URL  string `toml:"URL"`
+	// CredentialsFile, if set, is used to log in whenever the session has
+	// expired, like --file does for admin login.
+	CredentialsFile       string `toml:"CredentialsFile"`
+	TLSInsecureSkipVerify bool   `toml:"TLSInsecureSkipVerify"`
+	// TLSCAFile is a PEM file of certificate authorities to trust besides the
+	// system ones.
+	TLSCAFile string `toml:"TLSCAFile"`
+
+	rootCAs *x509.CertPool
+}
+
+// ClientNodeURL is the URL of the node.
+func (p Profile) ClientNodeURL() string {
+	return strings.TrimSuffix(p.URL, "/")
+}
+
+// InsecureSkipVerify is whether TLS certificates of the node go unverified.
+func (p Profile) InsecureSkipVerify() bool {
+	return p.TLSInsecureSkipVerify
+}
+
+// RootCAs are the certificate authorities trusted for the node, or nil for the
+// system ones.
+func (p Profile) RootCAs() *x509.CertPool {
+	return p.rootCAs
+}
+
+// Profiles are the profiles of a profiles file, in the order listed.
+type Profiles []Profile
+
+// LoadProfiles reads and validates the profiles file at path, and loads the
+// certificate authorities of each profile.
+func LoadProfiles(path string) (Profiles, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read profiles file")
+	}
+	ps, err := ParseProfiles(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid profiles file %s", path)
+	}
+	for i := range ps {
+		if ps[i].TLSCAFile == "" {
+			continue
+		}
+		pem, err := ioutil.ReadFile(ps[i].TLSCAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read TLSCAFile of profile %s", ps[i].Name)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in TLSCAFile of profile %s", ps[i].Name)
+		}
+		ps[i].rootCAs = pool
+	}
+	return ps, nil
+}
+
+// ParseProfiles decodes and validates the TOML contents of a profiles file.
+func ParseProfiles(b []byte) (Profiles, error) {
+	var f struct {
+		Profiles Profiles `toml:"Profiles"`
+	}
+	if err := toml.NewDecoder(bytes.NewReader(b)).Strict(true).Decode(&f); err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	for i, p := range f.Profiles {
+		switch {
+		case p.Name == "":
+			return nil, errors.Errorf("profile %d: Name is required", i+1)
+		case p.Name == allProfiles:
+			return nil, errors.Errorf("profile %d: Name %q is reserved", i+1, allProfiles)
+		case !profileName.MatchString(p.Name):
+			return nil, errors.Errorf("profile %s: Name may only contain letters, digits, '-' and '_'", p.Name)
+		}
+		if _, ok := seen[p.Name]; ok {
+			return nil, errors.Errorf("profile %s: duplicate Name", p.Name)
+		}
+		seen[p.Name] = struct{}{}
+		u, err := url.Parse(p.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errors.Errorf("profile %s: URL must be an http or https URL, got %q", p.Name, p.URL)
+		}
+	}
+	return f.Profiles, nil
+}
+
+// Select returns the named profiles, in the order given, or every profile if
+// the only name is "all".
+func (ps Profiles) Select(names []string) (Profiles, error) {
+	if len(names) == 1 && names[0] == allProfiles {
+		if len(ps) == 0 {
+			return nil, errors.New("no profiles in the profiles file")
+		}
+		return ps, nil
+	}
+	var selected Profiles
+	for _, name := range names {
+		p, ok := ps.find(name)
+		if !ok {
+			return nil, errors.Errorf("unknown profile %q", name)
+		}
+		selected = append(selected, p)
+	}
+	return selected, nil
+}
+
+func (ps Profiles) find(name string) (Profile, bool) {
+	for _, p := range ps {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+// localCommands run against the local node, database or files, rather than
+// over the remote API, so they take no profile flags. A command is local if it or any of
+// its parents is listed.
+var localCommands = map[string]struct{}{
+	"config dump":                   {},
+	"config validate":               {},
+	"jobs lint":                     {},
+	"node db":                       {},
+	"node rebroadcast-transactions": {},
+	"node setnextnonce":             {},
+	"node start":                    {},
+}
+
+// withProfileFlags adds the --profile and --profiles flags to each remote
+// command in cmds and its subcommands.
+func withProfileFlags(client *Client, cmds []clipkg.Command) []clipkg.Command {
+	return addProfileFlags(client, "", cmds)
+}
+
+func addProfileFlags(client *Client, parent string, cmds []clipkg.Command) []clipkg.Command {
+	for i := range cmds {
+		name := strings.TrimSpace(strings.Split(cmds[i].Name, ",")[0])
+		if parent != "" {
+			name = parent + " " + name
+		}
+		if _, ok := localCommands[name]; ok {
+			continue
+		}
+		if len(cmds[i].Subcommands) > 0 {
+			cmds[i].Subcommands = addProfileFlags(client, name, cmds[i].Subcommands)
+			continue
+		}
+		action, ok := cmds[i].Action.(func(*clipkg.Context) error)
+		if !ok {
+			continue
+		}
+		cmds[i].Flags = append(cmds[i].Flags,
+			clipkg.StringFlag{
+				Name:  "profile",
+				Usage: "name of the profile in the profiles file to run the command against",
+			},
+			clipkg.StringFlag{
+				Name:  "profiles",
+				Usage: `comma separated profile names, or "all", to run the command against each of them`,
+			},
+		)
+		cmds[i].Action = client.withProfiles(action)
+	}
+	return cmds
+}
+
+// withProfiles runs action against the node of the --profile flag, or against
+// each of the nodes of the --profiles flag. Without either, action runs against
+// CLIENT_NODE_URL.
+func (cli *Client) withProfiles(action func(*clipkg.Context) error) func(*clipkg.Context) error {
+	return func(c *clipkg.Context) error {
+		single, multi := c.String("profile"), c.String("profiles")
+		if single == "" && multi == "" {
+			return action(c)
+		}
+		if single != "" && multi != "" {
+			return cli.errorOut(errors.New("--profile and --profiles are mutually exclusive"))
+		}
+		ps, err := LoadProfiles(cli.profilesFile(c))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		if single != "" {
+			selected, err := ps.Select([]string{single})
+			if err != nil {
+				return cli.errorOut(err)
+			}
+			restore, err := cli.useProfile(selected[0])
+			if err != nil {
+				return cli.errorOut(err)
+			}
+			defer restore()
+			return action(c)
+		}
+		selected, err := ps.Select(strings.Split(multi, ","))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		return cli.fanOut(c, selected, action)
+	}
+}
+
+// profilesFile is the --profiles-file, or the profiles file in the root directory.
+func (cli *Client) profilesFile(c *clipkg.Context) string {
+	if path := c.GlobalString("profiles-file"); path != "" {
+		return path
+	}
+	return filepath.Join(cli.Config.RootDir(), ProfilesFileName)
+}
+
+// useProfile points the remote client at the node of p, with its own cookie,
+// until the returned func is called.
+func (cli *Client) useProfile(p Profile) (restore func(), err error) {
+	var sessionRequest sessions.SessionRequest
+	if p.CredentialsFile != "" {
+		sessionRequest, err = cli.FileSessionRequestBuilder.Build(p.CredentialsFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read CredentialsFile of profile %s", p.Name)
+		}
+	}
+	prevHTTP, prevCookieAuth := cli.HTTP, cli.CookieAuthenticator
+	cli.CookieAuthenticator = NewSessionCookieAuthenticator(p, DiskCookieStore{Config: cli.Config, Profile: p.Name}, cli.Logger)
+	cli.HTTP = NewAuthenticatedHTTPClient(p, cli.CookieAuthenticator, sessionRequest)
+	return func() {
+		cli.HTTP, cli.CookieAuthenticator = prevHTTP, prevCookieAuth
+	}, nil
+}
+
+// fanOut runs action against each of the profiles in turn, and renders what
+// each node returned together. Tables are combined into one, with a row per
+// item returned by each node, led by the name of its profile. JSON output is a
+// single list holding the output of each node.
+func (cli *Client) fanOut(c *clipkg.Context, profiles Profiles, action func(*clipkg.Context) error) error {
+	renderer := cli.Renderer
+	defer func() { cli.Renderer = renderer }()
+
+	var results ProfileResultPresenters
+	var failed int
+	for _, p := range profiles {
+		result := ProfileResultPresenter{Profile: p.Name, URL: p.ClientNodeURL()}
+		recorder := &recordingRenderer{}
+		cli.Renderer = recorder
+		if err := cli.runWithProfile(c, p, action); err != nil {
+			failed++
+			result.Error = err.Error()
+		}
+		result.Output = recorder.output()
+		results = append(results, result)
+	}
+
+	cli.Renderer = renderer
+	if err := cli.Render(&results); err != nil {
+		return cli.errorOut(err)
+	}
+	if failed > 0 {
+		return cli.errorOut(errors.Errorf("command failed on %d of %d profiles", failed, len(profiles)))
+	}
+	return nil
+}
+
+func (cli *Client) runWithProfile(c *clipkg.Context, p Profile, action func(*clipkg.Context) error) error {
+	restore, err := cli.useProfile(p)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return action(c)
+}
+
+// recordingRenderer keeps what is rendered, for fanOut to render all of it at once.
+type recordingRenderer struct {
+	rendered []interface{}
+}
+
+func (r *recordingRenderer) Render(v interface{}, _ ...string) error {
+	r.rendered = append(r.rendered, v)
+	return nil
+}
+
+// output is the single value rendered, or all of them if there were several.
+func (r *recordingRenderer) output() interface{} {
+	switch len(r.rendered) {
+	case 0:
+		return nil
+	case 1:
+		return r.rendered[0]
+	default:
+		return r.rendered
+	}
+}
+
+// ProfileResultPresenter is the outcome of running a command against the node
+// of one profile.
+type ProfileResultPresenter struct {
+	Profile string      `json:"profile"`
+	URL     string      `json:"url"`
+	Error   string      `json:"error,omitempty"`
+	Output  interface{} `json:"output,omitempty"`
+}
+
+// ProfileResultPresenters implements TableRenderer for a slice of
+// ProfileResultPresenter.
+type ProfileResultPresenters []ProfileResultPresenter
+
+// RenderTable implements TableRenderer. The outputs of all the nodes are
+// rendered as one table, with a column per field of what any node returned.
+// Nodes which returned nothing get a row of their own, as do failed nodes,
+// whose error is in the last column.
+func (ps ProfileResultPresenters) RenderTable(rt RendererTable) error {
+	var columns []string
+	seen := map[string]bool{}
+	outputs := make([][]map[string]string, len(ps))
+	var failed bool
+	for i, p := range ps {
+		var rowColumns []string
+		rowColumns, outputs[i] = tableRows(p.Output)
+		for _, c := range rowColumns {
+			if !seen[c] {
+				seen[c] = true
+				columns = append(columns, c)
+			}
+		}
+		failed = failed || p.Error != ""
+	}
+
+	headers := append([]string{"Profile"}, columns...)
+	if failed {
+		headers = append(headers, "Error")
+	}
+	table := rt.newTable(headers)
+	for i, p := range ps {
+		rows := outputs[i]
+		if len(rows) == 0 || p.Error != "" {
+			rows = append(rows, map[string]string{})
+		}
+		for j, values := range rows {
+			row := []string{p.Profile}
+			for _, c := range columns {
+				row = append(row, values[c])
+			}
+			if failed {
+				if j == len(rows)-1 {
+					row = append(row, p.Error)
+				} else {
+					row = append(row, "")
+				}
+			}
+			table.Append(row)
+		}
+	}
+	render("Profiles", table)
+	return nil
+}
+
+// tableRows flattens what a command rendered into table rows: a row per
+// element of lists, and a column per exported field of structs, including
+// those of embedded structs. Other values are in a single "Value" column.
+func tableRows(v interface{}) (columns []string, rows []map[string]string) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	addRow := func(rowColumns []string, row map[string]string) {
+		for _, c := range rowColumns {
+			if !seen[c] {
+				seen[c] = true
+				columns = append(columns, c)
+			}
+		}
+		rows = append(rows, row)
+	}
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		for i := 0; i < rv.Len(); i++ {
+			elemColumns, elemRows := tableRows(rv.Index(i).Interface())
+			for _, row := range elemRows {
+				addRow(elemColumns, row)
+			}
+		}
+		return columns, rows
+	}
+	if rv.Kind() != reflect.Struct || isCell(rv) {
+		return []string{"Value"}, []map[string]string{{"Value": formatCell(rv)}}
+	}
+	row := map[string]string{}
+	addRow(structCells(rv, row), row)
+	return columns, rows
+}
+
+// structCells sets a cell in row for each exported field of the struct rv and
+// of the structs it embeds, and returns their names in order. Fields shadowed
+// by a field of the same name higher up are skipped.
+func structCells(rv reflect.Value, row map[string]string) (columns []string) {
+	for i := 0; i < rv.NumField(); i++ {
+		field, value := rv.Type().Field(i), rv.Field(i)
+		if field.Anonymous {
+			for value.Kind() == reflect.Ptr && !value.IsNil() {
+				value = value.Elem()
+			}
+			if value.Kind() == reflect.Struct && !isCell(value) {
+				columns = append(columns, structCells(value, row)...)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if _, ok := row[field.Name]; ok {
+			continue
+		}
+		row[field.Name] = formatCell(value)
+		columns = append(columns, field.Name)
+	}
+	return columns
+}
+
+// isCell returns true if rv is presented as a single cell although it is a
+// struct, e.g. a time.Time.
+func isCell(rv reflect.Value) bool {
+	_, ok := asStringer(rv)
+	return ok
+}
+
+// asStringer returns rv, or a pointer to it, if it is a fmt.Stringer.
+func asStringer(rv reflect.Value) (fmt.Stringer, bool) {
+	if !rv.CanInterface() {
+		return nil, false
+	}
+	if s, ok := rv.Interface().(fmt.Stringer); ok {
+		return s, true
+	}
+	if rv.CanAddr() {
+		s, ok := rv.Addr().Interface().(fmt.Stringer)
+		return s, ok
+	}
+	return nil, false
+}
+
+// formatCell presents a value as the contents of a single cell.
+func formatCell(rv reflect.Value) string {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return ""
+		}
+		if s, ok := asStringer(rv); ok {
+			return s.String()
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || !rv.CanInterface() {
+		return ""
+	}
+	if s, ok := asStringer(rv); ok {
+		return s.String()
+	}
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.IsNil() {
+		return ""
+	}
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		b, err := json.Marshal(rv.Interface())
+		if err != nil {
+			return fmt.Sprint(rv.Interface())
+		}
+		return string(b)
+	default:
+		return fmt.Sprint(rv.Interface())
+	}
+}
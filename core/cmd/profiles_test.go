@@ -0,0 +1,293 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestParseProfiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		toml    string
+		want    []string
+		wantErr string
+	}{
+		{"empty", "", nil, ""},
+		{"valid", `
+[[Profiles]]
+Name = "node-1"
+URL = "https://node-1.example.com:6689"
+TLSInsecureSkipVerify = true
+
+[[Profiles]]
+Name = "node_2"
+URL = "http://localhost:6688"
+CredentialsFile = "/tmp/credentials"
+`, []string{"node-1", "node_2"}, ""},
+		{"unknown key", `
+[[Profiles]]
+Name = "node-1"
+URL = "http://localhost:6688"
+Password = "hunter2"
+`, nil, "Password"},
+		{"missing name", `
+[[Profiles]]
+URL = "http://localhost:6688"
+`, nil, "profile 1: Name is required"},
+		{"reserved name", `
+[[Profiles]]
+Name = "all"
+URL = "http://localhost:6688"
+`, nil, `Name "all" is reserved`},
+		{"unsafe name", `
+[[Profiles]]
+Name = "../node"
+URL = "http://localhost:6688"
+`, nil, "Name may only contain"},
+		{"duplicate name", `
+[[Profiles]]
+Name = "node"
+URL = "http://localhost:6688"
+
+[[Profiles]]
+Name = "node"
+URL = "http://localhost:6689"
+`, nil, "profile node: duplicate Name"},
+		{"bad url", `
+[[Profiles]]
+Name = "node"
+URL = "localhost:6688"
+`, nil, "URL must be an http or https URL"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ps, err := cmd.ParseProfiles([]byte(tt.toml))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, p := range ps {
+				names = append(names, p.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestProfiles_Select(t *testing.T) {
+	t.Parallel()
+
+	ps := cmd.Profiles{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+	selected, err := ps.Select([]string{"all"})
+	require.NoError(t, err)
+	assert.Equal(t, ps, selected)
+
+	selected, err = ps.Select([]string{"c", "a"})
+	require.NoError(t, err)
+	assert.Equal(t, cmd.Profiles{{Name: "c"}, {Name: "a"}}, selected)
+
+	_, err = ps.Select([]string{"a", "d"})
+	assert.EqualError(t, err, `unknown profile "d"`)
+
+	_, err = cmd.Profiles{}.Select([]string{"all"})
+	assert.Error(t, err)
+}
+
+func TestLoadProfiles_TLSCAFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0600))
+	path := filepath.Join(dir, cmd.ProfilesFileName)
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(`
+[[Profiles]]
+Name = "node"
+URL = "https://localhost:6689"
+TLSCAFile = %q
+`, caFile)), 0600))
+
+	_, err := cmd.LoadProfiles(path)
+	assert.EqualError(t, err, "no certificates found in TLSCAFile of profile node")
+
+	_, err = cmd.LoadProfiles(filepath.Join(dir, "missing.toml"))
+	assert.Error(t, err)
+}
+
+// findCommand returns the command at path in the command tree of app.
+func findCommand(t *testing.T, app *cli.App, path ...string) cli.Command {
+	cmds := app.Commands
+	var found cli.Command
+	for _, name := range path {
+		for _, c := range cmds {
+			if c.Name == name {
+				found = c
+				cmds = c.Subcommands
+				break
+			}
+		}
+		require.Equal(t, name, found.Name)
+	}
+	return found
+}
+
+func TestNewApp_ProfileFlags(t *testing.T) {
+	t.Parallel()
+
+	app := cmd.NewApp(&cmd.Client{Config: configtest.NewTestGeneralConfig(t)})
+
+	hasProfileFlag := func(path ...string) bool {
+		for _, f := range findCommand(t, app, path...).Flags {
+			if f.GetName() == "profile" {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.True(t, hasProfileFlag("jobs", "list"))
+	assert.True(t, hasProfileFlag("node", "doctor"))
+	assert.True(t, hasProfileFlag("config", "reload"))
+	assert.False(t, hasProfileFlag("node", "start"))
+	assert.False(t, hasProfileFlag("node", "db", "migrate"))
+	assert.False(t, hasProfileFlag("config", "validate"))
+	assert.False(t, hasProfileFlag("jobs", "lint"))
+}
+
+func TestClient_Profiles_FanOut(t *testing.T) {
+	t.Parallel()
+
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/jobs", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"errors":[{"detail":"boom"}]}`))
+	}))
+	defer failing.Close()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.SetRootDir(t.TempDir())
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.RootDir(), cmd.ProfilesFileName), []byte(fmt.Sprintf(`
+[[Profiles]]
+Name = "ok"
+URL = %q
+
+[[Profiles]]
+Name = "failing"
+URL = %q
+`, ok.URL, failing.URL)), 0600))
+
+	var b bytes.Buffer
+	client := &cmd.Client{
+		Renderer: cmd.RendererJSON{Writer: &b},
+		Config:   cfg,
+		Logger:   logger.TestLogger(t),
+	}
+	listJobs := findCommand(t, cmd.NewApp(client), "jobs", "list").Action.(func(*cli.Context) error)
+	run := func(profile, profiles string) error {
+		set := flag.NewFlagSet("test", 0)
+		set.Int("page", 0, "")
+		set.String("profile", profile, "")
+		set.String("profiles", profiles, "")
+		return listJobs(cli.NewContext(nil, set, nil))
+	}
+
+	err := run("", "all")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "command failed on 1 of 2 profiles")
+
+	var results []map[string]interface{}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &results))
+	require.Len(t, results, 2)
+	assert.Equal(t, "ok", results[0]["profile"])
+	assert.Equal(t, ok.URL, results[0]["url"])
+	assert.NotContains(t, results[0], "error")
+	assert.Contains(t, results[0], "output")
+	assert.Equal(t, "failing", results[1]["profile"])
+	assert.Contains(t, results[1]["error"], "boom")
+
+	// A single --profile runs the command as usual against that node
+	b.Reset()
+	require.NoError(t, run("ok", ""))
+	assert.JSONEq(t, "[]", b.String())
+
+	assert.EqualError(t, run("ok", "all"), "--profile and --profiles are mutually exclusive")
+	assert.EqualError(t, run("", "ok,missing"), `unknown profile "missing"`)
+}
+
+func TestProfileResultPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	type Resource struct {
+		ID string
+	}
+	type presenter struct {
+		Resource
+		Name      string
+		CreatedAt time.Time
+		Tags      []string
+	}
+	createdAt := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	ps := cmd.ProfileResultPresenters{
+		{Profile: "a", URL: "http://a:6688", Output: &[]presenter{
+			{Resource{"1"}, "job-1", createdAt, []string{"x"}},
+			{Resource{"2"}, "job-2", createdAt, nil},
+		}},
+		{Profile: "b", URL: "http://b:6688", Error: "boom"},
+		{Profile: "c", URL: "http://c:6688", Output: &[]presenter{}},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, ps.RenderTable(cmd.RendererTable{Writer: &b}))
+	lines := strings.Split(b.String(), "\n")
+	row := func(cells ...string) string {
+		for _, l := range lines {
+			var fields []string
+			for _, f := range strings.Split(l, "║") {
+				if f = strings.TrimSpace(f); f != "" {
+					fields = append(fields, f)
+				}
+			}
+			if reflect.DeepEqual(fields, cells) {
+				return l
+			}
+		}
+		return ""
+	}
+
+	assert.NotEmpty(t, row("PROFILE", "ID", "NAME", "CREATEDAT", "TAGS", "ERROR"), b.String())
+	assert.NotEmpty(t, row("a", "1", "job-1", createdAt.String(), `["x"]`), b.String())
+	assert.NotEmpty(t, row("a", "2", "job-2", createdAt.String()), b.String())
+	assert.NotEmpty(t, row("b", "boom"), b.String())
+	assert.NotEmpty(t, row("c"), b.String())
+}
@@ -412,6 +412,39 @@ func (cli *Client) GetConfiguration(c *clipkg.Context) (err error) {
 	return err
 }
 
+// ConfigReloadPresenter implements TableRenderer for a ConfigReloadResource.
+type ConfigReloadPresenter struct {
+	webpresenters.ConfigReloadResource
+}
+
+// RenderTable implements TableRenderer
+func (p ConfigReloadPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Setting", "Status"})
+	for _, name := range p.Applied {
+		table.Append([]string{name, "applied"})
+	}
+	for _, name := range p.RequiresRestart {
+		table.Append([]string{name, "requires restart"})
+	}
+	render("Changed settings", table)
+	return nil
+}
+
+// ReloadConfiguration asks the node to re-read its config files and apply the
+// changed settings that can change while it is running.
+func (cli *Client) ReloadConfiguration(c *clipkg.Context) (err error) {
+	resp, err := cli.HTTP.Post("/v2/config/reload", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	return cli.renderAPIResponse(resp, &ConfigReloadPresenter{})
+}
+
 func normalizePassword(password string) string {
 	return url.QueryEscape(strings.TrimSpace(password))
 }
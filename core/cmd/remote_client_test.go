@@ -569,6 +569,21 @@ func TestClient_GetConfiguration(t *testing.T) {
 	assert.Equal(t, cp.EnvPrinter.SessionTimeout, cfg.SessionTimeout())
 }
 
+func TestClient_GetConfigProvenance(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	require.NoError(t, client.GetConfigProvenance(cltest.EmptyCLIContext()))
+	require.Equal(t, 1, len(r.Renders))
+
+	ps := *r.Renders[0].(*cmd.ConfigProvenancePresenters)
+	require.NotEmpty(t, ps)
+	assert.Equal(t, "global", ps[0].ID)
+	assert.NotEmpty(t, ps[0].ToRows())
+}
+
 func TestClient_RunOCRJob_HappyPath(t *testing.T) {
 	t.Parallel()
 
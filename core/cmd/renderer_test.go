@@ -114,6 +114,30 @@ func TestRendererTable_PatchResponse(t *testing.T) {
 	assert.Regexp(t, regexp.MustCompile("53276"), output)
 }
 
+func TestRendererTable_PipelineRunReplay(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	original, replayed, failed := `{"USD": 1}`, "3", "uh oh"
+	replay := cmd.PipelineRunReplayPresenter{
+		JAID: cmd.JAID{ID: "42"},
+		PipelineRunReplayResource: webpresenters.PipelineRunReplayResource{
+			Changed: true,
+			Diffs: []webpresenters.PipelineTaskRunDiffResource{
+				{DotID: "ds", Type: "http", Recorded: true, Original: &webpresenters.PipelineTaskRunResource{Output: &original}, Replayed: &webpresenters.PipelineTaskRunResource{Output: &original}},
+				{DotID: "answer", Type: "multiply", Original: &webpresenters.PipelineTaskRunResource{Error: &failed}, Replayed: &webpresenters.PipelineTaskRunResource{Output: &replayed}, Changed: true},
+			},
+		},
+	}
+
+	assert.NoError(t, r.Render(&replay))
+	output := buffer.String()
+	assert.Contains(t, output, "error: uh oh")
+	assert.Regexp(t, regexp.MustCompile(`answer\s+║\s+multiply\s+║\s+false`), output)
+}
+
 func TestRendererTable_RenderUnknown(t *testing.T) {
 	t.Parallel()
 	r := cmd.RendererTable{Writer: ioutil.Discard}
@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type VRFRequestLookupPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFRequestLookupResource
+}
+
+var vrfRequestLookupHeaders = []string{
+	"Version", "Job ID", "Job Name", "Request ID", "Request Tx", "Status", "Error",
+	"Subscription", "Confirmations", "Attempts", "Balance Check", "Pipeline Run", "Fulfillments",
+}
+
+// ToRow presents the VRFRequestLookupResource as a slice of strings.
+func (p *VRFRequestLookupPresenter) ToRow() []string {
+	requestID := p.RequestID
+	if requestID == "" {
+		requestID = "unknown"
+	}
+
+	subscription := "n/a"
+	if p.SubID != nil {
+		subscription = fmt.Sprintf("%d (chain %s)", *p.SubID, p.EVMChainID)
+	}
+
+	confirmations := "n/a"
+	if p.MinRequestConfirmations != nil {
+		confirmations = fmt.Sprintf("waiting for %d, until block %d", *p.MinRequestConfirmations, *p.ConfirmedAtBlock)
+		if p.Confirmations != nil {
+			confirmations = fmt.Sprintf("%d of %d (request block %d, latest block %d)",
+				*p.Confirmations, *p.MinRequestConfirmations, *p.RequestBlockNumber, *p.LatestBlockNumber)
+		}
+	}
+
+	attempts := "n/a"
+	if p.Attempts != nil {
+		attempts = strconv.FormatInt(*p.Attempts, 10)
+		if p.LastTryAt != nil {
+			attempts += ", last at " + p.LastTryAt.String()
+		}
+	}
+
+	balance := "n/a"
+	if p.Balance != nil {
+		balance = vrfBalanceString(*p.Balance)
+	}
+
+	pipelineRun := "n/a"
+	if p.PipelineRunID != nil {
+		pipelineRun = strconv.FormatInt(*p.PipelineRunID, 10)
+	}
+
+	return []string{
+		strconv.Itoa(p.Version),
+		strconv.Itoa(int(p.JobID)),
+		p.JobName,
+		requestID,
+		p.RequestTxHash.Hex(),
+		p.Status,
+		stringOrNone(p.Error),
+		subscription,
+		confirmations,
+		attempts,
+		balance,
+		pipelineRun,
+		vrfFulfillmentsString(p.Fulfillments),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFRequestLookupPresenter) RenderTable(rt RendererTable) error {
+	renderList(vrfRequestLookupHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// VRFRequestLookupPresenters implements TableRenderer for a slice of VRFRequestLookupPresenter.
+type VRFRequestLookupPresenters []VRFRequestLookupPresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFRequestLookupPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(vrfRequestLookupHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+type VRFSubscriptionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFSubscriptionResource
+}
+
+var vrfSubscriptionHeaders = []string{
+	"Subscription ID", "EVM Chain ID", "Coordinator", "Owner", "Balance", "Reserved LINK", "Available LINK", "Error",
+	"Pending Requests", "Pending Fulfillments",
+}
+
+// ToRow presents the VRFSubscriptionResource as a slice of strings.
+func (p *VRFSubscriptionPresenter) ToRow() []string {
+	coordinator, owner := "unknown", "unknown"
+	if p.CoordinatorAddress != nil {
+		coordinator = p.CoordinatorAddress.Hex()
+	}
+	if p.Owner != nil {
+		owner = p.Owner.Hex()
+	}
+
+	var requests []string
+	for _, req := range p.PendingRequests {
+		requests = append(requests, fmt.Sprintf("%s (job %d, %s, %d attempts)", req.RequestID.String(), req.JobID, req.State, req.Attempts))
+	}
+	pendingRequests := "none"
+	if len(requests) > 0 {
+		pendingRequests = strings.Join(requests, "\n")
+	}
+
+	return []string{
+		strconv.FormatUint(p.SubID, 10),
+		p.EVMChainID.String(),
+		coordinator,
+		owner,
+		linkOrNA(p.Balance),
+		linkOrNA(p.ReservedLink),
+		linkOrNA(p.AvailableLink),
+		stringOrNone(p.Error),
+		pendingRequests,
+		vrfFulfillmentsString(p.PendingFulfillments),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFSubscriptionPresenter) RenderTable(rt RendererTable) error {
+	renderList(vrfSubscriptionHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+func vrfBalanceString(b presenters.VRFSubscriptionBalance) string {
+	if b.Error != nil && b.Balance == nil {
+		return "error: " + *b.Error
+	}
+	s := fmt.Sprintf("balance %s LINK, reserved %s LINK, available %s LINK", linkOrNA(b.Balance), linkOrNA(b.ReservedLink), linkOrNA(b.AvailableLink))
+	if b.Sufficient != nil {
+		s += fmt.Sprintf(", estimated fee %s LINK, sufficient: %t", linkOrNA(b.EstimatedFeeJuels), *b.Sufficient)
+	}
+	if b.Error != nil {
+		s += ", error: " + *b.Error
+	}
+	return s
+}
+
+func vrfFulfillmentsString(fulfillments []presenters.VRFFulfillment) string {
+	if len(fulfillments) == 0 {
+		return "none"
+	}
+	var lines []string
+	for _, f := range fulfillments {
+		line := fmt.Sprintf("eth tx %d: %s", f.EthTxID, f.State)
+		if f.Hash != nil {
+			line += ", hash " + f.Hash.Hex()
+		}
+		if f.BlockNumber != nil {
+			line += fmt.Sprintf(", block %d", *f.BlockNumber)
+		}
+		if f.GasUsed != nil {
+			line += fmt.Sprintf(", gas used %d", *f.GasUsed)
+		}
+		if f.Fee != nil {
+			line += fmt.Sprintf(", fee %s ETH", f.Fee.String())
+		}
+		if f.MaxLink != nil {
+			line += fmt.Sprintf(", max LINK %s", f.MaxLink.Link())
+		}
+		if f.Error != nil {
+			line += ", error: " + *f.Error
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func linkOrNA(l *assets.Link) string {
+	if l == nil {
+		return "n/a"
+	}
+	return l.Link()
+}
+
+func stringOrNone(s *string) string {
+	if s == nil {
+		return "none"
+	}
+	return *s
+}
+
+// ShowVRFRequest looks up the VRF v1 and v2 requests with a request ID or
+// sent in a transaction, and shows whether they were fulfilled, and if not why
+func (cli *Client) ShowVRFRequest(c *cli.Context) (err error) {
+	if !c.IsSet("request-id") && !c.IsSet("tx-hash") {
+		return cli.errorOut(errors.New("must pass the --request-id or the --tx-hash of the request"))
+	}
+	query := url.Values{}
+	if c.IsSet("request-id") {
+		query.Set("requestID", c.String("request-id"))
+	}
+	if c.IsSet("tx-hash") {
+		query.Set("txHash", c.String("tx-hash"))
+	}
+	resp, err := cli.HTTP.Get("/v2/vrf/lookup?" + query.Encode())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &VRFRequestLookupPresenters{})
+}
+
+// ShowVRFSubscription shows the balance of a VRF v2 subscription, the LINK
+// reserved for its pending fulfillments, and its pending requests
+func (cli *Client) ShowVRFSubscription(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the subscription"))
+	}
+	requestURI := "/v2/vrf/subscriptions/" + url.PathEscape(c.Args().First())
+	if c.IsSet("evm-chain-id") {
+		requestURI += "?" + url.Values{"evmChainID": {c.String("evm-chain-id")}}.Encode()
+	}
+	resp, err := cli.HTTP.Get(requestURI)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &VRFSubscriptionPresenter{})
+}
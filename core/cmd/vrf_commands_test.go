@@ -0,0 +1,130 @@
+package cmd_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestVRFRequestLookupPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer        = bytes.NewBufferString("")
+		r             = cmd.RendererTable{Writer: buffer}
+		revertReason  = "execution reverted: bad callback"
+		subID         = uint64(5)
+		minConfs      = uint16(3)
+		requestBlock  = uint64(10)
+		confirmedAt   = uint64(13)
+		latestBlock   = int64(12)
+		confirmations = int64(2)
+		attempts      = int64(1)
+		sufficient    = false
+		fulfillHash   = common.HexToHash("0xabcd")
+		gasUsed       = uint64(90_000)
+		runID         = int64(42)
+	)
+
+	ps := cmd.VRFRequestLookupPresenters{
+		{
+			VRFRequestLookupResource: presenters.VRFRequestLookupResource{
+				JAID:                    presenters.NewJAID("1-7"),
+				Version:                 2,
+				JobID:                   1,
+				RequestID:               "7",
+				Status:                  string(vrf.RequestStateSimulationReverted),
+				Error:                   &revertReason,
+				EVMChainID:              utils.NewBigI(1337),
+				SubID:                   &subID,
+				MinRequestConfirmations: &minConfs,
+				RequestBlockNumber:      &requestBlock,
+				ConfirmedAtBlock:        &confirmedAt,
+				LatestBlockNumber:       &latestBlock,
+				Confirmations:           &confirmations,
+				Attempts:                &attempts,
+				Balance: &presenters.VRFSubscriptionBalance{
+					Balance:           assets.NewLinkFromJuels(100),
+					ReservedLink:      assets.NewLinkFromJuels(40),
+					AvailableLink:     assets.NewLinkFromJuels(60),
+					EstimatedFeeJuels: assets.NewLinkFromJuels(80),
+					Sufficient:        &sufficient,
+				},
+			},
+		},
+		{
+			VRFRequestLookupResource: presenters.VRFRequestLookupResource{
+				JAID:          presenters.NewJAID("2-run-42"),
+				Version:       1,
+				JobID:         2,
+				Status:        vrf.RequestStatusFulfilled,
+				PipelineRunID: &runID,
+				Fulfillments: []presenters.VRFFulfillment{{
+					EthTxID: 3,
+					State:   "confirmed",
+					Hash:    &fulfillHash,
+					GasUsed: &gasUsed,
+					Fee:     (*assets.Eth)(big.NewInt(1e15)),
+				}},
+			},
+		},
+	}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, revertReason)
+	assert.Contains(t, output, "5 (chain 1337)")
+	assert.Contains(t, output, "2 of 3 (request block 10, latest block 12)")
+	assert.Contains(t, output, "sufficient: false")
+	assert.Contains(t, output, "unknown")
+	assert.Contains(t, output, fulfillHash.Hex())
+	assert.Contains(t, output, "gas used 90000")
+	assert.Contains(t, output, "fee 0.001000000000000000 ETH")
+}
+
+func TestVRFSubscriptionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer      = bytes.NewBufferString("")
+		r           = cmd.RendererTable{Writer: buffer}
+		coordinator = common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	)
+
+	p := cmd.VRFSubscriptionPresenter{
+		VRFSubscriptionResource: presenters.VRFSubscriptionResource{
+			JAID:               presenters.NewJAID("5"),
+			EVMChainID:         *utils.NewBigI(1337),
+			SubID:              5,
+			CoordinatorAddress: &coordinator,
+			VRFSubscriptionBalance: presenters.VRFSubscriptionBalance{
+				Balance:       assets.NewLinkFromJuels(100),
+				ReservedLink:  assets.NewLinkFromJuels(40),
+				AvailableLink: assets.NewLinkFromJuels(60),
+			},
+			PendingRequests: []presenters.VRFRequestV2Resource{{
+				JobID:     1,
+				RequestID: *utils.NewBigI(7),
+				State:     vrf.RequestStateInsufficientBalance,
+				Attempts:  2,
+			}},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, coordinator.Hex())
+	assert.Contains(t, output, "1337")
+	assert.Contains(t, output, "7 (job 1, insufficient_balance, 2 attempts)")
+	assert.Contains(t, output, "none")
+}
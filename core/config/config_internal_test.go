@@ -3,6 +3,9 @@ package config
 import (
 	"math/big"
 	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +32,178 @@ func TestGeneralConfig_Defaults(t *testing.T) {
 	assert.Equal(t, 15*time.Minute, config.SessionTimeout().Duration())
 }
 
+func TestGeneralConfig_LoadConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+LOG_LEVEL = "debug"
+BLOCK_BACKFILL_DEPTH = 20
+SESSION_TIMEOUT = "1h"
+ETH_GAS_BUMP_PERCENT = 25
+
+[[EVM]]
+ChainID = 1
+`), 0600))
+	t.Setenv(envvar.Name("SessionTimeout"), "30m")
+
+	config := NewGeneralConfig(logger.TestLogger(t))
+	require.NoError(t, config.LoadConfigFile(path))
+
+	assert.Equal(t, path, config.ConfigFile())
+	assert.Equal(t, zapcore.DebugLevel, config.LogLevel())
+	assert.Equal(t, uint64(20), config.BlockBackfillDepth())
+	// Global EVM settings, which have no defaults, are read from the file too
+	bumpPercent, ok := config.GlobalEvmGasBumpPercent()
+	assert.True(t, ok)
+	assert.Equal(t, uint16(25), bumpPercent)
+	_, ok = config.GlobalEvmGasBumpWei()
+	assert.False(t, ok)
+	// ENV takes precedence over the file
+	assert.Equal(t, 30*time.Minute, config.SessionTimeout().Duration())
+
+	err := config.LoadConfigFile(filepath.Join(t.TempDir(), "missing.toml"))
+	require.Error(t, err)
+}
+
+func TestGeneralConfig_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+LOG_LEVEL = "info"
+ETH_GAS_BUMP_PERCENT = 20
+TELEMETRY_INGRESS_URL = "https://a.example.com"
+`), 0600))
+	t.Setenv(envvar.Name("SessionTimeout"), "30m")
+
+	config := NewGeneralConfig(logger.TestLogger(t))
+	require.NoError(t, config.LoadConfigFile(path))
+
+	changed, err := config.Reload()
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+
+	// Runtime log level changes survive a reload that leaves LOG_LEVEL alone
+	require.NoError(t, config.SetLogLevel(zapcore.WarnLevel))
+	require.NoError(t, os.WriteFile(path, []byte(`
+LOG_LEVEL = "info"
+ETH_GAS_BUMP_PERCENT = 30
+SESSION_TIMEOUT = "1h"
+TELEMETRY_INGRESS_URL = "https://b.example.com"
+
+[[EVM]]
+ChainID = 1
+`), 0600))
+	changed, err = config.Reload()
+	require.NoError(t, err)
+	// SESSION_TIMEOUT is shadowed by the env
+	assert.Equal(t, []string{"ETH_GAS_BUMP_PERCENT", "EVM", "TELEMETRY_INGRESS_URL"}, changed)
+	assert.Equal(t, zapcore.WarnLevel, config.LogLevel())
+	assertGasBumpPercent(t, config, 30)
+	assert.Equal(t, "https://b.example.com", config.TelemetryIngressURL().String())
+	assert.Equal(t, 30*time.Minute, config.SessionTimeout().Duration())
+
+	// Removed keys revert to their defaults
+	require.NoError(t, os.WriteFile(path, []byte(`
+LOG_LEVEL = "debug"
+ETH_GAS_BUMP_PERCENT = 30
+SESSION_TIMEOUT = "1h"
+
+[[EVM]]
+ChainID = 1
+`), 0600))
+	changed, err = config.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"LOG_LEVEL", "TELEMETRY_INGRESS_URL"}, changed)
+	assert.Equal(t, zapcore.DebugLevel, config.LogLevel())
+	assert.Nil(t, config.TelemetryIngressURL())
+
+	// A broken file leaves the config untouched
+	require.NoError(t, os.WriteFile(path, []byte("LOG_LEVEL = \n"), 0600))
+	_, err = config.Reload()
+	require.Error(t, err)
+	assert.Equal(t, zapcore.DebugLevel, config.LogLevel())
+	assertGasBumpPercent(t, config, 30)
+}
+
+func TestGeneralConfig_ReloadConcurrently(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.toml")
+	require.NoError(t, os.WriteFile(path, []byte("ETH_GAS_BUMP_PERCENT = 20\n"), 0600))
+
+	config := NewGeneralConfig(logger.TestLogger(t))
+	require.NoError(t, config.LoadConfigFile(path))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			_, err := config.Reload()
+			assert.NoError(t, err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			p, ok := config.GlobalEvmGasBumpPercent()
+			assert.True(t, ok)
+			assert.Equal(t, uint16(20), p)
+			assert.Equal(t, uint64(10), config.BlockBackfillDepth())
+		}
+	}()
+	wg.Wait()
+}
+
+func TestNewReloadResult(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ReloadResult{Applied: []string{}, RequiresRestart: []string{}}, NewReloadResult(nil))
+	assert.Equal(t, ReloadResult{
+		Applied:         []string{"ETH_GAS_BUMP_PERCENT", "LOG_LEVEL"},
+		RequiresRestart: []string{"EVM", "TELEMETRY_INGRESS_URL"},
+	}, NewReloadResult([]string{"ETH_GAS_BUMP_PERCENT", "EVM", "LOG_LEVEL", "TELEMETRY_INGRESS_URL"}))
+}
+
+func TestGeneralConfig_ValueSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+SESSION_TIMEOUT = "1h"
+BLOCK_BACKFILL_DEPTH = 20
+`), 0600))
+	t.Setenv(envvar.Name("SessionTimeout"), "30m")
+
+	config := NewGeneralConfig(logger.TestLogger(t))
+	require.NoError(t, config.LoadConfigFile(path))
+
+	assert.Equal(t, []SourcedValue{
+		{Source: SourceEnv, Value: "30m"},
+		{Source: SourceFile, Value: "1h"},
+		{Source: SourceDefault, Value: "15m"},
+	}, config.ValueSources("SESSION_TIMEOUT"))
+	assert.Equal(t, []SourcedValue{
+		{Source: SourceFile, Value: "20"},
+		{Source: SourceDefault, Value: "10"},
+	}, config.ValueSources("BLOCK_BACKFILL_DEPTH"))
+	assert.Empty(t, config.ValueSources("ETH_URL"))
+
+	ps := make(map[string]Provenance)
+	for _, p := range GlobalProvenance(config) {
+		ps[p.Name] = p
+	}
+	assert.Equal(t, Provenance{
+		Name:   "SESSION_TIMEOUT",
+		Value:  "30m0s",
+		Source: SourceEnv,
+		Shadowed: []SourcedValue{
+			{Source: SourceFile, Value: "1h"},
+			{Source: SourceDefault, Value: "15m"},
+		},
+	}, ps["SESSION_TIMEOUT"])
+	assert.Equal(t, Provenance{
+		Name:     "ALLOW_ORIGINS",
+		Value:    "http://localhost:3000,http://localhost:6688",
+		Source:   SourceDefault,
+		Shadowed: []SourcedValue{},
+	}, ps["ALLOW_ORIGINS"])
+}
+
 func TestGeneralConfig_GlobalOCRDatabaseTimeout(t *testing.T) {
 	t.Setenv(envvar.Name("OCRDatabaseTimeout"), "3s")
 	config := NewGeneralConfig(logger.TestLogger(t))
@@ -155,3 +330,10 @@ func TestStore_boolParser(t *testing.T) {
 	_, err = parse.Bool("")
 	assert.Error(t, err)
 }
+
+func assertGasBumpPercent(t *testing.T, config GeneralConfig, expected uint16) {
+	t.Helper()
+	p, ok := config.GlobalEvmGasBumpPercent()
+	require.True(t, ok)
+	assert.Equal(t, expected, p)
+}
@@ -62,6 +62,7 @@ type ConfigSchema struct {
 	// Database
 	DatabaseListenerMaxReconnectDuration time.Duration `env:"DATABASE_LISTENER_MAX_RECONNECT_DURATION" default:"10m"` //nodoc
 	DatabaseListenerMinReconnectInterval time.Duration `env:"DATABASE_LISTENER_MIN_RECONNECT_INTERVAL" default:"1m"`  //nodoc
+	EventsEthTxEnabled                   bool          `env:"EVENTS_ETH_TX_ENABLED" default:"false"`
 	MigrateDatabase                      bool          `env:"MIGRATE_DATABASE" default:"true"`
 	ORMMaxIdleConns                      int           `env:"ORM_MAX_IDLE_CONNS" default:"10"`
 	ORMMaxOpenConns                      int           `env:"ORM_MAX_OPEN_CONNS" default:"20"`
@@ -80,6 +81,7 @@ type ConfigSchema struct {
 	DatabaseBackupURL              *url.URL      `env:"DATABASE_BACKUP_URL"`
 
 	// Logging
+	AuditLogFile      string         `env:"AUDIT_LOG_FILE"`
 	JSONConsole       bool           `env:"JSON_CONSOLE" default:"false"`
 	LogFileDir        string         `env:"LOG_FILE_DIR"`
 	LogLevel          zapcore.Level  `env:"LOG_LEVEL"`
@@ -134,6 +136,10 @@ type ConfigSchema struct {
 	EthereumSecondaryURL  string `env:"ETH_SECONDARY_URL"` //nodoc
 	EthereumSecondaryURLs string `env:"ETH_SECONDARY_URLS"`
 	EthereumURL           string `env:"ETH_URL"`
+	// Remote signer
+	EthRemoteSignerRetries uint          `env:"ETH_REMOTE_SIGNER_RETRIES" default:"2"`
+	EthRemoteSignerTimeout time.Duration `env:"ETH_REMOTE_SIGNER_TIMEOUT" default:"10s"`
+	EthRemoteSignerURL     *url.URL      `env:"ETH_REMOTE_SIGNER_URL"`
 	// Global
 	DefaultChainID *big.Int `env:"ETH_CHAIN_ID"`
 	// Per-chain overrides
@@ -270,6 +276,8 @@ type ConfigSchema struct {
 	KeeperGasPriceBufferPercent             uint32        `env:"KEEPER_GAS_PRICE_BUFFER_PERCENT" default:"20"`
 	KeeperGasTipCapBufferPercent            uint32        `env:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT" default:"20"`
 	KeeperBaseFeeBufferPercent              uint32        `env:"KEEPER_BASE_FEE_BUFFER_PERCENT" default:"20"`
+	KeeperCheckUpkeepBatchSize              uint32        `env:"KEEPER_CHECK_UPKEEP_BATCH_SIZE" default:"50"`
+	KeeperExecutionQueueSize                uint32        `env:"KEEPER_EXECUTION_QUEUE_SIZE" default:"10"`
 	KeeperMaximumGracePeriod                int64         `env:"KEEPER_MAXIMUM_GRACE_PERIOD" default:"100"`
 	KeeperRegistryCheckGasOverhead          uint64        `env:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD" default:"200000"`
 	KeeperRegistryPerformGasOverhead        uint64        `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
@@ -327,3 +335,15 @@ func DefaultValue(name string) (string, bool) {
 	log.Panicf("Invariant violated, no field of name %s found for DefaultValue", name)
 	return "", false
 }
+
+// DefaultValueForEnv looks up the default value by environment variable name
+func DefaultValueForEnv(env string) (string, bool) {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	for i := 0; i < schemaT.NumField(); i++ {
+		item := schemaT.Field(i)
+		if item.Tag.Get("env") == env {
+			return item.Tag.Lookup("default")
+		}
+	}
+	return "", false
+}
@@ -15,6 +15,7 @@ func TestConfigSchema(t *testing.T) {
 		"AdvisoryLockCheckInterval":                      "ADVISORY_LOCK_CHECK_INTERVAL",
 		"AdvisoryLockID":                                 "ADVISORY_LOCK_ID",
 		"AllowOrigins":                                   "ALLOW_ORIGINS",
+		"AuditLogFile":                                   "AUDIT_LOG_FILE",
 		"AuthenticatedRateLimit":                         "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":                   "AUTHENTICATED_RATE_LIMIT_PERIOD",
 		"AutoPprofBlockProfileRate":                      "AUTO_PPROF_BLOCK_PROFILE_RATE",
@@ -57,6 +58,9 @@ func TestConfigSchema(t *testing.T) {
 		"Dev":                                            "CHAINLINK_DEV",
 		"EVMEnabled":                                     "EVM_ENABLED",
 		"EVMRPCEnabled":                                  "EVM_RPC_ENABLED",
+		"EthRemoteSignerRetries":                         "ETH_REMOTE_SIGNER_RETRIES",
+		"EthRemoteSignerTimeout":                         "ETH_REMOTE_SIGNER_TIMEOUT",
+		"EthRemoteSignerURL":                             "ETH_REMOTE_SIGNER_URL",
 		"EthTxReaperInterval":                            "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                           "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                      "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -91,6 +95,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmNonceAutoSync":                               "ETH_NONCE_AUTO_SYNC",
 		"EvmUseForwarders":                               "ETH_USE_FORWARDERS",
 		"EvmRPCDefaultBatchSize":                         "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EventsEthTxEnabled":                             "EVENTS_ETH_TX_ENABLED",
 		"ExplorerAccessKey":                              "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                                 "EXPLORER_SECRET",
 		"ExplorerURL":                                    "EXPLORER_URL",
@@ -121,6 +126,8 @@ func TestConfigSchema(t *testing.T) {
 		"KeeperGasPriceBufferPercent":                    "KEEPER_GAS_PRICE_BUFFER_PERCENT",
 		"KeeperGasTipCapBufferPercent":                   "KEEPER_GAS_TIP_CAP_BUFFER_PERCENT",
 		"KeeperBaseFeeBufferPercent":                     "KEEPER_BASE_FEE_BUFFER_PERCENT",
+		"KeeperCheckUpkeepBatchSize":                     "KEEPER_CHECK_UPKEEP_BATCH_SIZE",
+		"KeeperExecutionQueueSize":                       "KEEPER_EXECUTION_QUEUE_SIZE",
 		"KeeperMaximumGracePeriod":                       "KEEPER_MAXIMUM_GRACE_PERIOD",
 		"KeeperRegistryCheckGasOverhead":                 "KEEPER_REGISTRY_CHECK_GAS_OVERHEAD",
 		"KeeperRegistryPerformGasOverhead":               "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
@@ -8,7 +8,9 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -63,6 +65,11 @@ type FeatureFlags interface {
 
 type GeneralOnlyConfig interface {
 	Validate() error
+	LoadConfigFile(path string) error
+	// LookupEnv returns the value of the environment variable k or, if it is
+	// not set, the value of k in the config files.
+	LookupEnv(k string) (string, bool)
+	Reload() ([]string, error)
 	SetLogLevel(lvl zapcore.Level) error
 	SetLogSQL(logSQL bool)
 
@@ -72,6 +79,7 @@ type GeneralOnlyConfig interface {
 	AdvisoryLockCheckInterval() time.Duration
 	AdvisoryLockID() int64
 	AllowOrigins() string
+	AuditLogFile() string
 	AppID() uuid.UUID
 	AuthenticatedRateLimit() int64
 	AuthenticatedRateLimitPeriod() models.Duration
@@ -91,6 +99,8 @@ type GeneralOnlyConfig interface {
 	BridgeResponseURL() *url.URL
 	CertFile() string
 	ClientNodeURL() string
+	ConfigFile() string
+	ValueSources(name string) []SourcedValue
 	DatabaseBackupDir() string
 	DatabaseBackupFrequency() time.Duration
 	DatabaseBackupMode() DatabaseBackupMode
@@ -107,10 +117,14 @@ type GeneralOnlyConfig interface {
 	DefaultLogLevel() zapcore.Level
 	Dev() bool
 	ShutdownGracePeriod() time.Duration
+	EthRemoteSignerRetries() uint
+	EthRemoteSignerTimeout() time.Duration
+	EthRemoteSignerURL() *url.URL
 	EthereumHTTPURL() *url.URL
 	EthereumNodes() string
 	EthereumSecondaryURLs() []url.URL
 	EthereumURL() string
+	EventsEthTxEnabled() bool
 	ExplorerAccessKey() string
 	ExplorerSecret() string
 	ExplorerURL() *url.URL
@@ -130,6 +144,8 @@ type GeneralOnlyConfig interface {
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
 	KeeperBaseFeeBufferPercent() uint32
+	KeeperCheckUpkeepBatchSize() uint32
+	KeeperExecutionQueueSize() uint32
 	KeeperMaximumGracePeriod() int64
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
@@ -251,6 +267,8 @@ type GeneralConfig interface {
 type generalConfig struct {
 	lggr             logger.Logger
 	viper            *viper.Viper
+	fileViper        *viper.Viper // values set by config files only, for ValueSources
+	viperMu          sync.RWMutex // guards viper, fileViper and configFile, which are replaced on reload
 	secretGenerator  SecretGenerator
 	randomP2PPort    uint16
 	randomP2PPortMtx sync.RWMutex
@@ -262,6 +280,8 @@ type generalConfig struct {
 	logMutex         sync.RWMutex
 	genAppID         sync.Once
 	appID            uuid.UUID
+	configFile       string
+	rootConfigFile   string
 }
 
 // NewGeneralConfig returns the config with the environment variables set to their
@@ -275,20 +295,12 @@ func NewGeneralConfig(lggr logger.Logger) GeneralConfig {
 }
 
 func newGeneralConfigWithViper(v *viper.Viper, lggr logger.Logger) (config *generalConfig) {
-	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
-	for index := 0; index < schemaT.NumField(); index++ {
-		item := schemaT.FieldByIndex([]int{index})
-		name := item.Tag.Get("env")
-		def, exists := item.Tag.Lookup("default")
-		if exists {
-			v.SetDefault(name, def)
-		}
-		_ = v.BindEnv(name, name)
-	}
+	bindSchema(v)
 
 	config = &generalConfig{
-		lggr:  lggr,
-		viper: v,
+		lggr:      lggr,
+		viper:     v,
+		fileViper: viper.New(),
 	}
 
 	if err := utils.EnsureDirAndMaxPerms(config.RootDir(), os.FileMode(0700)); err != nil {
@@ -300,6 +312,10 @@ func newGeneralConfigWithViper(v *viper.Viper, lggr logger.Logger) (config *gene
 	err := v.ReadInConfig()
 	if err != nil && reflect.TypeOf(err) != configFileNotFoundError {
 		lggr.Warnf("Unable to load config file: %v\n", err)
+	} else if err == nil {
+		config.rootConfigFile = v.ConfigFileUsed()
+		config.fileViper.SetConfigFile(v.ConfigFileUsed())
+		_ = config.fileViper.ReadInConfig()
 	}
 
 	ll, invalid := envvar.LogLevel.Parse()
@@ -314,6 +330,165 @@ func newGeneralConfigWithViper(v *viper.Viper, lggr logger.Logger) (config *gene
 	return
 }
 
+// bindSchema sets the defaults of the settings in v, and binds each of them to
+// its environment variable.
+func bindSchema(v *viper.Viper) {
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.FieldByIndex([]int{index})
+		name := item.Tag.Get("env")
+		def, exists := item.Tag.Lookup("default")
+		if exists {
+			v.SetDefault(name, def)
+		}
+		_ = v.BindEnv(name, name)
+	}
+}
+
+// getViper returns the viper holding the effective value of each setting.
+// Viper is not safe for concurrent use, so rather than being modified it is
+// replaced whenever the config files are read again.
+func (c *generalConfig) getViper() *viper.Viper {
+	c.viperMu.RLock()
+	defer c.viperMu.RUnlock()
+	return c.viper
+}
+
+// getFileViper returns the viper holding the values set by the config files.
+func (c *generalConfig) getFileViper() *viper.Viper {
+	c.viperMu.RLock()
+	defer c.viperMu.RUnlock()
+	return c.fileViper
+}
+
+// LoadConfigFile merges the settings of the TOML file at path into the
+// config. Keys are environment variable names, and any environment variables
+// that are set continue to take precedence over the file.
+func (c *generalConfig) LoadConfigFile(path string) error {
+	v, fileV, err := readVipers(c.rootConfigFile, path)
+	if err != nil {
+		return err
+	}
+
+	ll, invalid, err := envvar.LogLevel.ParseFrom(v.GetString)
+	if err != nil {
+		return err
+	}
+	if invalid != "" {
+		c.lggr.Error(invalid)
+	}
+
+	c.viperMu.Lock()
+	c.viper, c.fileViper, c.configFile = v, fileV, path
+	c.viperMu.Unlock()
+
+	c.logMutex.Lock()
+	defer c.logMutex.Unlock()
+	c.defaultLogLevel = ll
+	c.logLevel = ll
+	c.logSQL = v.GetBool(envvar.Name("LogSQL"))
+	return nil
+}
+
+// Reload re-reads the config files, i.e. the config file in the root directory
+// and any file passed to LoadConfigFile, and returns the names of the settings
+// whose effective values changed. Environment variables are not re-read, since
+// they cannot change while the node is running.
+func (c *generalConfig) Reload() ([]string, error) {
+	// A broken file leaves the config untouched, as the new vipers are only
+	// swapped in once both have been read
+	v, fileV, err := readVipers(c.rootConfigFile, c.ConfigFile())
+	if err != nil {
+		return nil, err
+	}
+
+	c.viperMu.Lock()
+	before := settingsSnapshot(c.viper, c.fileViper)
+	c.viper, c.fileViper = v, fileV
+	c.viperMu.Unlock()
+	after := settingsSnapshot(v, fileV)
+
+	var changed []string
+	for name, v := range after {
+		if before[name] != v {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	c.logMutex.Lock()
+	defer c.logMutex.Unlock()
+	if before[envvar.Name("LogLevel")] != after[envvar.Name("LogLevel")] {
+		ll, invalid, err := envvar.LogLevel.ParseFrom(v.GetString)
+		if err != nil {
+			return nil, err
+		}
+		if invalid != "" {
+			c.lggr.Error(invalid)
+		}
+		c.defaultLogLevel = ll
+		c.logLevel = ll
+	}
+	if before[envvar.Name("LogSQL")] != after[envvar.Name("LogSQL")] {
+		c.logSQL = v.GetBool(envvar.Name("LogSQL"))
+	}
+	return changed, nil
+}
+
+// settingsSnapshot returns the raw effective value of each setting in v, plus
+// the EVM tables of the config files in fileV.
+func settingsSnapshot(v, fileV *viper.Viper) map[string]string {
+	snapshot := map[string]string{"EVM": fmt.Sprint(fileV.Get("EVM"))}
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	for i := 0; i < schemaT.NumField(); i++ {
+		name := schemaT.Field(i).Tag.Get("env")
+		snapshot[name] = v.GetString(name)
+	}
+	return snapshot
+}
+
+// readVipers returns new vipers holding the effective value of each setting,
+// and the values set by the config files only, read from rootConfigFile and
+// configFile.
+func readVipers(rootConfigFile, configFile string) (v, fileV *viper.Viper, err error) {
+	v = viper.New()
+	bindSchema(v)
+	if err = readConfigFiles(v, rootConfigFile, configFile); err != nil {
+		return nil, nil, err
+	}
+	fileV = viper.New()
+	if err = readConfigFiles(fileV, rootConfigFile, configFile); err != nil {
+		return nil, nil, err
+	}
+	return v, fileV, nil
+}
+
+// readConfigFiles replaces any config file values in v with those read from
+// rootConfigFile, then merges in those of the TOML configFile. Either may be
+// empty.
+func readConfigFiles(v *viper.Viper, rootConfigFile, configFile string) error {
+	if rootConfigFile != "" {
+		v.SetConfigFile(rootConfigFile)
+		v.SetConfigType(strings.TrimPrefix(filepath.Ext(rootConfigFile), "."))
+		if err := v.ReadInConfig(); err != nil {
+			return errors.Wrapf(err, "failed to load config file %s", rootConfigFile)
+		}
+	} else {
+		v.SetConfigType("toml")
+		if err := v.ReadConfig(strings.NewReader("")); err != nil {
+			return errors.Wrap(err, "failed to clear config file values")
+		}
+	}
+	if configFile != "" {
+		v.SetConfigFile(configFile)
+		v.SetConfigType("toml")
+		if err := v.MergeInConfig(); err != nil {
+			return errors.Wrapf(err, "failed to load config file %s", configFile)
+		}
+	}
+	return nil
+}
+
 // Validate performs basic sanity checks on config and returns error if any
 // misconfiguration would be fatal to the application
 func (c *generalConfig) Validate() error {
@@ -402,7 +577,7 @@ EVM_ENABLED=false
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
 
-	if c.viper.GetString(envvar.Name("LogFileDir")) != "" && c.LogFileMaxSize() <= 0 {
+	if c.getViper().GetString(envvar.Name("LogFileDir")) != "" && c.LogFileMaxSize() <= 0 {
 		c.lggr.Warn("LOG_FILE_DIR is ignored and has no effect when LOG_FILE_MAX_SIZE is not set to a value greater than zero")
 	}
 
@@ -419,7 +594,13 @@ func (c *generalConfig) GetDatabaseDialectConfiguredOrDefault() dialects.Dialect
 
 // AllowOrigins returns the CORS hosts used by the frontend.
 func (c *generalConfig) AllowOrigins() string {
-	return c.viper.GetString(envvar.Name("AllowOrigins"))
+	return c.getViper().GetString(envvar.Name("AllowOrigins"))
+}
+
+// AuditLogFile is the path of a file the audit log is appended to as JSON
+// lines, in addition to the database. Empty disables the file.
+func (c *generalConfig) AuditLogFile() string {
+	return c.getViper().GetString(envvar.Name("AuditLogFile"))
 }
 
 func (c *generalConfig) AppID() uuid.UUID {
@@ -432,7 +613,7 @@ func (c *generalConfig) AppID() uuid.UUID {
 // AdminCredentialsFile points to text file containing admin credentials for logging in
 func (c *generalConfig) AdminCredentialsFile() string {
 	fieldName := "AdminCredentialsFile"
-	file := c.viper.GetString(envvar.Name(fieldName))
+	file := c.getViper().GetString(envvar.Name(fieldName))
 	defaultValue, _ := envvar.DefaultValue(fieldName)
 	if file == defaultValue {
 		return filepath.Join(c.RootDir(), "apicredentials")
@@ -443,7 +624,7 @@ func (c *generalConfig) AdminCredentialsFile() string {
 // AuthenticatedRateLimit defines the threshold to which authenticated requests
 // get limited. More than this many requests per AuthenticatedRateLimitPeriod will be rejected.
 func (c *generalConfig) AuthenticatedRateLimit() int64 {
-	return c.viper.GetInt64(envvar.Name("AuthenticatedRateLimit"))
+	return c.getViper().GetInt64(envvar.Name("AuthenticatedRateLimit"))
 }
 
 // AuthenticatedRateLimitPeriod defines the period to which authenticated requests get limited
@@ -452,11 +633,11 @@ func (c *generalConfig) AuthenticatedRateLimitPeriod() models.Duration {
 }
 
 func (c *generalConfig) AutoPprofEnabled() bool {
-	return c.viper.GetBool(envvar.Name("AutoPprofEnabled"))
+	return c.getViper().GetBool(envvar.Name("AutoPprofEnabled"))
 }
 
 func (c *generalConfig) AutoPprofProfileRoot() string {
-	root := c.viper.GetString(envvar.Name("AutoPprofProfileRoot"))
+	root := c.getViper().GetString(envvar.Name("AutoPprofProfileRoot"))
 	if root == "" {
 		return c.RootDir()
 	}
@@ -480,19 +661,19 @@ func (c *generalConfig) AutoPprofMaxProfileSize() utils.FileSize {
 }
 
 func (c *generalConfig) AutoPprofCPUProfileRate() int {
-	return c.viper.GetInt(envvar.Name("AutoPprofCPUProfileRate"))
+	return c.getViper().GetInt(envvar.Name("AutoPprofCPUProfileRate"))
 }
 
 func (c *generalConfig) AutoPprofMemProfileRate() int {
-	return c.viper.GetInt(envvar.Name("AutoPprofMemProfileRate"))
+	return c.getViper().GetInt(envvar.Name("AutoPprofMemProfileRate"))
 }
 
 func (c *generalConfig) AutoPprofBlockProfileRate() int {
-	return c.viper.GetInt(envvar.Name("AutoPprofBlockProfileRate"))
+	return c.getViper().GetInt(envvar.Name("AutoPprofBlockProfileRate"))
 }
 
 func (c *generalConfig) AutoPprofMutexProfileFraction() int {
-	return c.viper.GetInt(envvar.Name("AutoPprofMutexProfileFraction"))
+	return c.getViper().GetInt(envvar.Name("AutoPprofMutexProfileFraction"))
 }
 
 func (c *generalConfig) AutoPprofMemThreshold() utils.FileSize {
@@ -500,7 +681,7 @@ func (c *generalConfig) AutoPprofMemThreshold() utils.FileSize {
 }
 
 func (c *generalConfig) AutoPprofGoroutineThreshold() int {
-	return c.viper.GetInt(envvar.Name("AutoPprofGoroutineThreshold"))
+	return c.getViper().GetInt(envvar.Name("AutoPprofGoroutineThreshold"))
 }
 
 // BlockBackfillDepth specifies the number of blocks before the current HEAD that the
@@ -521,7 +702,37 @@ func (c *generalConfig) BridgeResponseURL() *url.URL {
 
 // ClientNodeURL is the URL of the Ethereum node this Chainlink node should connect to.
 func (c *generalConfig) ClientNodeURL() string {
-	return c.viper.GetString(envvar.Name("ClientNodeURL"))
+	return c.getViper().GetString(envvar.Name("ClientNodeURL"))
+}
+
+// ConfigFile is the path of the TOML config file passed with --config, if any.
+func (c *generalConfig) ConfigFile() string {
+	c.viperMu.RLock()
+	defer c.viperMu.RUnlock()
+	return c.configFile
+}
+
+// ValueSources returns the raw values set for the environment variable name by
+// each source, from highest to lowest precedence: the environment, config
+// files, and the default.
+func (c *generalConfig) ValueSources(name string) (sources []SourcedValue) {
+	if v := os.Getenv(name); v != "" {
+		sources = append(sources, SourcedValue{Source: SourceEnv, Value: v})
+	}
+	if fileV := c.getFileViper(); fileV.IsSet(name) {
+		sources = append(sources, SourcedValue{Source: SourceFile, Value: fileV.GetString(name)})
+	}
+	if def, ok := envvar.DefaultValueForEnv(name); ok {
+		sources = append(sources, SourcedValue{Source: SourceDefault, Value: def})
+	}
+	return
+}
+
+// EventsEthTxEnabled makes the node's database sessions notify eth_tx state
+// changes to the event stream. It is off by default, since every eth_tx
+// insert and state change then sends a Postgres notification.
+func (c *generalConfig) EventsEthTxEnabled() bool {
+	return getEnvWithFallback(c, envvar.NewBool("EventsEthTxEnabled"))
 }
 
 // FeatureUICSAKeys enables the CSA Keys UI Feature.
@@ -550,7 +761,7 @@ func (c *generalConfig) DatabaseBackupFrequency() time.Duration {
 
 // DatabaseBackupURL configures the URL for the database to backup, if it's to be different from the main on
 func (c *generalConfig) DatabaseBackupURL() *url.URL {
-	s := c.viper.GetString(envvar.Name("DatabaseBackupURL"))
+	s := c.getViper().GetString(envvar.Name("DatabaseBackupURL"))
 	if s == "" {
 		return nil
 	}
@@ -570,13 +781,13 @@ func (c *generalConfig) DatabaseBackupOnVersionUpgrade() bool {
 
 // DatabaseBackupDir configures the directory for saving the backup file, if it's to be different from default one located in the RootDir
 func (c *generalConfig) DatabaseBackupDir() string {
-	return c.viper.GetString(envvar.Name("DatabaseBackupDir"))
+	return c.getViper().GetString(envvar.Name("DatabaseBackupDir"))
 }
 
 // DatabaseURL configures the URL for chainlink to connect to. This must be
 // a properly formatted URL, with a valid scheme (postgres://)
 func (c *generalConfig) DatabaseURL() url.URL {
-	s := c.viper.GetString(envvar.Name("DatabaseURL"))
+	s := c.getViper().GetString(envvar.Name("DatabaseURL"))
 	uri, err := url.Parse(s)
 	if err != nil {
 		c.lggr.Error("invalid database url %s", s)
@@ -592,12 +803,12 @@ func (c *generalConfig) DatabaseURL() url.URL {
 // MigrateDatabase determines whether the database will be automatically
 // migrated on application startup if set to true
 func (c *generalConfig) MigrateDatabase() bool {
-	return c.viper.GetBool(envvar.Name("MigrateDatabase"))
+	return c.getViper().GetBool(envvar.Name("MigrateDatabase"))
 }
 
 // DefaultHTTPLimit defines the size limit for HTTP requests and responses
 func (c *generalConfig) DefaultHTTPLimit() int64 {
-	return c.viper.GetInt64(envvar.Name("DefaultHTTPLimit"))
+	return c.getViper().GetInt64(envvar.Name("DefaultHTTPLimit"))
 }
 
 // DefaultHTTPTimeout defines the default timeout for http requests
@@ -608,12 +819,12 @@ func (c *generalConfig) DefaultHTTPTimeout() models.Duration {
 // DefaultHTTPAllowUnrestrictedNetworkAccess controls whether http requests are unrestricted by default
 // It is recommended that this be left disabled
 func (c *generalConfig) DefaultHTTPAllowUnrestrictedNetworkAccess() bool {
-	return c.viper.GetBool(envvar.Name("DefaultHTTPAllowUnrestrictedNetworkAccess"))
+	return c.getViper().GetBool(envvar.Name("DefaultHTTPAllowUnrestrictedNetworkAccess"))
 }
 
 // Dev configures "development" mode for chainlink.
 func (c *generalConfig) Dev() bool {
-	return c.viper.GetBool(envvar.Name("Dev"))
+	return c.getViper().GetBool(envvar.Name("Dev"))
 }
 
 // ShutdownGracePeriod is the maximum duration of graceful application shutdown.
@@ -624,16 +835,16 @@ func (c *generalConfig) ShutdownGracePeriod() time.Duration {
 
 // FeatureExternalInitiators enables the External Initiator feature.
 func (c *generalConfig) FeatureExternalInitiators() bool {
-	return c.viper.GetBool(envvar.Name("FeatureExternalInitiators"))
+	return c.getViper().GetBool(envvar.Name("FeatureExternalInitiators"))
 }
 
 // FeatureFeedsManager enables the feeds manager
 func (c *generalConfig) FeatureFeedsManager() bool {
-	return c.viper.GetBool(envvar.Name("FeatureFeedsManager"))
+	return c.getViper().GetBool(envvar.Name("FeatureFeedsManager"))
 }
 
 func (c *generalConfig) FeatureLogPoller() bool {
-	return c.viper.GetBool(envvar.Name("FeatureLogPoller"))
+	return c.getViper().GetBool(envvar.Name("FeatureLogPoller"))
 }
 
 // FeatureOffchainReporting enables the OCR job type.
@@ -649,23 +860,23 @@ func (c *generalConfig) FeatureOffchainReporting2() bool {
 // FMDefaultTransactionQueueDepth controls the queue size for DropOldestStrategy in Flux Monitor
 // Set to 0 to use SendEvery strategy instead
 func (c *generalConfig) FMDefaultTransactionQueueDepth() uint32 {
-	return c.viper.GetUint32(envvar.Name("FMDefaultTransactionQueueDepth"))
+	return c.getViper().GetUint32(envvar.Name("FMDefaultTransactionQueueDepth"))
 }
 
 // FMSimulateTransactions enables using eth_call transaction simulation before
 // sending when set to true
 func (c *generalConfig) FMSimulateTransactions() bool {
-	return c.viper.GetBool(envvar.Name("FMSimulateTransactions"))
+	return c.getViper().GetBool(envvar.Name("FMSimulateTransactions"))
 }
 
 // EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
 func (c *generalConfig) EthereumURL() string {
-	return c.viper.GetString(envvar.Name("EthereumURL"))
+	return c.getViper().GetString(envvar.Name("EthereumURL"))
 }
 
 // EthereumHTTPURL is an optional but recommended url that points to the HTTP port of the primary node
 func (c *generalConfig) EthereumHTTPURL() (uri *url.URL) {
-	urlStr := c.viper.GetString(envvar.Name("EthereumHTTPURL"))
+	urlStr := c.getViper().GetString(envvar.Name("EthereumHTTPURL"))
 	if urlStr == "" {
 		return nil
 	}
@@ -680,15 +891,15 @@ func (c *generalConfig) EthereumHTTPURL() (uri *url.URL) {
 // EthereumNodes is a hack to allow node operators to give a JSON string that
 // sets up multiple nodes
 func (c *generalConfig) EthereumNodes() string {
-	return c.viper.GetString(envvar.Name("EthereumNodes"))
+	return c.getViper().GetString(envvar.Name("EthereumNodes"))
 }
 
 // EthereumSecondaryURLs is an optional backup RPC URL
 // Must be http(s) format
 // If specified, transactions will also be broadcast to this ethereum node
 func (c *generalConfig) EthereumSecondaryURLs() []url.URL {
-	oldConfig := c.viper.GetString(envvar.Name("EthereumSecondaryURL"))
-	newConfig := c.viper.GetString(envvar.Name("EthereumSecondaryURLs"))
+	oldConfig := c.getViper().GetString(envvar.Name("EthereumSecondaryURL"))
+	newConfig := c.getViper().GetString(envvar.Name("EthereumSecondaryURLs"))
 
 	config := ""
 	if newConfig != "" {
@@ -722,7 +933,7 @@ func (c *generalConfig) EVMRPCEnabled() bool {
 		}
 		c.lggr.Warnw("Failed to parse value for ETH_DISABLED", "err", err)
 	}
-	rpcEnabled := c.viper.GetBool(envvar.Name("EVMRPCEnabled"))
+	rpcEnabled := c.getViper().GetBool(envvar.Name("EVMRPCEnabled"))
 	return rpcEnabled
 }
 
@@ -735,17 +946,17 @@ func (c *generalConfig) EVMEnabled() bool {
 		}
 		c.lggr.Warnw("Failed to parse value for EVM_DISABLED", "err", err)
 	}
-	return c.viper.GetBool(envvar.Name("EVMEnabled"))
+	return c.getViper().GetBool(envvar.Name("EVMEnabled"))
 }
 
 // SolanaEnabled allows Solana to be used
 func (c *generalConfig) SolanaEnabled() bool {
-	return c.viper.GetBool(envvar.Name("SolanaEnabled"))
+	return c.getViper().GetBool(envvar.Name("SolanaEnabled"))
 }
 
 // TerraEnabled allows Terra to be used
 func (c *generalConfig) TerraEnabled() bool {
-	return c.viper.GetBool(envvar.Name("TerraEnabled"))
+	return c.getViper().GetBool(envvar.Name("TerraEnabled"))
 }
 
 // P2PEnabled controls whether Chainlink will run as a P2P peer for OCR protocol
@@ -757,7 +968,7 @@ func (c *generalConfig) P2PEnabled() bool {
 // InsecureFastScrypt causes all key stores to encrypt using "fast" scrypt params instead
 // This is insecure and only useful for local testing. DO NOT SET THIS IN PRODUCTION
 func (c *generalConfig) InsecureFastScrypt() bool {
-	return c.viper.GetBool(envvar.Name("InsecureFastScrypt"))
+	return c.getViper().GetBool(envvar.Name("InsecureFastScrypt"))
 }
 
 // InsecureSkipVerify disables SSL certificate verification when connection to
@@ -766,7 +977,7 @@ func (c *generalConfig) InsecureFastScrypt() bool {
 //
 // This is mostly useful for people who want to use TLS on localhost.
 func (c *generalConfig) InsecureSkipVerify() bool {
-	return c.viper.GetBool(envvar.Name("InsecureSkipVerify"))
+	return c.getViper().GetBool(envvar.Name("InsecureSkipVerify"))
 }
 
 func (c *generalConfig) TriggerFallbackDBPollInterval() time.Duration {
@@ -805,25 +1016,25 @@ func (c *generalConfig) KeeperRegistryPerformGasOverhead() uint64 {
 // KeeperDefaultTransactionQueueDepth controls the queue size for DropOldestStrategy in Keeper
 // Set to 0 to use SendEvery strategy instead
 func (c *generalConfig) KeeperDefaultTransactionQueueDepth() uint32 {
-	return c.viper.GetUint32(envvar.Name("KeeperDefaultTransactionQueueDepth"))
+	return c.getViper().GetUint32(envvar.Name("KeeperDefaultTransactionQueueDepth"))
 }
 
 // KeeperGasPriceBufferPercent adds the specified percentage to the gas price
 // used for checking whether to perform an upkeep. Only applies in legacy mode.
 func (c *generalConfig) KeeperGasPriceBufferPercent() uint32 {
-	return c.viper.GetUint32(envvar.Name("KeeperGasPriceBufferPercent"))
+	return c.getViper().GetUint32(envvar.Name("KeeperGasPriceBufferPercent"))
 }
 
 // KeeperGasTipCapBufferPercent adds the specified percentage to the gas price
 // used for checking whether to perform an upkeep. Only applies in EIP-1559 mode.
 func (c *generalConfig) KeeperGasTipCapBufferPercent() uint32 {
-	return c.viper.GetUint32(envvar.Name("KeeperGasTipCapBufferPercent"))
+	return c.getViper().GetUint32(envvar.Name("KeeperGasTipCapBufferPercent"))
 }
 
 // KeeperBaseFeeBufferPercent adds the specified percentage to the base fee
 // used for checking whether to perform an upkeep. Only applies in EIP-1559 mode.
 func (c *generalConfig) KeeperBaseFeeBufferPercent() uint32 {
-	return c.viper.GetUint32(envvar.Name("KeeperBaseFeeBufferPercent"))
+	return c.getViper().GetUint32(envvar.Name("KeeperBaseFeeBufferPercent"))
 }
 
 // KeeperRegistrySyncInterval is the interval in which the RegistrySynchronizer performs a full
@@ -835,7 +1046,7 @@ func (c *generalConfig) KeeperRegistrySyncInterval() time.Duration {
 // KeeperMaximumGracePeriod is the maximum number of blocks that a keeper will wait after performing
 // an upkeep before it resumes checking that upkeep
 func (c *generalConfig) KeeperMaximumGracePeriod() int64 {
-	return c.viper.GetInt64(envvar.Name("KeeperMaximumGracePeriod"))
+	return c.getViper().GetInt64(envvar.Name("KeeperMaximumGracePeriod"))
 }
 
 // KeeperRegistrySyncUpkeepQueueSize represents the maximum number of upkeeps that can be synced in parallel
@@ -843,6 +1054,18 @@ func (c *generalConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return getEnvWithFallback(c, envvar.KeeperRegistrySyncUpkeepQueueSize)
 }
 
+// KeeperCheckUpkeepBatchSize is the maximum number of upkeeps checked in a single
+// multicall, on registries which support it. Batches are also limited by the
+// check gas limits of their upkeeps. Set to 0 to check upkeeps one by one.
+func (c *generalConfig) KeeperCheckUpkeepBatchSize() uint32 {
+	return c.getViper().GetUint32(envvar.Name("KeeperCheckUpkeepBatchSize"))
+}
+
+// KeeperExecutionQueueSize is the maximum number of upkeeps executed in parallel
+func (c *generalConfig) KeeperExecutionQueueSize() uint32 {
+	return c.getViper().GetUint32(envvar.Name("KeeperExecutionQueueSize"))
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled enables keepers to include a gas price when running checkUpkeep
 func (c *generalConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	return getEnvWithFallback(c, envvar.NewBool("KeeperCheckUpkeepGasPriceFeatureEnabled"))
@@ -850,7 +1073,7 @@ func (c *generalConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 
 // KeeperTurnLookBack represents the number of blocks in the past to loo back when getting block for turn
 func (c *generalConfig) KeeperTurnLookBack() int64 {
-	return c.viper.GetInt64(envvar.Name("KeeperTurnLookBack"))
+	return c.getViper().GetInt64(envvar.Name("KeeperTurnLookBack"))
 }
 
 // KeeperTurnFlagEnabled enables new turn taking algo for keepers
@@ -864,6 +1087,23 @@ func (c *generalConfig) JSONConsole() bool {
 	return getEnvWithFallback(c, envvar.JSONConsole)
 }
 
+// EthRemoteSignerURL is the JSON-RPC endpoint of the Clef compatible signer
+// which holds the private keys of remote eth keys, or nil.
+func (c *generalConfig) EthRemoteSignerURL() *url.URL {
+	return getEnvWithFallback(c, envvar.New("EthRemoteSignerURL", url.Parse))
+}
+
+// EthRemoteSignerTimeout is the max duration of each request to the remote signer
+func (c *generalConfig) EthRemoteSignerTimeout() time.Duration {
+	return c.getDuration("EthRemoteSignerTimeout")
+}
+
+// EthRemoteSignerRetries is the number of times a request to the remote signer
+// is retried after it times out or fails to connect
+func (c *generalConfig) EthRemoteSignerRetries() uint {
+	return c.getViper().GetUint(envvar.Name("EthRemoteSignerRetries"))
+}
+
 // ExplorerURL returns the websocket URL for this node to push stats to, or nil.
 func (c *generalConfig) ExplorerURL() *url.URL {
 	return getEnvWithFallback(c, envvar.New("ExplorerURL", url.Parse))
@@ -871,24 +1111,24 @@ func (c *generalConfig) ExplorerURL() *url.URL {
 
 // ExplorerAccessKey returns the access key for authenticating with explorer
 func (c *generalConfig) ExplorerAccessKey() string {
-	return c.viper.GetString(envvar.Name("ExplorerAccessKey"))
+	return c.getViper().GetString(envvar.Name("ExplorerAccessKey"))
 }
 
 // ExplorerSecret returns the secret for authenticating with explorer
 func (c *generalConfig) ExplorerSecret() string {
-	return c.viper.GetString(envvar.Name("ExplorerSecret"))
+	return c.getViper().GetString(envvar.Name("ExplorerSecret"))
 }
 
 // SolanaNodes is a hack to allow node operators to give a JSON string that
 // sets up multiple nodes
 func (c *generalConfig) SolanaNodes() string {
-	return c.viper.GetString(envvar.Name("SolanaNodes"))
+	return c.getViper().GetString(envvar.Name("SolanaNodes"))
 }
 
 // TerraNodes is a hack to allow node operators to give a JSON string that
 // sets up multiple nodes
 func (c *generalConfig) TerraNodes() string {
-	return c.viper.GetString(envvar.Name("TerraNodes"))
+	return c.getViper().GetString(envvar.Name("TerraNodes"))
 }
 
 // TelemetryIngressURL returns the WSRPC URL for this node to push telemetry to, or nil.
@@ -898,17 +1138,17 @@ func (c *generalConfig) TelemetryIngressURL() *url.URL {
 
 // TelemetryIngressServerPubKey returns the public key to authenticate the telemetry ingress server
 func (c *generalConfig) TelemetryIngressServerPubKey() string {
-	return c.viper.GetString(envvar.Name("TelemetryIngressServerPubKey"))
+	return c.getViper().GetString(envvar.Name("TelemetryIngressServerPubKey"))
 }
 
 // TelemetryIngressBufferSize is the number of telemetry messages to buffer before dropping new ones
 func (c *generalConfig) TelemetryIngressBufferSize() uint {
-	return c.viper.GetUint(envvar.Name("TelemetryIngressBufferSize"))
+	return c.getViper().GetUint(envvar.Name("TelemetryIngressBufferSize"))
 }
 
 // TelemetryIngressMaxBatchSize is the maximum number of messages to batch into one telemetry request
 func (c *generalConfig) TelemetryIngressMaxBatchSize() uint {
-	return c.viper.GetUint(envvar.Name("TelemetryIngressMaxBatchSize"))
+	return c.getViper().GetUint(envvar.Name("TelemetryIngressMaxBatchSize"))
 }
 
 // TelemetryIngressSendInterval is the cadence on which batched telemetry is sent to the ingress server
@@ -923,7 +1163,7 @@ func (c *generalConfig) TelemetryIngressSendTimeout() time.Duration {
 
 // TelemetryIngressUseBatchSend toggles sending telemetry using the batch client to the ingress server
 func (c *generalConfig) TelemetryIngressUseBatchSend() bool {
-	return c.viper.GetBool(envvar.Name("TelemetryIngressUseBatchSend"))
+	return c.getViper().GetBool(envvar.Name("TelemetryIngressUseBatchSend"))
 }
 
 // TelemetryIngressLogging toggles very verbose logging of raw telemetry messages for the TelemetryIngressClient
@@ -1006,7 +1246,7 @@ func (c *generalConfig) Port() uint16 {
 
 // DefaultChainID represents the chain ID which jobs will use if one is not explicitly specified
 func (c *generalConfig) DefaultChainID() *big.Int {
-	str := c.viper.GetString(envvar.Name("DefaultChainID"))
+	str := c.getViper().GetString(envvar.Name("DefaultChainID"))
 	if str != "" {
 		v, err := parse.BigInt(str)
 		if err != nil {
@@ -1042,18 +1282,18 @@ func (c *generalConfig) RootDir() string {
 
 // RPID Fetches the RPID used for WebAuthn sessions. The RPID value should be the FQDN (localhost)
 func (c *generalConfig) RPID() string {
-	return c.viper.GetString(envvar.Name("RPID"))
+	return c.getViper().GetString(envvar.Name("RPID"))
 }
 
 // RPOrigin Fetches the RPOrigin used to configure WebAuthn sessions. The RPOrigin value should be
 // the origin URL where WebAuthn requests initiate (http://localhost:6688/)
 func (c *generalConfig) RPOrigin() string {
-	return c.viper.GetString(envvar.Name("RPOrigin"))
+	return c.getViper().GetString(envvar.Name("RPOrigin"))
 }
 
 // SecureCookies allows toggling of the secure cookies HTTP flag
 func (c *generalConfig) SecureCookies() bool {
-	return c.viper.GetBool(envvar.Name("SecureCookies"))
+	return c.getViper().GetBool(envvar.Name("SecureCookies"))
 }
 
 // SessionTimeout is the maximum duration that a user session can persist without any activity.
@@ -1064,19 +1304,19 @@ func (c *generalConfig) SessionTimeout() models.Duration {
 // TLSCertPath represents the file system location of the TLS certificate
 // Chainlink should use for HTTPS.
 func (c *generalConfig) TLSCertPath() string {
-	return c.viper.GetString(envvar.Name("TLSCertPath"))
+	return c.getViper().GetString(envvar.Name("TLSCertPath"))
 }
 
 // TLSHost represents the hostname to use for TLS clients. This should match
 // the TLS certificate.
 func (c *generalConfig) TLSHost() string {
-	return c.viper.GetString(envvar.Name("TLSHost"))
+	return c.getViper().GetString(envvar.Name("TLSHost"))
 }
 
 // TLSKeyPath represents the file system location of the TLS key Chainlink
 // should use for HTTPS.
 func (c *generalConfig) TLSKeyPath() string {
-	return c.viper.GetString(envvar.Name("TLSKeyPath"))
+	return c.getViper().GetString(envvar.Name("TLSKeyPath"))
 }
 
 // TLSPort represents the port Chainlink should listen on for encrypted client requests.
@@ -1086,12 +1326,12 @@ func (c *generalConfig) TLSPort() uint16 {
 
 // TLSRedirect forces TLS redirect for unencrypted connections
 func (c *generalConfig) TLSRedirect() bool {
-	return c.viper.GetBool(envvar.Name("TLSRedirect"))
+	return c.getViper().GetBool(envvar.Name("TLSRedirect"))
 }
 
 // UnAuthenticatedRateLimit defines the threshold to which requests unauthenticated requests get limited
 func (c *generalConfig) UnAuthenticatedRateLimit() int64 {
-	return c.viper.GetInt64(envvar.Name("UnAuthenticatedRateLimit"))
+	return c.getViper().GetInt64(envvar.Name("UnAuthenticatedRateLimit"))
 }
 
 // UnAuthenticatedRateLimitPeriod defines the period to which unauthenticated requests get limited
@@ -1141,7 +1381,7 @@ func (c *generalConfig) getWithFallback(name string, parser func(string) (interf
 }
 
 func getEnvWithFallback[T any](c *generalConfig, e *envvar.EnvVar[T]) T {
-	v, invalid, err := e.ParseFrom(c.viper.GetString)
+	v, invalid, err := e.ParseFrom(c.getViper().GetString)
 	if err != nil {
 		c.lggr.Panic(err)
 	}
@@ -1168,8 +1408,21 @@ func parseDatabaseBackupMode(s string) (DatabaseBackupMode, error) {
 	}
 }
 
+// LookupEnv implements GeneralOnlyConfig
+func (c *generalConfig) LookupEnv(k string) (string, bool) {
+	if s, ok := os.LookupEnv(k); ok {
+		return s, true
+	}
+	// Fall back to the config files, which have no defaults for these keys
+	v := c.getViper()
+	if !v.InConfig(k) {
+		return "", false
+	}
+	return v.GetString(k), true
+}
+
 func lookupEnv[T any](c *generalConfig, k string, parse func(string) (T, error)) (t T, ok bool) {
-	s, ok := os.LookupEnv(k)
+	s, ok := c.LookupEnv(k)
 	if !ok {
 		return
 	}
@@ -1359,7 +1612,7 @@ func (c *generalConfig) AdvisoryLockCheckInterval() time.Duration {
 
 // LogFileDir if set will override RootDir as the output path for log files
 func (c *generalConfig) LogFileDir() string {
-	s := c.viper.GetString(envvar.Name("LogFileDir"))
+	s := c.getViper().GetString(envvar.Name("LogFileDir"))
 	if s == "" {
 		return c.RootDir()
 	}
@@ -99,6 +99,20 @@ func (_m *GeneralConfig) AllowOrigins() string {
 	return r0
 }
 
+// AuditLogFile provides a mock function with given fields:
+func (_m *GeneralConfig) AuditLogFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AppID provides a mock function with given fields:
 func (_m *GeneralConfig) AppID() uuid.UUID {
 	ret := _m.Called()
@@ -383,6 +397,20 @@ func (_m *GeneralConfig) ClientNodeURL() string {
 	return r0
 }
 
+// ConfigFile provides a mock function with given fields:
+func (_m *GeneralConfig) ConfigFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupDir provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupDir() string {
 	ret := _m.Called()
@@ -625,6 +653,50 @@ func (_m *GeneralConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EthRemoteSignerRetries provides a mock function with given fields:
+func (_m *GeneralConfig) EthRemoteSignerRetries() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) EthRemoteSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerURL provides a mock function with given fields:
+func (_m *GeneralConfig) EthRemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // EthereumHTTPURL provides a mock function with given fields:
 func (_m *GeneralConfig) EthereumHTTPURL() *url.URL {
 	ret := _m.Called()
@@ -685,6 +757,20 @@ func (_m *GeneralConfig) EthereumURL() string {
 	return r0
 }
 
+// EventsEthTxEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) EventsEthTxEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // ExplorerAccessKey provides a mock function with given fields:
 func (_m *GeneralConfig) ExplorerAccessKey() string {
 	ret := _m.Called()
@@ -2040,6 +2126,20 @@ func (_m *GeneralConfig) KeeperBaseFeeBufferPercent() uint32 {
 	return r0
 }
 
+// KeeperCheckUpkeepBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperCheckUpkeepBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	ret := _m.Called()
@@ -2068,6 +2168,20 @@ func (_m *GeneralConfig) KeeperDefaultTransactionQueueDepth() uint32 {
 	return r0
 }
 
+// KeeperExecutionQueueSize provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperExecutionQueueSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // KeeperGasPriceBufferPercent provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperGasPriceBufferPercent() uint32 {
 	ret := _m.Called()
@@ -2236,6 +2350,20 @@ func (_m *GeneralConfig) LeaseLockRefreshInterval() time.Duration {
 	return r0
 }
 
+// LoadConfigFile provides a mock function with given fields: path
+func (_m *GeneralConfig) LoadConfigFile(path string) error {
+	ret := _m.Called(path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // LogFileDir provides a mock function with given fields:
 func (_m *GeneralConfig) LogFileDir() string {
 	ret := _m.Called()
@@ -2334,6 +2462,27 @@ func (_m *GeneralConfig) LogUnixTimestamps() bool {
 	return r0
 }
 
+// LookupEnv provides a mock function with given fields: k
+func (_m *GeneralConfig) LookupEnv(k string) (string, bool) {
+	ret := _m.Called(k)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(k)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(k)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // MigrateDatabase provides a mock function with given fields:
 func (_m *GeneralConfig) MigrateDatabase() bool {
 	ret := _m.Called()
@@ -3146,6 +3295,29 @@ func (_m *GeneralConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// Reload provides a mock function with given fields:
+func (_m *GeneralConfig) Reload() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -3581,3 +3753,19 @@ func (_m *GeneralConfig) Validate() error {
 
 	return r0
 }
+
+// ValueSources provides a mock function with given fields: name
+func (_m *GeneralConfig) ValueSources(name string) []config.SourcedValue {
+	ret := _m.Called(name)
+
+	var r0 []config.SourcedValue
+	if rf, ok := ret.Get(0).(func(string) []config.SourcedValue); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.SourcedValue)
+		}
+	}
+
+	return r0
+}
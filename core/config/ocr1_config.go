@@ -64,11 +64,11 @@ func (c *generalConfig) OCRBlockchainTimeout() time.Duration {
 }
 
 func (c *generalConfig) OCRMonitoringEndpoint() string {
-	return c.viper.GetString(envvar.Name("OCRMonitoringEndpoint"))
+	return c.getViper().GetString(envvar.Name("OCRMonitoringEndpoint"))
 }
 
 func (c *generalConfig) OCRKeyBundleID() (string, error) {
-	kbStr := c.viper.GetString(envvar.Name("OCRKeyBundleID"))
+	kbStr := c.getViper().GetString(envvar.Name("OCRKeyBundleID"))
 	if kbStr != "" {
 		_, err := models.Sha256HashFromHex(kbStr)
 		if err != nil {
@@ -81,13 +81,13 @@ func (c *generalConfig) OCRKeyBundleID() (string, error) {
 // OCRDefaultTransactionQueueDepth controls the queue size for DropOldestStrategy in OCR
 // Set to 0 to use SendEvery strategy instead
 func (c *generalConfig) OCRDefaultTransactionQueueDepth() uint32 {
-	return c.viper.GetUint32(envvar.Name("OCRDefaultTransactionQueueDepth"))
+	return c.getViper().GetUint32(envvar.Name("OCRDefaultTransactionQueueDepth"))
 }
 
 // OCRTraceLogging determines whether OCR logs at TRACE level are enabled. The
 // option to turn them off is given because they can be very verbose
 func (c *generalConfig) OCRTraceLogging() bool {
-	return c.viper.GetBool(envvar.Name("OCRTraceLogging"))
+	return c.getViper().GetBool(envvar.Name("OCRTraceLogging"))
 }
 
 func (c *generalConfig) OCRObservationTimeout() time.Duration {
@@ -97,11 +97,11 @@ func (c *generalConfig) OCRObservationTimeout() time.Duration {
 // OCRSimulateTransactions enables using eth_call transaction simulation before
 // sending when set to true
 func (c *generalConfig) OCRSimulateTransactions() bool {
-	return c.viper.GetBool(envvar.Name("OCRSimulateTransactions"))
+	return c.getViper().GetBool(envvar.Name("OCRSimulateTransactions"))
 }
 
 func (c *generalConfig) OCRTransmitterAddress() (ethkey.EIP55Address, error) {
-	taStr := c.viper.GetString(envvar.Name("OCRTransmitterAddress"))
+	taStr := c.getViper().GetString(envvar.Name("OCRTransmitterAddress"))
 	if taStr != "" {
 		ta, err := ethkey.NewEIP55Address(taStr)
 		if err != nil {
@@ -50,11 +50,11 @@ func (c *generalConfig) OCR2DatabaseTimeout() time.Duration {
 }
 
 func (c *generalConfig) OCR2MonitoringEndpoint() string {
-	return c.viper.GetString(envvar.Name("OCR2MonitoringEndpoint"))
+	return c.getViper().GetString(envvar.Name("OCR2MonitoringEndpoint"))
 }
 
 func (c *generalConfig) OCR2KeyBundleID() (string, error) {
-	kbStr := c.viper.GetString(envvar.Name("OCR2KeyBundleID"))
+	kbStr := c.getViper().GetString(envvar.Name("OCR2KeyBundleID"))
 	if kbStr != "" {
 		_, err := models.Sha256HashFromHex(kbStr)
 		if err != nil {
@@ -65,5 +65,5 @@ func (c *generalConfig) OCR2KeyBundleID() (string, error) {
 }
 
 func (c *generalConfig) OCR2TraceLogging() bool {
-	return c.viper.GetBool(envvar.Name("OCRTraceLogging"))
+	return c.getViper().GetBool(envvar.Name("OCRTraceLogging"))
 }
@@ -34,12 +34,12 @@ func (c *generalConfig) P2PNetworkingStack() (n ocrnetworking.NetworkingStack) {
 
 // P2PNetworkingStackRaw returns the raw string passed as networking stack
 func (c *generalConfig) P2PNetworkingStackRaw() string {
-	return c.viper.GetString(envvar.Name("P2PNetworkingStack"))
+	return c.getViper().GetString(envvar.Name("P2PNetworkingStack"))
 }
 
 // P2PPeerID is the default peer ID that will be used, if not overridden
 func (c *generalConfig) P2PPeerID() p2pkey.PeerID {
-	pidStr := c.viper.GetString(envvar.Name("P2PPeerID"))
+	pidStr := c.getViper().GetString(envvar.Name("P2PPeerID"))
 	if pidStr == "" {
 		return ""
 	}
@@ -53,7 +53,7 @@ func (c *generalConfig) P2PPeerID() p2pkey.PeerID {
 
 // P2PPeerIDRaw returns the string value of whatever P2P_PEER_ID was set to with no parsing
 func (c *generalConfig) P2PPeerIDRaw() string {
-	return c.viper.GetString(envvar.Name("P2PPeerID"))
+	return c.getViper().GetString(envvar.Name("P2PPeerID"))
 }
 
 func (c *generalConfig) P2PIncomingMessageBufferSize() int {
@@ -82,25 +82,25 @@ type P2PDeprecated interface {
 // DEPRECATED, do not use defaults, use only if specified and the
 // newer env vars is not
 func (c *generalConfig) OCRBootstrapCheckInterval() time.Duration {
-	return c.viper.GetDuration("OCRBootstrapCheckInterval")
+	return c.getViper().GetDuration("OCRBootstrapCheckInterval")
 }
 
 // DEPRECATED
 func (c *generalConfig) OCRDHTLookupInterval() int {
-	return c.viper.GetInt("OCRDHTLookupInterval")
+	return c.getViper().GetInt("OCRDHTLookupInterval")
 }
 
 // DEPRECATED
 func (c *generalConfig) OCRNewStreamTimeout() time.Duration {
-	return c.viper.GetDuration("OCRNewStreamTimeout")
+	return c.getViper().GetDuration("OCRNewStreamTimeout")
 }
 
 // DEPRECATED
 func (c *generalConfig) OCRIncomingMessageBufferSize() int {
-	return c.viper.GetInt("OCRIncomingMessageBufferSize")
+	return c.getViper().GetInt("OCRIncomingMessageBufferSize")
 }
 
 // DEPRECATED
 func (c *generalConfig) OCROutgoingMessageBufferSize() int {
-	return c.viper.GetInt("OCRIncomingMessageBufferSize")
+	return c.getViper().GetInt("OCRIncomingMessageBufferSize")
 }
@@ -34,8 +34,8 @@ func (c *generalConfig) P2PPeerstoreWriteInterval() time.Duration {
 }
 
 func (c *generalConfig) P2PBootstrapPeers() ([]string, error) {
-	if c.viper.IsSet(envvar.Name("P2PBootstrapPeers")) {
-		bps := c.viper.GetStringSlice(envvar.Name("P2PBootstrapPeers"))
+	if v := c.getViper(); v.IsSet(envvar.Name("P2PBootstrapPeers")) {
+		bps := v.GetStringSlice(envvar.Name("P2PBootstrapPeers"))
 		if bps != nil {
 			return bps, nil
 		}
@@ -51,8 +51,8 @@ func (c *generalConfig) P2PListenIP() net.IP {
 
 // P2PListenPort is the port that libp2p will bind to and listen on
 func (c *generalConfig) P2PListenPort() uint16 {
-	if c.viper.IsSet(envvar.Name("P2PListenPort")) {
-		return uint16(c.viper.GetUint32(envvar.Name("P2PListenPort")))
+	if v := c.getViper(); v.IsSet(envvar.Name("P2PListenPort")) {
+		return uint16(v.GetUint32(envvar.Name("P2PListenPort")))
 	}
 	switch c.P2PNetworkingStack() {
 	case ocrnetworking.NetworkingStackV1, ocrnetworking.NetworkingStackV1V2:
@@ -88,14 +88,14 @@ func (c *generalConfig) randomP2PListenPort() uint16 {
 
 // P2PListenPortRaw returns the raw string value of P2P_LISTEN_PORT
 func (c *generalConfig) P2PListenPortRaw() string {
-	return c.viper.GetString(envvar.Name("P2PListenPort"))
+	return c.getViper().GetString(envvar.Name("P2PListenPort"))
 }
 
 // P2PAnnounceIP is an optional override. If specified it will force the p2p
 // layer to announce this IP as the externally reachable one to the DHT
 // If this is set, P2PAnnouncePort MUST also be set.
 func (c *generalConfig) P2PAnnounceIP() net.IP {
-	str := c.viper.GetString(envvar.Name("P2PAnnounceIP"))
+	str := c.getViper().GetString(envvar.Name("P2PAnnounceIP"))
 	return net.ParseIP(str)
 }
 
@@ -103,7 +103,7 @@ func (c *generalConfig) P2PAnnounceIP() net.IP {
 // layer to announce this port as the externally reachable one to the DHT.
 // If this is set, P2PAnnounceIP MUST also be set.
 func (c *generalConfig) P2PAnnouncePort() uint16 {
-	return uint16(c.viper.GetUint32(envvar.Name("P2PAnnouncePort")))
+	return uint16(c.getViper().GetUint32(envvar.Name("P2PAnnouncePort")))
 }
 
 // P2PDHTAnnouncementCounterUserPrefix can be used to restore the node's
@@ -113,7 +113,7 @@ func (c *generalConfig) P2PAnnouncePort() uint16 {
 // could semi-permanently exclude your node from the P2P network by
 // misconfiguring it.
 func (c *generalConfig) P2PDHTAnnouncementCounterUserPrefix() uint32 {
-	return c.viper.GetUint32(envvar.Name("P2PDHTAnnouncementCounterUserPrefix"))
+	return c.getViper().GetUint32(envvar.Name("P2PDHTAnnouncementCounterUserPrefix"))
 }
 
 // FIXME: Add comments to all of these
@@ -23,21 +23,21 @@ type P2PV2Networking interface {
 // P2PV2ListenAddresses contains the addresses the peer will listen to on the network in <host>:<port> form as
 // accepted by net.Listen, but host and port must be fully specified and cannot be empty.
 func (c *generalConfig) P2PV2ListenAddresses() []string {
-	return c.viper.GetStringSlice(envvar.Name("P2PV2ListenAddresses"))
+	return c.getViper().GetStringSlice(envvar.Name("P2PV2ListenAddresses"))
 }
 
 // P2PV2AnnounceAddresses contains the addresses the peer will advertise on the network in <host>:<port> form as
 // accepted by net.Dial. The addresses should be reachable by peers of interest.
 func (c *generalConfig) P2PV2AnnounceAddresses() []string {
-	if c.viper.IsSet(envvar.Name("P2PV2AnnounceAddresses")) {
-		return c.viper.GetStringSlice(envvar.Name("P2PV2AnnounceAddresses"))
+	if v := c.getViper(); v.IsSet(envvar.Name("P2PV2AnnounceAddresses")) {
+		return v.GetStringSlice(envvar.Name("P2PV2AnnounceAddresses"))
 	}
 	return c.P2PV2ListenAddresses()
 }
 
 // P2PV2AnnounceAddressesRaw returns the raw value passed in
 func (c *generalConfig) P2PV2AnnounceAddressesRaw() []string {
-	return c.viper.GetStringSlice(envvar.Name("P2PV2AnnounceAddresses"))
+	return c.getViper().GetStringSlice(envvar.Name("P2PV2AnnounceAddresses"))
 }
 
 // P2PV2Bootstrappers returns the default bootstrapper peers for libocr's v2
@@ -57,7 +57,7 @@ func (c *generalConfig) P2PV2Bootstrappers() (locators []ocrcommontypes.Bootstra
 
 // P2PV2BootstrappersRaw returns the raw strings for v2 bootstrap peers
 func (c *generalConfig) P2PV2BootstrappersRaw() []string {
-	return c.viper.GetStringSlice(envvar.Name("P2PV2Bootstrappers"))
+	return c.getViper().GetStringSlice(envvar.Name("P2PV2Bootstrappers"))
 }
 
 // P2PV2DeltaDial controls how far apart Dial attempts are
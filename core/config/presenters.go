@@ -51,6 +51,7 @@ type EnvPrinter struct {
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
 	EthereumURL                                string          `json:"ETH_URL"`
+	EventsEthTxEnabled                         bool            `json:"EVENTS_ETH_TX_ENABLED"`
 	ExplorerURL                                string          `json:"EXPLORER_URL"`
 	FMDefaultTransactionQueueDepth             uint32          `json:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
 	FeatureExternalInitiators                  bool            `json:"FEATURE_EXTERNAL_INITIATORS"`
@@ -64,6 +65,8 @@ type EnvPrinter struct {
 	KeeperGasPriceBufferPercent                uint32          `json:"KEEPER_GAS_PRICE_BUFFER_PERCENT"`
 	KeeperGasTipCapBufferPercent               uint32          `json:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT"`
 	KeeperBaseFeeBufferPercent                 uint32          `json:"KEEPER_BASE_FEE_BUFFER_PERCENT"`
+	KeeperCheckUpkeepBatchSize                 uint32          `json:"KEEPER_CHECK_UPKEEP_BATCH_SIZE"`
+	KeeperExecutionQueueSize                   uint32          `json:"KEEPER_EXECUTION_QUEUE_SIZE"`
 	KeeperMaximumGracePeriod                   int64           `json:"KEEPER_MAXIMUM_GRACE_PERIOD"`
 	KeeperRegistryCheckGasOverhead             uint64          `json:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD"`
 	KeeperRegistryPerformGasOverhead           uint64          `json:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD"`
@@ -166,6 +169,7 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			EthereumHTTPURL:                         ethereumHTTPURL,
 			EthereumSecondaryURLs:                   mapToStringA(cfg.EthereumSecondaryURLs()),
 			EthereumURL:                             cfg.EthereumURL(),
+			EventsEthTxEnabled:                      cfg.EventsEthTxEnabled(),
 			ExplorerURL:                             explorerURL,
 			FMDefaultTransactionQueueDepth:          cfg.FMDefaultTransactionQueueDepth(),
 			FeatureExternalInitiators:               cfg.FeatureExternalInitiators(),
@@ -181,6 +185,8 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			KeeperTurnFlagEnabled:                   cfg.KeeperTurnFlagEnabled(),
 			KeeperGasTipCapBufferPercent:            cfg.KeeperGasTipCapBufferPercent(),
 			KeeperBaseFeeBufferPercent:              cfg.KeeperBaseFeeBufferPercent(),
+			KeeperCheckUpkeepBatchSize:              cfg.KeeperCheckUpkeepBatchSize(),
+			KeeperExecutionQueueSize:                cfg.KeeperExecutionQueueSize(),
 			LeaseLockDuration:                       cfg.LeaseLockDuration(),
 			LeaseLockRefreshInterval:                cfg.LeaseLockRefreshInterval(),
 			LogFileDir:                              cfg.LogFileDir(),
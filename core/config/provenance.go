@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+)
+
+// Source is where a config value was set.
+type Source string
+
+const (
+	// SourceEnv is an environment variable.
+	SourceEnv Source = "env"
+	// SourceFile is a config file.
+	SourceFile Source = "file"
+	// SourceDB is a chain config override stored in the database, e.g. with PATCH /v2/config.
+	SourceDB Source = "db"
+	// SourceChainDefault is a default specific to the chain ID.
+	SourceChainDefault Source = "chain default"
+	// SourceDefault is the global default.
+	SourceDefault Source = "default"
+)
+
+// SourcedValue is a config value as set by a single source.
+type SourcedValue struct {
+	Source Source `json:"source"`
+	Value  string `json:"value"`
+}
+
+// Provenance is the effective value of a setting and the source it came
+// from, along with any lower precedence values that it shadows.
+type Provenance struct {
+	Name     string         `json:"name"`
+	Value    string         `json:"value"`
+	Source   Source         `json:"source"`
+	Shadowed []SourcedValue `json:"shadowed"`
+}
+
+// NewProvenance returns the Provenance of the setting name with the effective
+// value, given the values set by each source from highest to lowest
+// precedence. With no sources, the value is a default.
+func NewProvenance(name, value string, sources []SourcedValue) Provenance {
+	p := Provenance{Name: name, Value: value, Source: SourceDefault, Shadowed: []SourcedValue{}}
+	if len(sources) > 0 {
+		p.Source = sources[0].Source
+		p.Shadowed = append(p.Shadowed, sources[1:]...)
+	}
+	return p
+}
+
+// GlobalProvenance returns the Provenance of each of the non-secret settings
+// in EnvPrinter.
+func GlobalProvenance(cfg GeneralConfig) (ps []Provenance) {
+	printer := NewConfigPrinter(cfg).EnvPrinter
+
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	t := reflect.TypeOf(printer)
+	v := reflect.ValueOf(printer)
+	for i := 0; i < t.NumField(); i++ {
+		item := t.Field(i)
+		schemaItem, ok := schemaT.FieldByName(item.Name)
+		if !ok {
+			continue
+		}
+		envName, ok := schemaItem.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		ps = append(ps, NewProvenance(envName, FormatValue(v.FieldByIndex(item.Index).Interface()), cfg.ValueSources(envName)))
+	}
+	return
+}
+
+// FormatValue formats an effective config value the same way as
+// ConfigPrinter.
+func FormatValue(val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	if stringer, ok := val.(fmt.Stringer); ok {
+		rv := reflect.ValueOf(val)
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return ""
+		}
+		return stringer.String()
+	}
+	return fmt.Sprintf("%v", val)
+}
@@ -0,0 +1,60 @@
+package config
+
+// liveSettings are the settings that take effect without a restart when the
+// config is reloaded, because the subsystems using them read them on each use,
+// or are updated by the application after a reload.
+var liveSettings = map[string]struct{}{
+	// Logger
+	"LOG_LEVEL": {},
+	"LOG_SQL":   {},
+
+	// Gas estimators
+	"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE":             {},
+	"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY":            {},
+	"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE":     {},
+	"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE": {},
+	"ETH_GAS_BUMP_PERCENT":                           {},
+	"ETH_GAS_BUMP_THRESHOLD":                         {},
+	"ETH_GAS_BUMP_WEI":                               {},
+	"ETH_GAS_LIMIT_DEFAULT":                          {},
+	"ETH_GAS_LIMIT_MULTIPLIER":                       {},
+	"ETH_GAS_LIMIT_TRANSFER":                         {},
+	"ETH_GAS_PRICE_DEFAULT":                          {},
+	"ETH_MAX_GAS_PRICE_WEI":                          {},
+	"ETH_MIN_GAS_PRICE_WEI":                          {},
+	"EVM_GAS_FEE_CAP_DEFAULT":                        {},
+	"EVM_GAS_TIP_CAP_DEFAULT":                        {},
+	"EVM_GAS_TIP_CAP_MINIMUM":                        {},
+
+	// Transaction manager
+	"ETH_GAS_BUMP_TX_DEPTH":          {},
+	"ETH_MAX_IN_FLIGHT_TRANSACTIONS": {},
+	"ETH_MAX_QUEUED_TRANSACTIONS":    {},
+
+	// Pipeline HTTP tasks
+	"DEFAULT_HTTP_ALLOW_UNRESTRICTED_NETWORK_ACCESS": {},
+	"DEFAULT_HTTP_LIMIT":                             {},
+	"DEFAULT_HTTP_TIMEOUT":                           {},
+}
+
+// ReloadResult lists the settings that changed when the config was reloaded.
+type ReloadResult struct {
+	// Applied settings are already in effect.
+	Applied []string `json:"applied"`
+	// RequiresRestart settings only take effect when the node is restarted.
+	RequiresRestart []string `json:"requiresRestart"`
+}
+
+// NewReloadResult sorts the changed settings into those that were applied and
+// those that require a restart.
+func NewReloadResult(changed []string) ReloadResult {
+	r := ReloadResult{Applied: []string{}, RequiresRestart: []string{}}
+	for _, name := range changed {
+		if _, ok := liveSettings[name]; ok {
+			r.Applied = append(r.Applied, name)
+		} else {
+			r.RequiresRestart = append(r.RequiresRestart, name)
+		}
+	}
+	return r
+}
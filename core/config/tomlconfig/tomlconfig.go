@@ -0,0 +1,445 @@
+// Package tomlconfig loads, validates and dumps TOML config files.
+//
+// Global settings are keyed by their environment variable names, and EVM
+// chains may be listed along with their config overrides and nodes:
+//
+//	LOG_LEVEL = "debug"
+//	SHUTDOWN_GRACE_PERIOD = "10s"
+//
+//	[[EVM]]
+//	ChainID = "1"
+//	EvmGasPriceDefault = "20000000000"
+//
+//	[[EVM.Nodes]]
+//	Name = "primary-1"
+//	WSURL = "wss://example.com/ws"
+//	HTTPURL = "https://example.com"
+//
+// Environment variables that are set take precedence over the file.
+package tomlconfig
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+	"github.com/smartcontractkit/chainlink/core/config/parse"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+const evmKey = "EVM"
+
+// redacted replaces secret values in Dump output.
+const redacted = "*REDACTED*"
+
+var (
+	// globals maps each environment variable name to its type in envvar.ConfigSchema.
+	globals  = map[string]reflect.Type{}
+	defaults = map[string]string{}
+	// chainCfgFields is the set of keys accepted in an [[EVM]] table, besides ChainID, Enabled and Nodes.
+	chainCfgFields = map[string]struct{}{}
+
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func init() {
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	for i := 0; i < schemaT.NumField(); i++ {
+		item := schemaT.Field(i)
+		name := item.Tag.Get("env")
+		globals[name] = item.Type
+		if def, ok := item.Tag.Lookup("default"); ok {
+			defaults[name] = def
+		}
+	}
+	cfgT := reflect.TypeOf(evmtypes.ChainCfg{})
+	for i := 0; i < cfgT.NumField(); i++ {
+		chainCfgFields[cfgT.Field(i).Name] = struct{}{}
+	}
+}
+
+// Problem is an unknown key or invalid value found in a config file.
+type Problem struct {
+	Line, Col int
+	Key       string
+	Msg       string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s", p.Line, p.Col, p.Key, p.Msg)
+}
+
+// Problems is a list of Problem, ordered by their position in the file.
+type Problems []Problem
+
+func (ps Problems) Error() string {
+	lines := make([]string, len(ps))
+	for i, p := range ps {
+		lines[i] = p.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (ps *Problems) add(pos toml.Position, key string, format string, args ...interface{}) {
+	*ps = append(*ps, Problem{Line: pos.Line, Col: pos.Col, Key: key, Msg: fmt.Sprintf(format, args...)})
+}
+
+// File is a parsed TOML config file.
+type File struct {
+	Path string
+	tree *toml.Tree
+}
+
+// Load reads and parses the TOML file at path. Syntax errors include their
+// line and column.
+func Load(path string) (*File, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config file")
+	}
+	tree, err := toml.LoadBytes(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config file %s", path)
+	}
+	return &File{Path: path, tree: tree}, nil
+}
+
+// Validate returns a Problem for every unknown key and invalid value in the
+// file.
+func (f *File) Validate() Problems {
+	var ps Problems
+	for _, key := range f.tree.Keys() {
+		if key == evmKey {
+			continue
+		}
+		pos := f.tree.GetPositionPath([]string{key})
+		typ, ok := globals[key]
+		if !ok {
+			if _, ok = globals[strings.ToUpper(key)]; ok {
+				ps.add(pos, key, "unknown key, did you mean %s?", strings.ToUpper(key))
+			} else {
+				ps.add(pos, key, "unknown key")
+			}
+			continue
+		}
+		s, err := scalarString(f.tree.GetPath([]string{key}))
+		if err != nil {
+			ps.add(pos, key, "%v", err)
+			continue
+		}
+		if err = parseAs(typ, s); err != nil {
+			ps.add(pos, key, "invalid value %q: %v", s, err)
+		}
+	}
+	_, _, evmProblems := f.evm()
+	ps = append(ps, evmProblems...)
+
+	sort.SliceStable(ps, func(i, j int) bool {
+		if ps[i].Line != ps[j].Line {
+			return ps[i].Line < ps[j].Line
+		}
+		return ps[i].Col < ps[j].Col
+	})
+	return ps
+}
+
+// EVMChains returns the chains and nodes listed in the file's [[EVM]] tables.
+func (f *File) EVMChains() ([]evmtypes.Chain, []evmtypes.Node, error) {
+	chains, nodes, ps := f.evm()
+	if len(ps) > 0 {
+		return nil, nil, ps
+	}
+	return chains, nodes, nil
+}
+
+func (f *File) evm() (chains []evmtypes.Chain, nodes []evmtypes.Node, ps Problems) {
+	v := f.tree.GetPath([]string{evmKey})
+	if v == nil {
+		return
+	}
+	tables, ok := v.([]*toml.Tree)
+	if !ok {
+		ps.add(f.tree.GetPositionPath([]string{evmKey}), evmKey, "must be an array of tables, i.e. [[EVM]]")
+		return
+	}
+
+	chainIDs := map[string]struct{}{}
+	nodeNames := map[string]struct{}{}
+	for i, t := range tables {
+		prefix := fmt.Sprintf("%s[%d]", evmKey, i)
+		chain := evmtypes.Chain{Enabled: true}
+		var hasID bool
+		var nodeTables []*toml.Tree
+		for _, key := range t.Keys() {
+			val := t.GetPath([]string{key})
+			pos := t.GetPositionPath([]string{key})
+			name := prefix + "." + key
+			switch key {
+			case "ChainID":
+				hasID = true
+				s, err := scalarString(val)
+				if err == nil {
+					err = chain.ID.UnmarshalText([]byte(s))
+				}
+				if err != nil {
+					ps.add(pos, name, "invalid chain ID: %v", err)
+					continue
+				}
+				id := chain.ID.String()
+				if _, dup := chainIDs[id]; dup {
+					ps.add(pos, name, "duplicate chain ID %s", id)
+				}
+				chainIDs[id] = struct{}{}
+			case "Enabled":
+				b, ok := val.(bool)
+				if !ok {
+					ps.add(pos, name, "must be a boolean")
+					continue
+				}
+				chain.Enabled = b
+			case "Nodes":
+				ns, ok := val.([]*toml.Tree)
+				if !ok {
+					ps.add(pos, name, "must be an array of tables, i.e. [[EVM.Nodes]]")
+					continue
+				}
+				nodeTables = ns
+			default:
+				if err := setChainCfg(&chain.Cfg, key, val); err != nil {
+					ps.add(pos, name, "%v", err)
+				}
+			}
+		}
+		if !hasID {
+			ps.add(t.Position(), prefix, "missing ChainID")
+		}
+		chains = append(chains, chain)
+
+		for j, nt := range nodeTables {
+			node, nps := decodeNode(nt, fmt.Sprintf("%s.Nodes[%d]", prefix, j))
+			ps = append(ps, nps...)
+			if node.Name != "" {
+				if _, dup := nodeNames[node.Name]; dup {
+					ps.add(nt.Position(), fmt.Sprintf("%s.Nodes[%d]", prefix, j), "duplicate node name %q", node.Name)
+				}
+				nodeNames[node.Name] = struct{}{}
+			}
+			node.EVMChainID = chain.ID
+			nodes = append(nodes, node)
+		}
+	}
+	return
+}
+
+func decodeNode(t *toml.Tree, prefix string) (n evmtypes.Node, ps Problems) {
+	for _, key := range t.Keys() {
+		val := t.GetPath([]string{key})
+		pos := t.GetPositionPath([]string{key})
+		name := prefix + "." + key
+		switch key {
+		case "Name":
+			s, ok := val.(string)
+			if !ok || s == "" {
+				ps.add(pos, name, "must be a non-empty string")
+				continue
+			}
+			n.Name = s
+		case "WSURL":
+			s, err := nodeURL(val, "ws", "wss")
+			if err != nil {
+				ps.add(pos, name, "%v", err)
+				continue
+			}
+			n.WSURL = null.StringFrom(s)
+		case "HTTPURL":
+			s, err := nodeURL(val, "http", "https")
+			if err != nil {
+				ps.add(pos, name, "%v", err)
+				continue
+			}
+			n.HTTPURL = null.StringFrom(s)
+		case "SendOnly":
+			b, ok := val.(bool)
+			if !ok {
+				ps.add(pos, name, "must be a boolean")
+				continue
+			}
+			n.SendOnly = b
+		default:
+			ps.add(pos, name, "unknown key")
+		}
+	}
+
+	if !t.Has("Name") {
+		ps.add(t.Position(), prefix, "missing Name")
+	}
+	if n.SendOnly {
+		if t.Has("WSURL") {
+			ps.add(t.GetPositionPath([]string{"WSURL"}), prefix+".WSURL", "must not be set for a send-only node")
+		}
+		if !t.Has("HTTPURL") {
+			ps.add(t.Position(), prefix, "missing HTTPURL, which is required for a send-only node")
+		}
+	} else if !t.Has("WSURL") {
+		ps.add(t.Position(), prefix, "missing WSURL, which is required unless SendOnly is true")
+	}
+	return
+}
+
+func nodeURL(val interface{}, schemes ...string) (string, error) {
+	s, ok := val.(string)
+	if !ok {
+		return "", errors.New("must be a string")
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid URL")
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return s, nil
+		}
+	}
+	return "", errors.Errorf("invalid URL %q: scheme must be one of %s", s, strings.Join(schemes, ", "))
+}
+
+// setChainCfg decodes val into the field key of cfg, the same way chain
+// configs are decoded from the database.
+func setChainCfg(cfg *evmtypes.ChainCfg, key string, val interface{}) error {
+	if _, ok := chainCfgFields[key]; !ok {
+		return errors.New("unknown key")
+	}
+	if t, ok := val.(*toml.Tree); ok {
+		val = t.ToMap()
+	}
+	b, err := json.Marshal(map[string]interface{}{key: val})
+	if err != nil {
+		return errors.Wrap(err, "invalid value")
+	}
+	if err = json.Unmarshal(b, cfg); err != nil {
+		return errors.Wrap(err, "invalid value")
+	}
+	return nil
+}
+
+// scalarString formats val the same way the config reads it from an
+// environment variable.
+func scalarString(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", errors.New("must be a string, number or boolean")
+	}
+}
+
+// parseAs returns an error if s is not a valid value for a config variable of type t.
+func parseAs(t reflect.Type, s string) (err error) {
+	switch t {
+	case reflect.TypeOf(time.Duration(0)), reflect.TypeOf(models.Duration{}):
+		_, err = time.ParseDuration(s)
+		return
+	case reflect.TypeOf(&big.Int{}):
+		_, err = parse.BigInt(s)
+		return
+	case reflect.TypeOf(&url.URL{}), reflect.TypeOf(url.URL{}):
+		_, err = url.Parse(s)
+		return
+	case reflect.TypeOf(net.IP{}):
+		if net.ParseIP(s) == nil {
+			return errors.New("not an IP address")
+		}
+		return nil
+	}
+	if reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return reflect.New(t).Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		_, err = strconv.ParseBool(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(s, 10, t.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(s, 10, t.Bits())
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(s, t.Bits())
+	}
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return numErr.Err
+	}
+	return err
+}
+
+// Dump returns the effective config as TOML. Each global setting is taken
+// from its environment variable if set, otherwise from f, otherwise from its
+// default. The EVM chains of f follow. f may be nil, and secrets are redacted.
+func Dump(f *File) (string, error) {
+	m := map[string]interface{}{}
+	for name := range globals {
+		v := os.Getenv(name)
+		if v == "" && f != nil {
+			if fv := f.tree.GetPath([]string{name}); fv != nil {
+				v, _ = scalarString(fv)
+			}
+		}
+		if v == "" {
+			v = defaults[name]
+		}
+		if v == "" {
+			continue
+		}
+		m[name] = redact(name, v)
+	}
+	if f != nil {
+		if tables, ok := f.tree.GetPath([]string{evmKey}).([]*toml.Tree); ok {
+			evm := make([]map[string]interface{}, len(tables))
+			for i, t := range tables {
+				evm[i] = t.ToMap()
+			}
+			m[evmKey] = evm
+		}
+	}
+
+	tree, err := toml.TreeFromMap(m)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build config tree")
+	}
+	return tree.ToTomlString()
+}
+
+func redact(name, v string) string {
+	switch name {
+	case envvar.Name("DatabaseURL"), envvar.Name("DatabaseBackupURL"):
+		u, err := url.Parse(v)
+		if err != nil {
+			return redacted
+		}
+		return u.Redacted()
+	case envvar.Name("ExplorerAccessKey"), envvar.Name("ExplorerSecret"):
+		return redacted
+	}
+	return v
+}
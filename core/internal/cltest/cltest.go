@@ -90,8 +90,12 @@ const (
 	APIKey = "2d25e62eaf9143e993acaf48691564b2"
 	// APISecret of the fixture API user.
 	APISecret = "1eCP/w0llVkchejFaoBpfIGaLRxZK54lTXBCT22YLW+pdzE4Fafy/XO5LoJ2uwHi"
-	// APIEmail is the email of the fixture API user
+	// APIEmail is the email of the fixture API user, an admin
 	APIEmail = "apiuser@chainlink.test"
+	// APIEmailEdit is the email of the fixture API user with the edit role
+	APIEmailEdit = "apiuser-edit@chainlink.test"
+	// APIEmailViewOnly is the email of the fixture API user with the view role
+	APIEmailViewOnly = "apiuser-view@chainlink.test"
 	// Password just a password we use everywhere for testing
 	Password = "p4SsW0rD1!@#_"
 	// SessionSecret is the hardcoded secret solely used for test
@@ -548,9 +552,9 @@ func (ta *TestApplication) Stop() error {
 	return err
 }
 
-func (ta *TestApplication) MustSeedNewSession() (id string) {
+func (ta *TestApplication) MustSeedNewSession(email string) (id string) {
 	session := NewSession()
-	err := ta.GetSqlxDB().Get(&id, `INSERT INTO sessions (id, last_used, created_at) VALUES ($1, $2, NOW()) RETURNING id`, session.ID, session.LastUsed)
+	err := ta.GetSqlxDB().Get(&id, `INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, $3, NOW()) RETURNING id`, session.ID, email, session.LastUsed)
 	require.NoError(ta.t, err)
 	return id
 }
@@ -565,7 +569,15 @@ func (ta *TestApplication) Import(content string) {
 func (ta *TestApplication) NewHTTPClient() HTTPClientCleaner {
 	ta.t.Helper()
 
-	sessionID := ta.MustSeedNewSession()
+	return ta.NewHTTPClientForUser(APIEmail)
+}
+
+// NewHTTPClientForUser returns a client authenticated with a session of the
+// fixture user with the given email
+func (ta *TestApplication) NewHTTPClientForUser(email string) HTTPClientCleaner {
+	ta.t.Helper()
+
+	sessionID := ta.MustSeedNewSession(email)
 
 	return HTTPClientCleaner{
 		HTTPClient: NewMockAuthenticatedHTTPClient(ta.Config, sessionID),
@@ -575,7 +587,7 @@ func (ta *TestApplication) NewHTTPClient() HTTPClientCleaner {
 
 // NewClientAndRenderer creates a new cmd.Client for the test application
 func (ta *TestApplication) NewClientAndRenderer() (*cmd.Client, *RendererMock) {
-	sessionID := ta.MustSeedNewSession()
+	sessionID := ta.MustSeedNewSession(APIEmail)
 	r := &RendererMock{}
 	lggr := logger.TestLogger(ta.t)
 	client := &cmd.Client{
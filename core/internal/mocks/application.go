@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	audit "github.com/smartcontractkit/chainlink/core/services/audit"
+
 	big "math/big"
 
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
@@ -12,6 +14,8 @@ import (
 
 	context "context"
 
+	events "github.com/smartcontractkit/chainlink/core/services/events"
+
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 
 	job "github.com/smartcontractkit/chainlink/core/services/job"
@@ -108,6 +112,22 @@ func (_m *Application) EVMORM() types.ORM {
 	return r0
 }
 
+// GetAuditLogger provides a mock function with given fields:
+func (_m *Application) GetAuditLogger() audit.Logger {
+	ret := _m.Called()
+
+	var r0 audit.Logger
+	if rf, ok := ret.Get(0).(func() audit.Logger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.Logger)
+		}
+	}
+
+	return r0
+}
+
 // GetChains provides a mock function with given fields:
 func (_m *Application) GetChains() chainlink.Chains {
 	ret := _m.Called()
@@ -154,6 +174,22 @@ func (_m *Application) GetEventBroadcaster() pg.EventBroadcaster {
 	return r0
 }
 
+// GetEventStream provides a mock function with given fields:
+func (_m *Application) GetEventStream() events.Stream {
+	ret := _m.Called()
+
+	var r0 events.Stream
+	if rf, ok := ret.Get(0).(func() events.Stream); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(events.Stream)
+		}
+	}
+
+	return r0
+}
+
 // GetExternalInitiatorManager provides a mock function with given fields:
 func (_m *Application) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	ret := _m.Called()
@@ -328,6 +364,27 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// ReloadConfig provides a mock function with given fields:
+func (_m *Application) ReloadConfig() (config.ReloadResult, error) {
+	ret := _m.Called()
+
+	var r0 config.ReloadResult
+	if rf, ok := ret.Get(0).(func() config.ReloadResult); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(config.ReloadResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -342,6 +399,27 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReplayPipelineRun provides a mock function with given fields: ctx, runID, spec
+func (_m *Application) ReplayPipelineRun(ctx context.Context, runID int64, spec *pipeline.Spec) (pipeline.ReplayResult, error) {
+	ret := _m.Called(ctx, runID, spec)
+
+	var r0 pipeline.ReplayResult
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *pipeline.Spec) pipeline.ReplayResult); ok {
+		r0 = rf(ctx, runID, spec)
+	} else {
+		r0 = ret.Get(0).(pipeline.ReplayResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *pipeline.Spec) error); ok {
+		r1 = rf(ctx, runID, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -58,6 +58,7 @@ func ExampleRun() {
 	//    attempts, txas  Commands for managing Ethereum Transaction Attempts
 	//    blocks          Commands for managing blocks
 	//    bridges         Commands for Bridges communicating with External Adapters
+	//    bundle          Commands for moving the jobs, bridges, external initiators, chains and nodes of a node to another one
 	//    config          Commands for the node's configuration
 	//    jobs            Commands for managing Jobs
 	//    keys            Commands for managing various types of keys used by the Chainlink node
@@ -66,12 +67,16 @@ func ExampleRun() {
 	//    chains          Commands for handling chain configuration
 	//    nodes           Commands for handling node configuration
 	//    forwarders      Commands for managing forwarder addresses.
+	//    keeper          Commands for keeper jobs.
+	//    vrf             Commands for VRF requests and subscriptions.
 	//    help, h         Shows a list of commands or help for one command
 	//
 	// GLOBAL OPTIONS:
-	//    --json, -j     json output as opposed to table
-	//    --help, -h     show help
-	//    --version, -v  print the version
+	//    --json, -j             json output as opposed to table
+	//    --config value         TOML config file; any environment variables that are set take precedence over it
+	//    --profiles-file value  TOML file of named nodes for the --profile and --profiles flags of remote commands (default: profiles.toml in the root directory)
+	//    --help, -h             show help
+	//    --version, -v          print the version
 	// core.test version 0.0.0@exampleSHA
 }
 
@@ -86,6 +91,9 @@ func ExampleRun_admin() {
 	//
 	// COMMANDS:
 	//    chpass  Change your API password remotely
+	//    users   Create, edit permissions, or delete API users
+	//    tokens  Create, list or revoke your named API tokens
+	//    audit   List the audit log of state-changing API actions, most recent first
 	//    login   Login to remote client by creating a session cookie
 	//
 	// OPTIONS:
@@ -154,6 +162,10 @@ func ExampleRun_config() {
 	//
 	// COMMANDS:
 	//    list         Show the node's environment variables
+	//    provenance   Show the source of each global and per chain setting, and any values it overrides
+	//    reload       Re-read the node's config files and apply the changed settings that do not need a restart
+	//    validate     Report unknown keys and invalid values in a TOML config file, given as an argument or with --config
+	//    dump         Print the effective config as TOML, merging environment variables over the config file and defaults
 	//    setgasprice  Set the default gas price to use for outgoing transactions
 	//    loglevel     Set log level
 	//    logpkg       Set package specific logging
@@ -178,6 +190,8 @@ func ExampleRun_jobs() {
 	//    create  Create a job
 	//    delete  Delete a job
 	//    run     Trigger a job run
+	//    replay  Replay a pipeline run with its recorded http and bridge results and diff it against the original
+	//    lint    Validate job spec files without creating the jobs, and report settings and tasks which would be ignored or fail
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -193,14 +207,17 @@ func ExampleRun_keys() {
 	//    core.test keys command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    eth     Remote commands for administering the node's Ethereum keys
-	//    p2p     Remote commands for administering the node's p2p keys
-	//    csa     Remote commands for administering the node's CSA keys
-	//    ocr     Remote commands for administering the node's legacy off chain reporting keys
-	//    ocr2    Remote commands for administering the node's off chain reporting keys
-	//    solana  Remote commands for administering the node's solana keys
-	//    terra   Remote commands for administering the node's terra keys
-	//    vrf     Remote commands for administering the node's vrf keys
+	//    rotate-password  Re-encrypts the keystore with a new password. The node must be started with the new password from then on.
+	//    backup           Saves all of the keys of the node, with the states of its ETH keys, to a single password protected file
+	//    restore          Restores the keys of a file saved by 'keys backup', skipping the keys the node already has
+	//    eth              Remote commands for administering the node's Ethereum keys
+	//    p2p              Remote commands for administering the node's p2p keys
+	//    csa              Remote commands for administering the node's CSA keys
+	//    ocr              Remote commands for administering the node's legacy off chain reporting keys
+	//    ocr2             Remote commands for administering the node's off chain reporting keys
+	//    solana           Remote commands for administering the node's solana keys
+	//    terra            Remote commands for administering the node's terra keys
+	//    vrf              Remote commands for administering the node's vrf keys
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -222,6 +239,9 @@ func ExampleRun_keys_eth() {
 	//    delete  Delete the ETH key by address
 	//    import  Import an ETH key from a JSON file
 	//    export  Exports an ETH key to a JSON file
+	//    hd      Commands for the HD wallet from which new ETH keys are derived, so that they can be recovered from its mnemonic
+	//    policy  Commands for the policies which limit the transactions of ETH keys
+	//    usage   Show the jobs which use the ETH key with the given address, and what its transactions spent on gas per job
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -376,6 +396,7 @@ func ExampleRun_node() {
 	//    core.test node command [command options] [arguments...]
 	//
 	// COMMANDS:
+	//    doctor                    Run diagnostic checks against the running node, e.g. of its database, RPC nodes and keys, and suggest fixes for any problems
 	//    setnextnonce              Manually set the next nonce for a key. This should NEVER be necessary during normal operation. USE WITH CAUTION: Setting this incorrectly can break your node.
 	//    start, node, n            Run the Chainlink node
 	//    rebroadcast-transactions  Manually rebroadcast txs matching nonce range with the specified gas price. This is useful in emergencies e.g. high gas prices and/or network congestion to forcibly clear out the pending TX queue
@@ -434,6 +455,8 @@ func ExampleRun_node_profile() {
 	// OPTIONS:
 	//    --seconds value, -s value     duration of profile capture (default: 8)
 	//    --output_dir value, -o value  output directory of the captured profile (default: "/tmp/")
+	//    --profile value               name of the profile in the profiles file to run the command against
+	//    --profiles value              comma separated profile names, or "all", to run the command against each of them
 }
 
 func ExampleRun_txs() {
@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// maxParamLength bounds the length of the string parameters stored with an
+// entry, e.g. job specs
+const maxParamLength = 4096
+
+// redactedValue replaces the values of sensitive parameters
+const redactedValue = "*REDACTED*"
+
+// NOTE: must be in lowercase for case insensitive match
+var sensitiveParams = []string{
+	"password",
+	"secret",
+	"token",
+	"accesskey",
+	"privatekey",
+	"mnemonic",
+	"crypto",
+}
+
+// Entry is a state-changing action taken through the REST or GraphQL API.
+// Exactly one of UserEmail and ExternalInitiator is set, except for requests
+// which failed to authenticate. APITokenKey is set when a user authenticated
+// with an API token rather than a session.
+type Entry struct {
+	ID                int64       `json:"id"`
+	UserEmail         null.String `json:"userEmail"`
+	APITokenKey       null.String `json:"apiTokenKey" db:"api_token_key"`
+	ExternalInitiator null.String `json:"externalInitiator"`
+	SourceIP          string      `json:"sourceIP" db:"source_ip"`
+	// Action is "<METHOD> <route>" for REST requests, e.g.
+	// "POST /v2/jobs", and "mutation <field>" for GraphQL mutations.
+	Action string `json:"action"`
+	// Resource is the request path for REST requests, and the ID of the
+	// affected resource, if any, for GraphQL mutations.
+	Resource string `json:"resource"`
+	// Params holds the query parameters and JSON body of REST requests, or
+	// the arguments of GraphQL mutations, with sensitive values redacted.
+	Params Params `json:"params"`
+	// StatusCode is the HTTP status of the response
+	StatusCode int       `json:"statusCode"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// Params are the parameters of an audited action
+type Params map[string]interface{}
+
+// Value returns this instance serialized for database storage.
+func (p Params) Value() (driver.Value, error) {
+	if p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p)
+}
+
+// Scan reads the database value and returns an instance.
+func (p *Params) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("unable to convert %v of %T to Params", value, value)
+	}
+	return json.Unmarshal(b, p)
+}
+
+// Redact returns a copy of params with the values of sensitive parameters,
+// such as passwords, secrets, tokens and encrypted keys, replaced and long
+// strings truncated. Nested objects and arrays are redacted recursively.
+func Redact(params map[string]interface{}) Params {
+	if params == nil {
+		return Params{}
+	}
+	return Params(redactValue(params).(map[string]interface{}))
+}
+
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		cleaned := make(map[string]interface{}, len(val))
+		for k, v := range val {
+			if isSensitive(k) {
+				cleaned[k] = redactedValue
+				continue
+			}
+			cleaned[k] = redactValue(v)
+		}
+		return cleaned
+	case []interface{}:
+		cleaned := make([]interface{}, len(val))
+		for i, v := range val {
+			cleaned[i] = redactValue(v)
+		}
+		return cleaned
+	case []string:
+		cleaned := make([]interface{}, len(val))
+		for i, v := range val {
+			cleaned[i] = redactValue(v)
+		}
+		return cleaned
+	case string:
+		if len(val) > maxParamLength {
+			return fmt.Sprintf("%s...(%d bytes)", val[:maxParamLength], len(val))
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+func isSensitive(param string) bool {
+	lp := strings.ToLower(param)
+	for _, s := range sensitiveParams {
+		if strings.Contains(lp, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package audit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("a", 5000)
+
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		want   audit.Params
+	}{
+		{"nil", nil, audit.Params{}},
+		{"plain", map[string]interface{}{"name": "bridge", "confirmations": float64(1)}, audit.Params{"name": "bridge", "confirmations": float64(1)}},
+		{"passwords", map[string]interface{}{"oldPassword": "a", "newpassword": "b"}, audit.Params{"oldPassword": "*REDACTED*", "newpassword": "*REDACTED*"}},
+		{"tokens and secrets", map[string]interface{}{"outgoingToken": "a", "secret": "b", "accessKey": "c"}, audit.Params{"outgoingToken": "*REDACTED*", "secret": "*REDACTED*", "accessKey": "*REDACTED*"}},
+		{"encrypted key", map[string]interface{}{"address": "0x1", "crypto": map[string]interface{}{"cipher": "aes"}}, audit.Params{"address": "0x1", "crypto": "*REDACTED*"}},
+		{
+			"nested",
+			map[string]interface{}{
+				"query": map[string]interface{}{"oldpassword": "a", "evmChainID": "1"},
+				"input": []interface{}{map[string]interface{}{"privateKey": "b"}},
+			},
+			audit.Params{
+				"query": map[string]interface{}{"oldpassword": "*REDACTED*", "evmChainID": "1"},
+				"input": []interface{}{map[string]interface{}{"privateKey": "*REDACTED*"}},
+			},
+		},
+		{"long strings", map[string]interface{}{"toml": long}, audit.Params{"toml": long[:4096] + "...(5000 bytes)"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, audit.Redact(tt.params))
+		})
+	}
+}
+
+func TestRedact_DoesNotModifyParams(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]interface{}{"password": "hunter2"}
+	audit.Redact(params)
+	assert.Equal(t, "hunter2", params["password"])
+}
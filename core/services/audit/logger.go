@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Logger records audit log entries in the database and, if a file is
+// configured, appends them to it as JSON lines.
+type Logger interface {
+	services.ServiceCtx
+	// Record redacts the params of entry and stores it. Failures are logged
+	// rather than returned, as they must not fail the audited action. The
+	// entry is written to the file even if it could not be stored in the
+	// database.
+	Record(entry *Entry)
+	// Entries returns a page of entries, most recent first, and the total
+	// number of entries.
+	Entries(offset, limit int) ([]Entry, int, error)
+}
+
+type auditLogger struct {
+	utils.StartStopOnce
+	orm  ORM
+	lggr logger.Logger
+	path string
+
+	fileMu sync.Mutex
+	file   *os.File
+}
+
+var _ Logger = (*auditLogger)(nil)
+
+// NewLogger returns a Logger storing entries with orm. If path is not
+// empty, entries are also appended to that file.
+func NewLogger(orm ORM, path string, lggr logger.Logger) Logger {
+	return &auditLogger{
+		orm:  orm,
+		lggr: lggr.Named("AuditLogger"),
+		path: path,
+	}
+}
+
+func (l *auditLogger) Start(context.Context) error {
+	return l.StartOnce("AuditLogger", func() error {
+		if l.path == "" {
+			return nil
+		}
+		f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Wrap(err, "AuditLogger: could not open audit log file")
+		}
+		l.fileMu.Lock()
+		l.file = f
+		l.fileMu.Unlock()
+		return nil
+	})
+}
+
+func (l *auditLogger) Close() error {
+	return l.StopOnce("AuditLogger", func() error {
+		l.fileMu.Lock()
+		defer l.fileMu.Unlock()
+		if l.file == nil {
+			return nil
+		}
+		err := l.file.Close()
+		l.file = nil
+		return err
+	})
+}
+
+func (l *auditLogger) Record(entry *Entry) {
+	entry.Params = Redact(entry.Params)
+	if err := l.orm.CreateEntry(entry); err != nil {
+		l.lggr.Errorw("Failed to store audit log entry", "err", err, "action", entry.Action, "resource", entry.Resource)
+	}
+	l.writeFile(entry)
+}
+
+func (l *auditLogger) writeFile(entry *Entry) {
+	l.fileMu.Lock()
+	defer l.fileMu.Unlock()
+	if l.file == nil {
+		return
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		l.lggr.Errorw("Failed to marshal audit log entry", "err", err)
+		return
+	}
+	if _, err = l.file.Write(append(b, '\n')); err != nil {
+		l.lggr.Errorw("Failed to write audit log entry to file", "err", err, "path", l.path)
+	}
+}
+
+func (l *auditLogger) Entries(offset, limit int) ([]Entry, int, error) {
+	return l.orm.Entries(offset, limit)
+}
@@ -0,0 +1,117 @@
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+type fakeORM struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+	err     error
+}
+
+func (o *fakeORM) CreateEntry(entry *audit.Entry) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.err != nil {
+		return o.err
+	}
+	entry.ID = int64(len(o.entries) + 1)
+	o.entries = append(o.entries, *entry)
+	return nil
+}
+
+func (o *fakeORM) Entries(offset, limit int) ([]audit.Entry, int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.entries, len(o.entries), nil
+}
+
+func readEntries(t *testing.T, path string) (entries []audit.Entry) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry audit.Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return
+}
+
+func TestLogger_Record(t *testing.T) {
+	t.Parallel()
+
+	orm := &fakeORM{}
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l := audit.NewLogger(orm, path, logger.TestLogger(t))
+	require.NoError(t, l.Start(testutils.Context(t)))
+
+	l.Record(&audit.Entry{
+		UserEmail:  null.StringFrom("user@chain.link"),
+		Action:     "PATCH /v2/user/password",
+		Params:     audit.Params{"body": map[string]interface{}{"oldPassword": "a", "newPassword": "b"}},
+		StatusCode: 200,
+	})
+
+	// Entries are written to the file even if they cannot be stored
+	orm.err = errors.New("connection refused")
+	l.Record(&audit.Entry{Action: "DELETE /v2/jobs/:ID", Resource: "/v2/jobs/1", StatusCode: 204})
+
+	require.NoError(t, l.Close())
+
+	entries, count, err := l.Entries(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, audit.Params{"body": map[string]interface{}{"oldPassword": "*REDACTED*", "newPassword": "*REDACTED*"}}, entries[0].Params)
+
+	lines := readEntries(t, path)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "user@chain.link", lines[0].UserEmail.String)
+	assert.Equal(t, audit.Params{"body": map[string]interface{}{"oldPassword": "*REDACTED*", "newPassword": "*REDACTED*"}}, lines[0].Params)
+	assert.Equal(t, "DELETE /v2/jobs/:ID", lines[1].Action)
+	assert.Equal(t, "/v2/jobs/1", lines[1].Resource)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestLogger_NoFile(t *testing.T) {
+	t.Parallel()
+
+	orm := &fakeORM{}
+	l := audit.NewLogger(orm, "", logger.TestLogger(t))
+	require.NoError(t, l.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, l.Close()) })
+
+	l.Record(&audit.Entry{Action: "POST /v2/jobs", StatusCode: 200})
+
+	_, count, err := l.Entries(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestLogger_Start_InvalidPath(t *testing.T) {
+	t.Parallel()
+
+	l := audit.NewLogger(&fakeORM{}, filepath.Join(t.TempDir(), "missing", "audit.jsonl"), logger.TestLogger(t))
+	require.Error(t, l.Start(testutils.Context(t)))
+}
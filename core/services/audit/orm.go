@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ORM stores audit log entries. The audit_log table is append-only: the
+// database rejects updates and deletes.
+type ORM interface {
+	CreateEntry(entry *Entry) error
+	// Entries returns a page of entries, most recent first, and the total
+	// number of entries.
+	Entries(offset, limit int) ([]Entry, int, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	namedLogger := lggr.Named("AuditORM")
+	return &orm{pg.NewQ(db, namedLogger, cfg)}
+}
+
+// CreateEntry inserts the entry, and sets its ID and CreatedAt.
+func (o *orm) CreateEntry(entry *Entry) error {
+	sql := `INSERT INTO audit_log (user_email, api_token_key, external_initiator, source_ip, action, resource, params, status_code, created_at)
+VALUES (:user_email, :api_token_key, :external_initiator, :source_ip, :action, :resource, :params, :status_code, NOW())
+RETURNING *`
+	return errors.Wrap(o.q.GetNamed(sql, entry, entry), "CreateEntry failed to insert entry")
+}
+
+func (o *orm) Entries(offset, limit int) (entries []Entry, count int, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, "SELECT COUNT(*) FROM audit_log"); err != nil {
+			return errors.Wrap(err, "Entries failed to get count")
+		}
+		sql := `SELECT * FROM audit_log ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2;`
+		if err = tx.Select(&entries, sql, limit, offset); err != nil {
+			return errors.Wrap(err, "Entries failed to load audit_log")
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+
+	return
+}
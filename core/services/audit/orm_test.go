@@ -0,0 +1,67 @@
+package audit_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+func TestORM_CreateEntry_Entries(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := audit.NewORM(db, logger.TestLogger(t), cfg)
+
+	first := audit.Entry{
+		UserEmail:  null.StringFrom(cltest.APIEmail),
+		SourceIP:   "127.0.0.1",
+		Action:     "POST /v2/bridge_types",
+		Resource:   "/v2/bridge_types",
+		Params:     audit.Params{"body": map[string]interface{}{"name": "bridge"}},
+		StatusCode: http.StatusCreated,
+	}
+	require.NoError(t, orm.CreateEntry(&first))
+	assert.NotZero(t, first.ID)
+	assert.False(t, first.CreatedAt.IsZero())
+
+	second := audit.Entry{
+		ExternalInitiator: null.StringFrom("ei"),
+		SourceIP:          "127.0.0.1",
+		Action:            "POST /v2/jobs/:ID/runs",
+		Resource:          "/v2/jobs/1/runs",
+		StatusCode:        http.StatusOK,
+	}
+	require.NoError(t, orm.CreateEntry(&second))
+
+	entries, count, err := orm.Entries(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, entries, 2)
+	assert.Equal(t, second.ID, entries[0].ID)
+	assert.Equal(t, "ei", entries[0].ExternalInitiator.String)
+	assert.Equal(t, audit.Params{}, entries[0].Params)
+	assert.Equal(t, first.ID, entries[1].ID)
+	assert.Equal(t, cltest.APIEmail, entries[1].UserEmail.String)
+	assert.Equal(t, first.Params, entries[1].Params)
+
+	entries, count, err = orm.Entries(1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, entries, 1)
+	assert.Equal(t, first.ID, entries[0].ID)
+
+	// The audit log is append-only
+	_, err = db.Exec(`UPDATE audit_log SET status_code = 200`)
+	require.Error(t, err)
+	_, err = db.Exec(`DELETE FROM audit_log`)
+	require.Error(t, err)
+}
@@ -0,0 +1,235 @@
+// Package bundle moves the workload of a node to another one. A bundle holds
+// the jobs, bridges, external initiators, EVM chains and nodes of a node, and
+// optionally its keys, in a single gzipped tar archive:
+//
+//	manifest.json           everything but the job specs and keys
+//	jobs/<externalJobID>.toml
+//	keys/<type>/<id>.json   in the encrypted format of each key type's export
+//
+// Bundles hold the credentials of bridges and external initiators in plain
+// text, so they must be kept as safe as the database they come from.
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Version of the bundle format.
+const Version = 1
+
+const manifestFile = "manifest.json"
+
+// maxFileSize bounds each file read from an archive.
+const maxFileSize = 64 << 20
+
+// Bundle is the exported workload of a node.
+type Bundle struct {
+	Version            int                 `json:"version"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	Bridges            []Bridge            `json:"bridges"`
+	ExternalInitiators []ExternalInitiator `json:"externalInitiators"`
+	EVMChains          []EVMChain          `json:"evmChains"`
+	EVMNodes           []EVMNode           `json:"evmNodes"`
+	Jobs               []Job               `json:"jobs"`
+	Keys               []Key               `json:"keys"`
+}
+
+// Bridge is a bridge with its credentials, so that external adapters keep
+// working after an import.
+type Bridge struct {
+	Name                   string        `json:"name"`
+	URL                    models.WebURL `json:"url"`
+	Confirmations          uint32        `json:"confirmations"`
+	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	IncomingTokenHash      string        `json:"incomingTokenHash"`
+	Salt                   string        `json:"salt"`
+	OutgoingToken          string        `json:"outgoingToken"`
+}
+
+// ExternalInitiator is an external initiator with its credentials.
+type ExternalInitiator struct {
+	Name           string         `json:"name"`
+	URL            *models.WebURL `json:"url,omitempty"`
+	AccessKey      string         `json:"accessKey"`
+	Salt           string         `json:"salt"`
+	HashedSecret   string         `json:"hashedSecret"`
+	OutgoingToken  string         `json:"outgoingToken"`
+	OutgoingSecret string         `json:"outgoingSecret"`
+}
+
+// EVMChain is an EVM chain with its config overrides.
+type EVMChain struct {
+	ID      utils.Big         `json:"id"`
+	Enabled bool              `json:"enabled"`
+	Config  evmtypes.ChainCfg `json:"config"`
+}
+
+// EVMNode is an RPC node of an EVM chain.
+type EVMNode struct {
+	Name       string      `json:"name"`
+	EVMChainID utils.Big   `json:"evmChainID"`
+	WSURL      null.String `json:"wsURL"`
+	HTTPURL    null.String `json:"httpURL"`
+	SendOnly   bool        `json:"sendOnly"`
+}
+
+// Job is a job, by its TOML spec.
+type Job struct {
+	ExternalJobID uuid.UUID `json:"externalJobID"`
+	Name          string    `json:"name"`
+	Type          job.Type  `json:"type"`
+	// TOML is kept in its own file of the archive.
+	TOML string `json:"-"`
+}
+
+// KeyType is the type of a key, as named in the CLI and API.
+type KeyType string
+
+const (
+	KeyTypeCSA    KeyType = "csa"
+	KeyTypeETH    KeyType = "eth"
+	KeyTypeOCR    KeyType = "ocr"
+	KeyTypeOCR2   KeyType = "ocr2"
+	KeyTypeP2P    KeyType = "p2p"
+	KeyTypeSolana KeyType = "solana"
+	KeyTypeTerra  KeyType = "terra"
+	KeyTypeVRF    KeyType = "vrf"
+)
+
+// Key is a key, encrypted with the password of the export.
+type Key struct {
+	Type KeyType `json:"type"`
+	ID   string  `json:"id"`
+	// EVMChainID is the chain an ETH key was enabled for.
+	EVMChainID *utils.Big `json:"evmChainID,omitempty"`
+	// JSON is kept in its own file of the archive.
+	JSON []byte `json:"-"`
+}
+
+func (j Job) file() string {
+	return path.Join("jobs", j.ExternalJobID.String()+".toml")
+}
+
+func (k Key) file() string {
+	// IDs of some key types have a prefix separated by '_', and none have '/'
+	return path.Join("keys", string(k.Type), strings.ReplaceAll(k.ID, "/", "_")+".json")
+}
+
+// Write writes b to w as a gzipped tar archive.
+func (b *Bundle) Write(w io.Writer) error {
+	manifest, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode manifest")
+	}
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	type file struct {
+		name string
+		data []byte
+	}
+	files := []file{{manifestFile, manifest}}
+	for _, j := range b.Jobs {
+		files = append(files, file{j.file(), []byte(j.TOML)})
+	}
+	for _, k := range b.Keys {
+		files = append(files, file{k.file(), k.JSON})
+	}
+	for _, f := range files {
+		hdr := &tar.Header{Name: f.name, Mode: 0600, Size: int64(len(f.data)), ModTime: b.CreatedAt}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return errors.Wrapf(err, "failed to write %s", f.name)
+		}
+		if _, err = tw.Write(f.data); err != nil {
+			return errors.Wrapf(err, "failed to write %s", f.name)
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Bytes returns b as a gzipped tar archive.
+func (b *Bundle) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Read reads a bundle from a gzipped tar archive.
+func Read(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle is not a gzipped archive")
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "failed to read bundle")
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(tr, maxFileSize+1))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", hdr.Name)
+		}
+		if len(data) > maxFileSize {
+			return nil, errors.Errorf("%s is too large", hdr.Name)
+		}
+		files[hdr.Name] = data
+	}
+
+	manifest, ok := files[manifestFile]
+	if !ok {
+		return nil, errors.Errorf("bundle has no %s", manifestFile)
+	}
+	var b Bundle
+	if err = json.Unmarshal(manifest, &b); err != nil {
+		return nil, errors.Wrap(err, "failed to decode manifest")
+	}
+	if b.Version != Version {
+		return nil, errors.Errorf("unsupported bundle version %d, expected %d", b.Version, Version)
+	}
+	for i, j := range b.Jobs {
+		spec, ok := files[j.file()]
+		if !ok {
+			return nil, errors.Errorf("bundle has no spec for job %s", j.ExternalJobID)
+		}
+		b.Jobs[i].TOML = string(spec)
+	}
+	for i, k := range b.Keys {
+		keyJSON, ok := files[k.file()]
+		if !ok {
+			return nil, errors.Errorf("bundle has no file for %s key %s", k.Type, k.ID)
+		}
+		b.Keys[i].JSON = keyJSON
+	}
+	return &b, nil
+}
@@ -0,0 +1,126 @@
+package bundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/bundle"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestBundle_WriteRead(t *testing.T) {
+	t.Parallel()
+
+	eiURL := cltest.WebURL(t, "http://ei.example.com")
+	want := &bundle.Bundle{
+		Version:   bundle.Version,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+		Bridges: []bundle.Bridge{{
+			Name:                   "adapter",
+			URL:                    cltest.WebURL(t, "http://adapter.example.com"),
+			Confirmations:          3,
+			MinimumContractPayment: assets.NewLinkFromJuels(100),
+			IncomingTokenHash:      "hash",
+			Salt:                   "salt",
+			OutgoingToken:          "token",
+		}},
+		ExternalInitiators: []bundle.ExternalInitiator{{
+			Name:      "ei",
+			URL:       &eiURL,
+			AccessKey: "key",
+		}},
+		EVMChains: []bundle.EVMChain{{
+			ID:      *utils.NewBigI(1337),
+			Enabled: true,
+			Config:  evmtypes.ChainCfg{EvmFinalityDepth: null.IntFrom(10)},
+		}},
+		EVMNodes: []bundle.EVMNode{{
+			Name:       "primary",
+			EVMChainID: *utils.NewBigI(1337),
+			WSURL:      null.StringFrom("ws://localhost:8546"),
+		}},
+		Jobs: []bundle.Job{{
+			ExternalJobID: uuid.NewV4(),
+			Name:          "cron",
+			Type:          job.Cron,
+			TOML:          "type = \"cron\"\n",
+		}},
+		Keys: []bundle.Key{
+			{Type: bundle.KeyTypeETH, ID: "0x123", EVMChainID: utils.NewBigI(1337), JSON: []byte(`{"address":"123"}`)},
+			{Type: bundle.KeyTypeP2P, ID: "p2p_12D3KooW", JSON: []byte(`{}`)},
+		},
+	}
+
+	archive, err := want.Bytes()
+	require.NoError(t, err)
+	got, err := bundle.Read(bytes.NewReader(archive))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestRead_Errors(t *testing.T) {
+	t.Parallel()
+
+	archive := func(files map[string]string) []byte {
+		var b bytes.Buffer
+		gz := gzip.NewWriter(&b)
+		tw := tar.NewWriter(gz)
+		for name, data := range files {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))}))
+			_, err := tw.Write([]byte(data))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+		return b.Bytes()
+	}
+	jobID := uuid.NewV4()
+
+	tests := []struct {
+		name    string
+		archive []byte
+		wantErr string
+	}{
+		{"not gzipped", []byte(`{"version":1}`), "bundle is not a gzipped archive"},
+		{"no manifest", archive(nil), "bundle has no manifest.json"},
+		{"bad version", archive(map[string]string{"manifest.json": `{"version":2}`}), "unsupported bundle version 2, expected 1"},
+		{"missing job", archive(map[string]string{
+			"manifest.json": `{"version":1,"jobs":[{"externalJobID":"` + jobID.String() + `"}]}`,
+		}), "bundle has no spec for job " + jobID.String()},
+		{"missing key", archive(map[string]string{
+			"manifest.json": `{"version":1,"keys":[{"type":"vrf","id":"0xabc"}]}`,
+		}), "bundle has no file for vrf key 0xabc"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bundle.Read(bytes.NewReader(tt.archive))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestImportResult_Failed(t *testing.T) {
+	t.Parallel()
+
+	r := bundle.ImportResult{Items: []bundle.ItemResult{
+		{Status: bundle.StatusCreated},
+		{Status: bundle.StatusFailed},
+		{Status: bundle.StatusExists},
+		{Status: bundle.StatusFailed},
+	}}
+	assert.Equal(t, 2, r.Failed())
+}
@@ -0,0 +1,478 @@
+package bundle
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+)
+
+// pageSize is the number of records loaded per query when exporting.
+const pageSize = 1000
+
+// CreateJobFunc validates a TOML job spec and creates the job, the same way
+// as when a job is created through the API.
+type CreateJobFunc func(ctx context.Context, toml string) (job.Job, error)
+
+// ExportOpts configures an export.
+type ExportOpts struct {
+	// KeyPassword encrypts the exported keys. Keys are left out without it.
+	KeyPassword string
+}
+
+// Status is the outcome of importing an item of a bundle.
+type Status string
+
+const (
+	StatusCreated Status = "created"
+	// StatusExists is reported for items the node already has, which are
+	// left as they are.
+	StatusExists Status = "exists"
+	StatusFailed Status = "failed"
+)
+
+// ItemResult is the outcome of importing an item of a bundle.
+type ItemResult struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ImportResult lists the outcome of importing each item of a bundle.
+type ImportResult struct {
+	Items []ItemResult `json:"items"`
+}
+
+// Failed returns the number of items which failed to import.
+func (r ImportResult) Failed() (n int) {
+	for _, item := range r.Items {
+		if item.Status == StatusFailed {
+			n++
+		}
+	}
+	return
+}
+
+func (r *ImportResult) add(kind, id string, created bool, err error) {
+	item := ItemResult{Kind: kind, ID: id, Status: StatusExists}
+	if err != nil {
+		item.Status, item.Error = StatusFailed, err.Error()
+	} else if created {
+		item.Status = StatusCreated
+	}
+	r.Items = append(r.Items, item)
+}
+
+// Service exports the workload of a node to a bundle, and imports it into
+// another one.
+type Service interface {
+	Export(opts ExportOpts) (*Bundle, error)
+	// Import creates the items of b which the node does not have yet, in
+	// order of dependency. Items which fail to import are reported, and do not
+	// stop the import. As items which already exist are skipped, a bundle can
+	// be imported again once the cause of a failure is fixed.
+	//
+	// EVM chains and nodes are only connected to on the next start of the
+	// node, so jobs which need a newly imported chain fail to import until then.
+	Import(ctx context.Context, b *Bundle, keyPassword string) (ImportResult, error)
+}
+
+type service struct {
+	bridgeORM bridges.ORM
+	evmORM    evmtypes.ORM
+	jobORM    job.ORM
+	eiManager webhook.ExternalInitiatorManager
+	keyStore  keystore.Master
+	createJob CreateJobFunc
+	lggr      logger.Logger
+}
+
+var _ Service = (*service)(nil)
+
+// NewService constructs a bundle Service.
+func NewService(
+	bridgeORM bridges.ORM,
+	evmORM evmtypes.ORM,
+	jobORM job.ORM,
+	eiManager webhook.ExternalInitiatorManager,
+	keyStore keystore.Master,
+	createJob CreateJobFunc,
+	lggr logger.Logger,
+) Service {
+	return &service{
+		bridgeORM: bridgeORM,
+		evmORM:    evmORM,
+		jobORM:    jobORM,
+		eiManager: eiManager,
+		keyStore:  keyStore,
+		createJob: createJob,
+		lggr:      lggr.Named("Bundle"),
+	}
+}
+
+// all loads every record of a paginated query.
+func all[T any](page func(offset, limit int) ([]T, int, error)) ([]T, error) {
+	var items []T
+	for {
+		batch, count, err := page(len(items), pageSize)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, batch...)
+		if len(batch) == 0 || len(items) >= count {
+			return items, nil
+		}
+	}
+}
+
+func (s *service) Export(opts ExportOpts) (*Bundle, error) {
+	b := &Bundle{Version: Version, CreatedAt: time.Now().UTC()}
+
+	bts, err := all(s.bridgeORM.BridgeTypes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load bridges")
+	}
+	for _, bt := range bts {
+		b.Bridges = append(b.Bridges, Bridge{
+			Name:                   bt.Name.String(),
+			URL:                    bt.URL,
+			Confirmations:          bt.Confirmations,
+			MinimumContractPayment: bt.MinimumContractPayment,
+			IncomingTokenHash:      bt.IncomingTokenHash,
+			Salt:                   bt.Salt,
+			OutgoingToken:          bt.OutgoingToken,
+		})
+	}
+
+	eis, err := all(s.bridgeORM.ExternalInitiators)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load external initiators")
+	}
+	for _, ei := range eis {
+		b.ExternalInitiators = append(b.ExternalInitiators, ExternalInitiator{
+			Name:           ei.Name,
+			URL:            ei.URL,
+			AccessKey:      ei.AccessKey,
+			Salt:           ei.Salt,
+			HashedSecret:   ei.HashedSecret,
+			OutgoingToken:  ei.OutgoingToken,
+			OutgoingSecret: ei.OutgoingSecret,
+		})
+	}
+
+	chains, err := all(func(offset, limit int) ([]evmtypes.Chain, int, error) {
+		return s.evmORM.Chains(offset, limit)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load EVM chains")
+	}
+	for _, c := range chains {
+		b.EVMChains = append(b.EVMChains, EVMChain{ID: c.ID, Enabled: c.Enabled, Config: c.Cfg})
+	}
+
+	nodes, err := all(func(offset, limit int) ([]evmtypes.Node, int, error) {
+		return s.evmORM.Nodes(offset, limit)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load EVM nodes")
+	}
+	for _, n := range nodes {
+		b.EVMNodes = append(b.EVMNodes, EVMNode{
+			Name:       n.Name,
+			EVMChainID: n.EVMChainID,
+			WSURL:      n.WSURL,
+			HTTPURL:    n.HTTPURL,
+			SendOnly:   n.SendOnly,
+		})
+	}
+
+	jbs, err := all(s.jobORM.FindJobs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load jobs")
+	}
+	for _, jb := range jbs {
+		if jb.WebhookSpecID != nil && jb.WebhookSpec != nil {
+			eiSpecs, _, err := s.eiManager.Load(*jb.WebhookSpecID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to load external initiators of job %s", jb.ExternalJobID)
+			}
+			jb.WebhookSpec.ExternalInitiatorWebhookSpecs = eiSpecs
+		}
+		spec, err := job.EncodeTOML(jb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encode job %s", jb.ExternalJobID)
+		}
+		b.Jobs = append(b.Jobs, Job{
+			ExternalJobID: jb.ExternalJobID,
+			Name:          jb.Name.String,
+			Type:          jb.Type,
+			TOML:          spec,
+		})
+	}
+
+	if opts.KeyPassword != "" {
+		if b.Keys, err = s.exportKeys(opts.KeyPassword); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// keyExporter exports the keys of one type.
+type keyExporter struct {
+	typ    KeyType
+	ids    func() ([]string, error)
+	export func(id, password string) ([]byte, error)
+}
+
+func ids[K keystore.Key](keys []K, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, k := range keys {
+		ids = append(ids, k.ID())
+	}
+	return ids, nil
+}
+
+// localEthKeys filters out remote keys, whose private keys are held by the
+// remote signer and cannot be exported.
+func localEthKeys(keys []ethkey.KeyV2, err error) (local []ethkey.KeyV2, _ error) {
+	for _, k := range keys {
+		if !k.IsRemote() {
+			local = append(local, k)
+		}
+	}
+	return local, err
+}
+
+func (s *service) keyExporters() []keyExporter {
+	ks := s.keyStore
+	return []keyExporter{
+		{KeyTypeCSA, func() ([]string, error) { return ids(ks.CSA().GetAll()) }, ks.CSA().Export},
+		{KeyTypeETH, func() ([]string, error) { return ids(localEthKeys(ks.Eth().GetAll())) }, ks.Eth().Export},
+		{KeyTypeOCR, func() ([]string, error) { return ids(ks.OCR().GetAll()) }, ks.OCR().Export},
+		{KeyTypeOCR2, func() ([]string, error) { return ids(ks.OCR2().GetAll()) }, ks.OCR2().Export},
+		{KeyTypeP2P, func() ([]string, error) { return ids(ks.P2P().GetAll()) }, func(id, password string) ([]byte, error) {
+			peerID, err := p2pkey.MakePeerID(id)
+			if err != nil {
+				return nil, err
+			}
+			return ks.P2P().Export(peerID, password)
+		}},
+		{KeyTypeSolana, func() ([]string, error) { return ids(ks.Solana().GetAll()) }, ks.Solana().Export},
+		{KeyTypeTerra, func() ([]string, error) { return ids(ks.Terra().GetAll()) }, ks.Terra().Export},
+		{KeyTypeVRF, func() ([]string, error) { return ids(ks.VRF().GetAll()) }, ks.VRF().Export},
+	}
+}
+
+func (s *service) exportKeys(password string) (keys []Key, err error) {
+	for _, e := range s.keyExporters() {
+		kids, err := e.ids()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load %s keys", e.typ)
+		}
+		for _, id := range kids {
+			k := Key{Type: e.typ, ID: id}
+			if k.JSON, err = e.export(id, password); err != nil {
+				return nil, errors.Wrapf(err, "failed to export %s key %s", e.typ, id)
+			}
+			if e.typ == KeyTypeETH {
+				state, err := s.keyStore.Eth().GetState(id)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to load state of eth key %s", id)
+				}
+				k.EVMChainID = &state.EVMChainID
+			}
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (s *service) Import(ctx context.Context, b *Bundle, keyPassword string) (r ImportResult, err error) {
+	if len(b.Keys) > 0 && keyPassword == "" {
+		return r, errors.New("bundle has keys, but no password was given to decrypt them")
+	}
+	for _, br := range b.Bridges {
+		created, err := s.importBridge(br)
+		r.add("bridge", br.Name, created, err)
+	}
+	for _, ei := range b.ExternalInitiators {
+		created, err := s.importExternalInitiator(ei)
+		r.add("externalInitiator", ei.Name, created, err)
+	}
+	for _, c := range b.EVMChains {
+		created, err := s.importEVMChain(c)
+		r.add("evmChain", c.ID.String(), created, err)
+	}
+	if len(b.EVMNodes) > 0 {
+		nodes, err := all(func(offset, limit int) ([]evmtypes.Node, int, error) {
+			return s.evmORM.Nodes(offset, limit)
+		})
+		if err != nil {
+			return r, errors.Wrap(err, "failed to load EVM nodes")
+		}
+		existing := map[string]struct{}{}
+		for _, n := range nodes {
+			existing[n.Name] = struct{}{}
+		}
+		for _, n := range b.EVMNodes {
+			created, err := s.importEVMNode(n, existing)
+			r.add("evmNode", n.Name, created, err)
+		}
+	}
+	if len(b.Keys) > 0 {
+		existing := map[KeyType]map[string]struct{}{}
+		for _, e := range s.keyExporters() {
+			kids, err := e.ids()
+			if err != nil {
+				return r, errors.Wrapf(err, "failed to load %s keys", e.typ)
+			}
+			existing[e.typ] = map[string]struct{}{}
+			for _, id := range kids {
+				existing[e.typ][id] = struct{}{}
+			}
+		}
+		for _, k := range b.Keys {
+			created, err := s.importKey(k, keyPassword, existing[k.Type])
+			r.add(string(k.Type)+"Key", k.ID, created, err)
+		}
+	}
+	for _, jb := range b.Jobs {
+		created, err := s.importJob(ctx, jb)
+		r.add("job", jb.ExternalJobID.String(), created, err)
+	}
+	s.lggr.Infow("Imported bundle", "items", len(r.Items), "failed", r.Failed())
+	return r, nil
+}
+
+func (s *service) importBridge(br Bridge) (bool, error) {
+	name, err := bridges.ParseBridgeName(br.Name)
+	if err != nil {
+		return false, err
+	}
+	if _, err = s.bridgeORM.FindBridge(name); err == nil {
+		return false, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	return true, s.bridgeORM.CreateBridgeType(&bridges.BridgeType{
+		Name:                   name,
+		URL:                    br.URL,
+		Confirmations:          br.Confirmations,
+		IncomingTokenHash:      br.IncomingTokenHash,
+		Salt:                   br.Salt,
+		OutgoingToken:          br.OutgoingToken,
+		MinimumContractPayment: br.MinimumContractPayment,
+	})
+}
+
+func (s *service) importExternalInitiator(ei ExternalInitiator) (bool, error) {
+	if _, err := s.bridgeORM.FindExternalInitiatorByName(ei.Name); err == nil {
+		return false, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	return true, s.bridgeORM.CreateExternalInitiator(&bridges.ExternalInitiator{
+		Name:           ei.Name,
+		URL:            ei.URL,
+		AccessKey:      ei.AccessKey,
+		Salt:           ei.Salt,
+		HashedSecret:   ei.HashedSecret,
+		OutgoingToken:  ei.OutgoingToken,
+		OutgoingSecret: ei.OutgoingSecret,
+	})
+}
+
+func (s *service) importEVMChain(c EVMChain) (bool, error) {
+	if _, err := s.evmORM.Chain(c.ID); err == nil {
+		return false, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	if _, err := s.evmORM.CreateChain(c.ID, c.Config); err != nil {
+		return false, err
+	}
+	if !c.Enabled {
+		if _, err := s.evmORM.UpdateChain(c.ID, false, c.Config); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (s *service) importEVMNode(n EVMNode, existing map[string]struct{}) (bool, error) {
+	if _, ok := existing[n.Name]; ok {
+		return false, nil
+	}
+	_, err := s.evmORM.CreateNode(evmtypes.Node{
+		Name:       n.Name,
+		EVMChainID: n.EVMChainID,
+		WSURL:      n.WSURL,
+		HTTPURL:    n.HTTPURL,
+		SendOnly:   n.SendOnly,
+	})
+	return err == nil, err
+}
+
+func (s *service) importKey(k Key, password string, existing map[string]struct{}) (bool, error) {
+	if existing == nil {
+		return false, errors.Errorf("unknown key type: %s", k.Type)
+	}
+	if _, ok := existing[k.ID]; ok {
+		return false, nil
+	}
+	var err error
+	switch k.Type {
+	case KeyTypeCSA:
+		_, err = s.keyStore.CSA().Import(k.JSON, password)
+	case KeyTypeETH:
+		if k.EVMChainID == nil {
+			return false, errors.New("eth key has no EVM chain ID")
+		}
+		_, err = s.keyStore.Eth().Import(k.JSON, password, k.EVMChainID.ToInt())
+	case KeyTypeOCR:
+		_, err = s.keyStore.OCR().Import(k.JSON, password)
+	case KeyTypeOCR2:
+		_, err = s.keyStore.OCR2().Import(k.JSON, password)
+	case KeyTypeP2P:
+		_, err = s.keyStore.P2P().Import(k.JSON, password)
+	case KeyTypeSolana:
+		_, err = s.keyStore.Solana().Import(k.JSON, password)
+	case KeyTypeTerra:
+		_, err = s.keyStore.Terra().Import(k.JSON, password)
+	case KeyTypeVRF:
+		_, err = s.keyStore.VRF().Import(k.JSON, password)
+	}
+	return err == nil, err
+}
+
+func (s *service) importJob(ctx context.Context, jb Job) (bool, error) {
+	if _, err := s.jobORM.FindJobByExternalJobID(jb.ExternalJobID); err == nil {
+		return false, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	created, err := s.createJob(ctx, jb.TOML)
+	if err != nil {
+		return false, err
+	}
+	if created.ExternalJobID != jb.ExternalJobID {
+		// the spec is the source of truth, but a mismatch means the bundle was tampered with
+		return true, errors.Errorf("job was created with external job ID %s", created.ExternalJobID)
+	}
+	return true, nil
+}
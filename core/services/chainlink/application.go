@@ -26,16 +26,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/solana"
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/config/tomlconfig"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/events"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
@@ -66,8 +70,11 @@ type Application interface {
 	GetSqlxDB() *sqlx.DB
 	GetConfig() config.GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	ReloadConfig() (config.ReloadResult, error)
 	GetKeyStore() keystore.Master
 	GetEventBroadcaster() pg.EventBroadcaster
+	GetEventStream() events.Stream
+	GetAuditLogger() audit.Logger
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
 
@@ -86,6 +93,9 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// ReplayPipelineRun re-executes a stored run using the recorded results of
+	// its external tasks. If spec is nil the run's own pipeline spec is used.
+	ReplayPipelineRun(ctx context.Context, runID int64, spec *pipeline.Spec) (pipeline.ReplayResult, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
@@ -107,6 +117,8 @@ type Application interface {
 type ChainlinkApplication struct {
 	Chains                   Chains
 	EventBroadcaster         pg.EventBroadcaster
+	eventStream              events.Stream
+	auditLogger              audit.Logger
 	jobORM                   job.ORM
 	jobSpawner               job.Spawner
 	pipelineORM              pipeline.ORM
@@ -138,6 +150,7 @@ type ApplicationOpts struct {
 	EventBroadcaster         pg.EventBroadcaster
 	SqlxDB                   *sqlx.DB
 	KeyStore                 keystore.Master
+	RemoteSigner             *remotesigner.Signer // nil if ETH_REMOTE_SIGNER_URL is not set
 	Chains                   Chains
 	Logger                   logger.Logger
 	CloseLogger              func() error
@@ -235,6 +248,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set DATABASE_BACKUP_MODE=lite or DATABASE_BACKUP_MODE=full")
 	}
 
+	if opts.RemoteSigner != nil {
+		subservices = append(subservices, opts.RemoteSigner)
+	}
 	subservices = append(subservices, eventBroadcaster)
 	subservices = append(subservices, chains.services()...)
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
@@ -254,6 +270,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
 	}
 
+	eventStream := events.NewStream(eventBroadcaster, globalLogger)
+	pipelineRunner.OnRunFinished(eventStream.RunFinished)
+	subservices = append(subservices, eventStream)
+
+	auditLogger := audit.NewLogger(audit.NewORM(db, globalLogger, cfg), cfg.AuditLogFile(), globalLogger)
+	subservices = append(subservices, auditLogger)
+
 	var (
 		delegates = map[job.Type]job.Delegate{
 			job.DirectRequest: directrequest.NewDelegate(
@@ -408,6 +431,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	app := &ChainlinkApplication{
 		Chains:                   chains,
 		EventBroadcaster:         eventBroadcaster,
+		eventStream:              eventStream,
+		auditLogger:              auditLogger,
 		jobORM:                   jobORM,
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
@@ -452,6 +477,35 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// ReloadConfig re-reads the config files and applies the changed settings that
+// can safely change while the node is running. The result lists the settings
+// that changed, and which of them only take effect after a restart.
+func (app *ChainlinkApplication) ReloadConfig() (config.ReloadResult, error) {
+	if path := app.Config.ConfigFile(); path != "" {
+		f, err := tomlconfig.Load(path)
+		if err != nil {
+			return config.ReloadResult{}, err
+		}
+		if ps := f.Validate(); len(ps) > 0 {
+			return config.ReloadResult{}, errors.Wrapf(ps, "invalid config file %s", path)
+		}
+	}
+
+	changed, err := app.Config.Reload()
+	if err != nil {
+		return config.ReloadResult{}, errors.Wrap(err, "failed to reload config")
+	}
+	for _, name := range changed {
+		if name == "LOG_LEVEL" {
+			app.logger.SetLogLevel(app.Config.LogLevel())
+		}
+	}
+
+	result := config.NewReloadResult(changed)
+	app.logger.Infow("Reloaded config", "applied", result.Applied, "requiresRestart", result.RequiresRestart)
+	return result, nil
+}
+
 // SetServiceLogLevel sets the Logger level for a given service and stores the setting in the db.
 func (app *ChainlinkApplication) SetServiceLogLevel(ctx context.Context, serviceName string, level zapcore.Level) error {
 	// TODO: Implement other service loggers
@@ -711,6 +765,15 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+// ReplayPipelineRun implements the Application interface.
+func (app *ChainlinkApplication) ReplayPipelineRun(ctx context.Context, runID int64, spec *pipeline.Spec) (pipeline.ReplayResult, error) {
+	run, err := app.pipelineORM.FindRun(runID)
+	if err != nil {
+		return pipeline.ReplayResult{}, errors.Wrapf(err, "failed to load run %d", runID)
+	}
+	return app.pipelineRunner.ReplayRun(ctx, run, spec, app.logger.Named("PipelineReplay"))
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -734,6 +797,16 @@ func (app *ChainlinkApplication) GetEventBroadcaster() pg.EventBroadcaster {
 	return app.EventBroadcaster
 }
 
+// GetEventStream returns the stream of run, task run, job error and eth_tx events.
+func (app *ChainlinkApplication) GetEventStream() events.Stream {
+	return app.eventStream
+}
+
+// GetAuditLogger returns the log of state-changing API actions.
+func (app *ChainlinkApplication) GetAuditLogger() audit.Logger {
+	return app.auditLogger
+}
+
 func (app *ChainlinkApplication) GetSqlxDB() *sqlx.DB {
 	return app.sqlxDB
 }
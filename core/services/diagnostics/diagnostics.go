@@ -0,0 +1,433 @@
+// Package diagnostics runs health checks against a running node, to help
+// operators find out why it misbehaves.
+package diagnostics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// StaleRunAge is how long a pipeline run may stay unfinished before it is
+// reported.
+const StaleRunAge = time.Hour
+
+// Result is the outcome of a single check of a subject, e.g. the balance of a
+// key.
+type Result struct {
+	Check   string `json:"check"`
+	Subject string `json:"subject"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	// Hint suggests how to fix a warning or failure
+	Hint string `json:"hint,omitempty"`
+}
+
+// Config is the subset of the general config used by the checks.
+type Config interface {
+	AutoPprofEnabled() bool
+	AutoPprofMaxProfileSize() utils.FileSize
+	AutoPprofProfileRoot() string
+	DatabaseLockingMode() string
+	LogSQL() bool
+}
+
+// Doctor runs the checks.
+type Doctor struct {
+	db          *sqlx.DB
+	cfg         Config
+	appID       uuid.UUID
+	chainSet    evm.ChainSet
+	ethKeyStore keystore.Eth
+	pipelineORM pipeline.ORM
+	diskStats   utils.DiskStatsProvider
+	lggr        logger.Logger
+}
+
+// NewDoctor returns a Doctor for the node with ID appID. chainSet may be nil
+// if EVM is disabled.
+func NewDoctor(db *sqlx.DB, cfg Config, appID uuid.UUID, chainSet evm.ChainSet, ethKeyStore keystore.Eth, pipelineORM pipeline.ORM, lggr logger.Logger) *Doctor {
+	return &Doctor{
+		db:          db,
+		cfg:         cfg,
+		appID:       appID,
+		chainSet:    chainSet,
+		ethKeyStore: ethKeyStore,
+		pipelineORM: pipelineORM,
+		diskStats:   utils.NewDiskStatsProvider(),
+		lggr:        lggr.Named("Doctor"),
+	}
+}
+
+// Run runs all checks and returns their results. Checks that depend on the
+// database are skipped if it cannot be reached.
+func (d *Doctor) Run(ctx context.Context) (results []Result) {
+	dbResult := d.checkDatabase(ctx)
+	results = append(results, dbResult)
+	if dbResult.Status != StatusFail {
+		results = append(results, d.checkMigrations())
+		results = append(results, d.checkLeaseLock(ctx))
+		results = append(results, d.checkPipelineRuns(ctx))
+	}
+	if d.chainSet != nil {
+		for _, chain := range d.chainSet.Chains() {
+			results = append(results, d.checkChain(ctx, chain, dbResult.Status != StatusFail)...)
+		}
+	}
+	results = append(results, CheckAutoPprofDiskSpace(d.cfg, d.diskStats))
+	return
+}
+
+func (d *Doctor) checkDatabase(ctx context.Context) Result {
+	r := Result{Check: "database", Subject: "postgres"}
+	ctx, cancel := pg.DefaultQueryCtxWithParent(ctx)
+	defer cancel()
+	if err := d.db.PingContext(ctx); err != nil {
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("cannot reach the database: %v", err)
+		r.Hint = "Check that DATABASE_URL is correct and that Postgres is running and reachable from the node"
+		return r
+	}
+	r.Status = StatusPass
+	r.Message = "connected"
+	return r
+}
+
+func (d *Doctor) checkMigrations() Result {
+	r := Result{Check: "migrations", Subject: "postgres"}
+	current, err := migrate.Current(d.db.DB, d.lggr)
+	if err != nil {
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("failed to load the current migration: %v", err)
+		return r
+	}
+	latest, err := migrate.Latest()
+	if err != nil {
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("failed to load the latest migration: %v", err)
+		return r
+	}
+	return CheckMigrations(current, latest)
+}
+
+// CheckMigrations compares the current migration of the database with the
+// latest one known to this node.
+func CheckMigrations(current, latest int64) Result {
+	r := Result{Check: "migrations", Subject: "postgres"}
+	switch {
+	case current < latest:
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("database is at migration %d, but the latest is %d", current, latest)
+		r.Hint = "Run `chainlink node db migrate`, or restart the node, which migrates the database on start"
+	case current > latest:
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("database is at migration %d, which is newer than the latest known to this node, %d", current, latest)
+		r.Hint = "The database was migrated by a newer version of Chainlink. Upgrade this node, or roll back the migrations with the newer version"
+	default:
+		r.Status = StatusPass
+		r.Message = fmt.Sprintf("database is at the latest migration, %d", latest)
+	}
+	return r
+}
+
+func (d *Doctor) checkLeaseLock(ctx context.Context) Result {
+	r := Result{Check: "lease lock", Subject: "postgres"}
+	mode := d.cfg.DatabaseLockingMode()
+	switch mode {
+	case "lease", "dual":
+	case "none":
+		r.Status = StatusWarn
+		r.Message = "database locking is disabled"
+		r.Hint = "Set DATABASE_LOCKING_MODE=lease unless you are sure no other node uses this database"
+		return r
+	default:
+		r.Status = StatusPass
+		r.Message = fmt.Sprintf("not used with DATABASE_LOCKING_MODE=%s", mode)
+		return r
+	}
+
+	ctx, cancel := pg.DefaultQueryCtxWithParent(ctx)
+	defer cancel()
+	holder, expiresAt, err := pg.LeaseHolder(ctx, d.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		r.Status = StatusFail
+		r.Message = "no node holds the lease lock"
+		r.Hint = "Restart the node so it takes the lease"
+		return r
+	} else if err != nil {
+		r.Status = StatusFail
+		r.Message = err.Error()
+		return r
+	}
+	return CheckLeaseHolder(d.appID, holder, expiresAt, time.Now())
+}
+
+// CheckLeaseHolder checks that the node with ID appID holds an unexpired lease.
+func CheckLeaseHolder(appID, holder uuid.UUID, expiresAt, now time.Time) Result {
+	r := Result{Check: "lease lock", Subject: "postgres"}
+	switch {
+	case holder != appID:
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("the lease is held by another node, %s, not this one, %s", holder, appID)
+		r.Hint = "Another node is using this database. Stop it, or point this node at its own database"
+	case !expiresAt.After(now):
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("this node's lease expired at %s", expiresAt)
+		r.Hint = "The node is failing to renew its lease. Check the database latency and the LeaseLock logs"
+	default:
+		r.Status = StatusPass
+		r.Message = fmt.Sprintf("held by this node until %s", expiresAt)
+	}
+	return r
+}
+
+func (d *Doctor) checkPipelineRuns(ctx context.Context) Result {
+	r := Result{Check: "pipeline runs", Subject: "unfinished runs"}
+	var count int
+	var oldest time.Time
+	err := d.pipelineORM.GetUnfinishedRuns(ctx, time.Now().Add(-StaleRunAge), func(run pipeline.Run) error {
+		if count == 0 {
+			oldest = run.CreatedAt
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("failed to load unfinished runs: %v", err)
+		return r
+	}
+	if count > 0 {
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("%d run(s) started over %s ago have not finished, the oldest at %s", count, StaleRunAge, oldest)
+		r.Hint = "Unfinished runs are resumed when the node restarts. If they are stuck on a bridge or external adapter, check that it is up"
+		return r
+	}
+	r.Status = StatusPass
+	r.Message = fmt.Sprintf("no runs started over %s ago are unfinished", StaleRunAge)
+	return r
+}
+
+func (d *Doctor) checkChain(ctx context.Context, chain evm.Chain, dbOK bool) (results []Result) {
+	subject := fmt.Sprintf("EVM chain %s", chain.ID())
+	results = append(results, CheckNodeStates(subject, chain.Client().NodeStates())...)
+
+	states, err := d.ethKeyStore.GetStatesForChain(chain.ID())
+	if err != nil {
+		return append(results, Result{Check: "keys", Subject: subject, Status: StatusFail,
+			Message: fmt.Sprintf("failed to load keys: %v", err), Hint: "Check that the keystore is unlocked"})
+	}
+	if len(states) == 0 {
+		return append(results, Result{Check: "keys", Subject: subject, Status: StatusWarn,
+			Message: "no keys", Hint: "Create a key with `chainlink keys eth create` if jobs on this chain send transactions"})
+	}
+
+	q := pg.NewQ(d.db, d.lggr, d.cfg, pg.WithParentCtx(ctx))
+	for _, state := range states {
+		keySubject := fmt.Sprintf("%s on %s", state.Address, subject)
+		results = append(results, CheckBalance(keySubject, chain.BalanceMonitor().GetEthBalance(state.Address.Address())))
+		if dbOK {
+			results = append(results, d.checkTransactions(q, chain, state, keySubject))
+		}
+		results = append(results, d.checkNonce(ctx, chain, state, keySubject))
+	}
+	return
+}
+
+// CheckNodeStates checks that the primary RPC nodes of a chain are alive and
+// on the right chain. states maps node IDs to their Pool state.
+func CheckNodeStates(subject string, states map[int32]string) (results []Result) {
+	if len(states) == 0 {
+		return []Result{{Check: "rpc nodes", Subject: subject, Status: StatusPass, Message: "no node states reported"}}
+	}
+
+	var alive int
+	var dead, invalidChainID []string
+	for id, state := range states {
+		switch state {
+		case "Alive":
+			alive++
+		case "InvalidChainID":
+			invalidChainID = append(invalidChainID, fmt.Sprint(id))
+		default:
+			dead = append(dead, fmt.Sprintf("%d (%s)", id, state))
+		}
+	}
+
+	r := Result{Check: "rpc nodes", Subject: subject}
+	switch {
+	case alive == 0:
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("0/%d nodes are alive", len(states))
+		r.Hint = "Check the node URLs with `chainlink nodes evm list` and that the RPC providers are up"
+	case alive < len(states):
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("%d/%d nodes are alive", alive, len(states))
+		r.Hint = "Check the node URLs with `chainlink nodes evm list` and that the RPC providers are up"
+	default:
+		r.Status = StatusPass
+		r.Message = fmt.Sprintf("%d/%d nodes are alive", alive, len(states))
+	}
+	if len(dead) > 0 {
+		sort.Strings(dead)
+		r.Message += fmt.Sprintf("; not alive: %s", strings.Join(dead, ", "))
+	}
+	results = append(results, r)
+
+	if len(invalidChainID) > 0 {
+		sort.Strings(invalidChainID)
+		results = append(results, Result{Check: "chain ID", Subject: subject, Status: StatusFail,
+			Message: fmt.Sprintf("nodes %s report a different chain ID", strings.Join(invalidChainID, ", ")),
+			Hint:    "Point these nodes at an RPC endpoint for the right chain, or add them to the chain they belong to"})
+	} else {
+		results = append(results, Result{Check: "chain ID", Subject: subject, Status: StatusPass, Message: "all nodes report the configured chain ID"})
+	}
+	return
+}
+
+// CheckBalance checks the balance of a key, as reported by its chain's
+// BalanceMonitor.
+func CheckBalance(subject string, balance *assets.Eth) Result {
+	r := Result{Check: "balance", Subject: subject}
+	switch {
+	case balance == nil:
+		r.Status = StatusWarn
+		r.Message = "balance is not known yet"
+		r.Hint = "The balance is loaded on each new head. If this persists, check the chain's RPC nodes and that BALANCE_MONITOR_ENABLED is true"
+	case balance.IsZero():
+		r.Status = StatusFail
+		r.Message = "balance is zero"
+		r.Hint = "Fund the key so it can pay for gas"
+	default:
+		r.Status = StatusPass
+		r.Message = fmt.Sprintf("balance is %s", balance)
+	}
+	return r
+}
+
+func (d *Doctor) checkTransactions(q pg.Q, chain evm.Chain, state ethkey.State, subject string) Result {
+	r := Result{Check: "transactions", Subject: subject}
+	unconfirmed, err := txmgr.CountUnconfirmedTransactions(q, state.Address.Address(), *chain.ID())
+	if err != nil {
+		r.Status = StatusFail
+		r.Message = err.Error()
+		return r
+	}
+	unstarted, err := txmgr.CountUnstartedTransactions(q, state.Address.Address(), *chain.ID())
+	if err != nil {
+		r.Status = StatusFail
+		r.Message = err.Error()
+		return r
+	}
+	cfg := chain.Config()
+	return CheckTransactionCounts(subject, unconfirmed, unstarted, cfg.EvmMaxInFlightTransactions(), cfg.EvmMaxQueuedTransactions())
+}
+
+// CheckTransactionCounts checks the number of unconfirmed and queued
+// transactions of a key against the txmgr limits. A limit of zero is
+// unlimited.
+func CheckTransactionCounts(subject string, unconfirmed, unstarted uint32, maxInFlight uint32, maxQueued uint64) Result {
+	r := Result{Check: "transactions", Subject: subject, Message: fmt.Sprintf("%d unconfirmed, %d queued", unconfirmed, unstarted)}
+	switch {
+	case maxQueued > 0 && uint64(unstarted) >= maxQueued:
+		r.Status = StatusFail
+		r.Message += fmt.Sprintf("; the queue is full (ETH_MAX_QUEUED_TRANSACTIONS=%d) and new transactions are dropped", maxQueued)
+		r.Hint = "Transactions are not being confirmed. Check the nonce and gas price, e.g. whether ETH_MAX_GAS_PRICE_WEI is too low for the network"
+	case maxInFlight > 0 && unconfirmed >= maxInFlight:
+		r.Status = StatusWarn
+		r.Message += fmt.Sprintf("; the in-flight limit is reached (ETH_MAX_IN_FLIGHT_TRANSACTIONS=%d) and new transactions wait in the queue", maxInFlight)
+		r.Hint = "Transactions are not being confirmed. Check the nonce and gas price, e.g. whether ETH_MAX_GAS_PRICE_WEI is too low for the network"
+	default:
+		r.Status = StatusPass
+	}
+	return r
+}
+
+func (d *Doctor) checkNonce(ctx context.Context, chain evm.Chain, state ethkey.State, subject string) Result {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	onChain, err := chain.Client().PendingNonceAt(ctx, state.Address.Address())
+	if err != nil {
+		return Result{Check: "nonce", Subject: subject, Status: StatusWarn,
+			Message: fmt.Sprintf("failed to load the nonce from the chain: %v", err),
+			Hint:    "Check the chain's RPC nodes"}
+	}
+	return CheckNonce(subject, state.Address.Hex(), state.NextNonce, onChain)
+}
+
+// CheckNonce compares the next nonce the node will use for a key with the
+// pending nonce reported by the chain.
+func CheckNonce(subject, address string, nextNonce int64, onChain uint64) Result {
+	r := Result{Check: "nonce", Subject: subject}
+	hint := fmt.Sprintf("Enable ETH_NONCE_AUTO_SYNC and restart the node, or as a last resort run `chainlink node setnextnonce --address %s --nextNonce %d` with the node stopped", address, onChain)
+	switch {
+	case uint64(nextNonce) < onChain:
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("next nonce is %d, but the chain is at %d: new transactions will fail with nonce too low", nextNonce, onChain)
+		r.Hint = hint
+	case uint64(nextNonce) > onChain:
+		// Unconfirmed transactions may not be in the RPC node's mempool yet
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("next nonce is %d, but the chain is at %d: transactions with nonces from %d are pending or stuck", nextNonce, onChain, onChain)
+		r.Hint = fmt.Sprintf("This is expected while transactions are unconfirmed. If it persists, the transaction with nonce %d was lost; check it with `chainlink txs evm list`", onChain)
+	default:
+		r.Status = StatusPass
+		r.Message = fmt.Sprintf("next nonce is %d", nextNonce)
+	}
+	return r
+}
+
+// CheckAutoPprofDiskSpace checks that there is room for AutoPprof to write
+// its profiles, when it is enabled.
+func CheckAutoPprofDiskSpace(cfg Config, diskStats utils.DiskStatsProvider) Result {
+	r := Result{Check: "disk space", Subject: "AutoPprof"}
+	if !cfg.AutoPprofEnabled() {
+		r.Status = StatusPass
+		r.Message = "AutoPprof is disabled"
+		return r
+	}
+	root := cfg.AutoPprofProfileRoot()
+	available, err := diskStats.AvailableSpace(root)
+	if err != nil {
+		r.Status = StatusFail
+		r.Message = fmt.Sprintf("failed to check the disk space of %s: %v", root, err)
+		r.Hint = "Check that AUTO_PPROF_PROFILE_ROOT exists and is readable by the node"
+		return r
+	}
+	if max := cfg.AutoPprofMaxProfileSize(); available < max {
+		r.Status = StatusWarn
+		r.Message = fmt.Sprintf("%s available in %s, less than AUTO_PPROF_MAX_PROFILE_SIZE=%s", available, root, max)
+		r.Hint = "Free up disk space, or lower AUTO_PPROF_MAX_PROFILE_SIZE"
+		return r
+	}
+	r.Status = StatusPass
+	r.Message = fmt.Sprintf("%s available in %s", available, root)
+	return r
+}
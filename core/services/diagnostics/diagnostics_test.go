@@ -0,0 +1,191 @@
+package diagnostics_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/diagnostics"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	utilsmocks "github.com/smartcontractkit/chainlink/core/utils/mocks"
+)
+
+func TestCheckMigrations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		current, latest int64
+		want            diagnostics.Status
+	}{
+		{"up to date", 10, 10, diagnostics.StatusPass},
+		{"behind", 9, 10, diagnostics.StatusFail},
+		{"ahead", 11, 10, diagnostics.StatusWarn},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, diagnostics.CheckMigrations(tt.current, tt.latest).Status)
+		})
+	}
+}
+
+func TestCheckLeaseHolder(t *testing.T) {
+	t.Parallel()
+
+	appID, otherID := uuid.NewV4(), uuid.NewV4()
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		holder    uuid.UUID
+		expiresAt time.Time
+		want      diagnostics.Status
+	}{
+		{"held", appID, now.Add(time.Minute), diagnostics.StatusPass},
+		{"expired", appID, now.Add(-time.Minute), diagnostics.StatusWarn},
+		{"other node", otherID, now.Add(time.Minute), diagnostics.StatusFail},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, diagnostics.CheckLeaseHolder(appID, tt.holder, tt.expiresAt, now).Status)
+		})
+	}
+}
+
+func TestCheckNodeStates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		states      map[int32]string
+		wantNodes   diagnostics.Status
+		wantChainID diagnostics.Status
+		wantMessage string
+	}{
+		{"all alive", map[int32]string{1: "Alive", 2: "Alive"}, diagnostics.StatusPass, diagnostics.StatusPass, "2/2 nodes are alive"},
+		{"some dead", map[int32]string{1: "Alive", 2: "Unreachable", 3: "OutOfSync"}, diagnostics.StatusWarn, diagnostics.StatusPass, "1/3 nodes are alive; not alive: 2 (Unreachable), 3 (OutOfSync)"},
+		{"all dead", map[int32]string{1: "Unreachable"}, diagnostics.StatusFail, diagnostics.StatusPass, "0/1 nodes are alive; not alive: 1 (Unreachable)"},
+		{"wrong chain", map[int32]string{1: "Alive", 2: "InvalidChainID"}, diagnostics.StatusWarn, diagnostics.StatusFail, "1/2 nodes are alive"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rs := diagnostics.CheckNodeStates("EVM chain 1", tt.states)
+			assert.Len(t, rs, 2)
+			assert.Equal(t, tt.wantNodes, rs[0].Status)
+			assert.Equal(t, tt.wantMessage, rs[0].Message)
+			assert.Equal(t, tt.wantChainID, rs[1].Status)
+		})
+	}
+
+	rs := diagnostics.CheckNodeStates("EVM chain 1", nil)
+	assert.Len(t, rs, 1)
+	assert.Equal(t, diagnostics.StatusPass, rs[0].Status)
+}
+
+func TestCheckBalance(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, diagnostics.StatusWarn, diagnostics.CheckBalance("key", nil).Status)
+	zero, one := assets.NewEthValue(0), assets.NewEthValue(1)
+	assert.Equal(t, diagnostics.StatusFail, diagnostics.CheckBalance("key", &zero).Status)
+	assert.Equal(t, diagnostics.StatusPass, diagnostics.CheckBalance("key", &one).Status)
+}
+
+func TestCheckTransactionCounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		unconfirmed, unstarted uint32
+		want                   diagnostics.Status
+	}{
+		{"idle", 0, 0, diagnostics.StatusPass},
+		{"busy", 5, 10, diagnostics.StatusPass},
+		{"in-flight limit", 16, 10, diagnostics.StatusWarn},
+		{"queue full", 16, 250, diagnostics.StatusFail},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, diagnostics.CheckTransactionCounts("key", tt.unconfirmed, tt.unstarted, 16, 250).Status)
+		})
+	}
+
+	// Zero limits are unlimited
+	assert.Equal(t, diagnostics.StatusPass, diagnostics.CheckTransactionCounts("key", 1000, 1000, 0, 0).Status)
+}
+
+func TestCheckNonce(t *testing.T) {
+	t.Parallel()
+
+	r := diagnostics.CheckNonce("key", "0xabc", 5, 5)
+	assert.Equal(t, diagnostics.StatusPass, r.Status)
+
+	r = diagnostics.CheckNonce("key", "0xabc", 3, 5)
+	assert.Equal(t, diagnostics.StatusWarn, r.Status)
+	assert.Contains(t, r.Message, "nonce too low")
+	assert.Contains(t, r.Hint, "--address 0xabc --nextNonce 5")
+
+	r = diagnostics.CheckNonce("key", "0xabc", 7, 5)
+	assert.Equal(t, diagnostics.StatusWarn, r.Status)
+	assert.Contains(t, r.Message, "pending or stuck")
+}
+
+type pprofConfig struct {
+	enabled bool
+	maxSize utils.FileSize
+}
+
+func (c pprofConfig) AutoPprofEnabled() bool                  { return c.enabled }
+func (c pprofConfig) AutoPprofMaxProfileSize() utils.FileSize { return c.maxSize }
+func (c pprofConfig) AutoPprofProfileRoot() string            { return "/profiles" }
+func (c pprofConfig) DatabaseLockingMode() string             { return "lease" }
+func (c pprofConfig) LogSQL() bool                            { return false }
+
+func TestCheckAutoPprofDiskSpace(t *testing.T) {
+	t.Parallel()
+
+	disabled := pprofConfig{}
+	enabled := pprofConfig{enabled: true, maxSize: 100 * utils.MB}
+
+	tests := []struct {
+		name      string
+		cfg       pprofConfig
+		available utils.FileSize
+		err       error
+		want      diagnostics.Status
+	}{
+		{"disabled", disabled, 0, nil, diagnostics.StatusPass},
+		{"enough space", enabled, utils.GB, nil, diagnostics.StatusPass},
+		{"low space", enabled, 10 * utils.MB, nil, diagnostics.StatusWarn},
+		{"missing root", enabled, 0, errors.New("no such file or directory"), diagnostics.StatusFail},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diskStats := &utilsmocks.DiskStatsProvider{}
+			diskStats.Test(t)
+			diskStats.On("AvailableSpace", "/profiles").Return(tt.available, tt.err).Maybe()
+
+			assert.Equal(t, tt.want, diagnostics.CheckAutoPprofDiskSpace(tt.cfg, diskStats).Status)
+			diskStats.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// Type is the kind of an Event
+type Type string
+
+const (
+	// TypeRun is published when a pipeline run is stored in a finished or
+	// suspended state.
+	TypeRun Type = "run"
+	// TypeTaskRun is published for every task run of a run published with TypeRun.
+	TypeTaskRun Type = "task_run"
+	// TypeJobError is published when a job error is recorded, or recurs.
+	TypeJobError Type = "job_error"
+	// TypeEthTx is published when an eth_tx is created or changes state, if
+	// EVENTS_ETH_TX_ENABLED is set.
+	TypeEthTx Type = "eth_tx"
+)
+
+// maxErrorLength bounds the error messages carried by events, as Postgres
+// notification payloads are limited to 8000 bytes.
+const maxErrorLength = 1000
+
+// Event is a state change of a run, task run, job error or eth_tx. Only the
+// fields relevant to its Type are set.
+//
+// Events of type TypeJobError and TypeEthTx are published by database
+// triggers, so the JSON field names must match the ones used in the
+// migration that created them.
+type Event struct {
+	Type Type      `json:"type"`
+	Time time.Time `json:"time"`
+
+	JobID       int32  `json:"jobID,omitempty"`
+	RunID       int64  `json:"runID,omitempty"`
+	TaskRunID   string `json:"taskRunID,omitempty"`
+	DotID       string `json:"dotID,omitempty"`
+	TaskType    string `json:"taskType,omitempty"`
+	EthTxID     int64  `json:"ethTxID,omitempty"`
+	EVMChainID  string `json:"evmChainID,omitempty"`
+	FromAddress string `json:"fromAddress,omitempty"`
+	Nonce       *int64 `json:"nonce,omitempty"`
+	State       string `json:"state,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Occurrences int    `json:"occurrences,omitempty"`
+}
+
+// Filter selects events. The zero value matches all events.
+type Filter struct {
+	// Types matches events of any of these types
+	Types []Type
+	// JobID matches the events of this job. eth_tx events have no job, so they
+	// never match.
+	JobID *int32
+}
+
+// Matches returns true if e is selected by the filter.
+func (f Filter) Matches(e Event) bool {
+	if f.JobID != nil && e.JobID != *f.JobID {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if t == e.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// RunEvents returns the events for a stored run: one for the run and one for
+// each of its task runs.
+func RunEvents(run *pipeline.Run) []Event {
+	now := time.Now()
+	runEvent := Event{
+		Type:  TypeRun,
+		Time:  now,
+		JobID: run.PipelineSpec.JobID,
+		RunID: run.ID,
+		State: string(run.State),
+	}
+	if err := run.FatalErrors.ToError(); err != nil {
+		runEvent.Error = truncate(err.Error())
+	}
+	evs := []Event{runEvent}
+
+	for _, tr := range run.PipelineTaskRuns {
+		ev := Event{
+			Type:      TypeTaskRun,
+			Time:      now,
+			JobID:     run.PipelineSpec.JobID,
+			RunID:     run.ID,
+			TaskRunID: tr.ID.String(),
+			DotID:     tr.DotID,
+			TaskType:  string(tr.Type),
+		}
+		switch {
+		case tr.Error.Valid:
+			ev.State = string(pipeline.RunStatusErrored)
+			ev.Error = truncate(tr.Error.String)
+		case tr.IsPending():
+			ev.State = string(pipeline.RunStatusSuspended)
+		default:
+			ev.State = string(pipeline.RunStatusCompleted)
+		}
+		evs = append(evs, ev)
+	}
+	return evs
+}
+
+func truncate(s string) string {
+	if len(s) > maxErrorLength {
+		return s[:maxErrorLength]
+	}
+	return s
+}
@@ -0,0 +1,83 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	jobID := int32(1)
+	otherJobID := int32(2)
+
+	tests := []struct {
+		name   string
+		filter events.Filter
+		event  events.Event
+		want   bool
+	}{
+		{"zero value", events.Filter{}, events.Event{Type: events.TypeEthTx}, true},
+		{"type", events.Filter{Types: []events.Type{events.TypeRun, events.TypeJobError}}, events.Event{Type: events.TypeJobError}, true},
+		{"other type", events.Filter{Types: []events.Type{events.TypeRun}}, events.Event{Type: events.TypeTaskRun}, false},
+		{"job", events.Filter{JobID: &jobID}, events.Event{Type: events.TypeRun, JobID: 1}, true},
+		{"other job", events.Filter{JobID: &otherJobID}, events.Event{Type: events.TypeRun, JobID: 1}, false},
+		{"eth_tx with job", events.Filter{JobID: &jobID}, events.Event{Type: events.TypeEthTx}, false},
+		{"type and job", events.Filter{Types: []events.Type{events.TypeRun}, JobID: &jobID}, events.Event{Type: events.TypeTaskRun, JobID: 1}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Matches(tt.event))
+		})
+	}
+}
+
+func TestRunEvents(t *testing.T) {
+	t.Parallel()
+
+	run := &pipeline.Run{
+		ID:           42,
+		State:        pipeline.RunStatusErrored,
+		PipelineSpec: pipeline.Spec{JobID: 7},
+		FatalErrors:  pipeline.RunErrors{null.StringFrom("boom")},
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{ID: uuid.NewV4(), Type: pipeline.TaskTypeHTTP, DotID: "ds", Output: pipeline.JSONSerializable{Val: "1", Valid: true}, FinishedAt: null.TimeFrom(time.Now())},
+			{ID: uuid.NewV4(), Type: pipeline.TaskTypeJSONParse, DotID: "parse", Error: null.StringFrom("boom")},
+			{ID: uuid.NewV4(), Type: pipeline.TaskTypeBridge, DotID: "bridge"},
+		},
+	}
+
+	evs := events.RunEvents(run)
+	require.Len(t, evs, 4)
+
+	assert.Equal(t, events.TypeRun, evs[0].Type)
+	assert.Equal(t, int32(7), evs[0].JobID)
+	assert.Equal(t, int64(42), evs[0].RunID)
+	assert.Equal(t, "errored", evs[0].State)
+	assert.Equal(t, "boom", evs[0].Error)
+
+	for i, want := range []struct{ dotID, state, err string }{
+		{"ds", "completed", ""},
+		{"parse", "errored", "boom"},
+		{"bridge", "suspended", ""},
+	} {
+		ev := evs[i+1]
+		assert.Equal(t, events.TypeTaskRun, ev.Type)
+		assert.Equal(t, int32(7), ev.JobID)
+		assert.Equal(t, int64(42), ev.RunID)
+		assert.Equal(t, run.PipelineTaskRuns[i].ID.String(), ev.TaskRunID)
+		assert.Equal(t, want.dotID, ev.DotID)
+		assert.Equal(t, want.state, ev.State)
+		assert.Equal(t, want.err, ev.Error)
+	}
+}
@@ -0,0 +1,219 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// maxPayloadSize keeps notifications under the 8000 byte Postgres limit
+	maxPayloadSize = 7500
+	// publishInterval batches the events published by this node, so that a
+	// busy node sends a few notifications per second rather than one per run
+	publishInterval = 250 * time.Millisecond
+	// subscriberBufferSize is the number of events a subscriber may fall
+	// behind by before events are dropped for it
+	subscriberBufferSize = 256
+)
+
+// Stream publishes run, task run, job error and eth_tx state changes to the
+// subscribers of every node sharing the database.
+//
+// Run and task run events are published by this node through RunFinished.
+// Job error and eth_tx events are published by database triggers; eth_tx
+// events only by the sessions of nodes with EVENTS_ETH_TX_ENABLED set. All of
+// them are delivered through Postgres notifications on pg.ChannelNodeEvents.
+type Stream interface {
+	services.ServiceCtx
+	// Subscribe returns a channel receiving the events matching filter, and a
+	// function which must be called to end the subscription. Events are
+	// dropped if the subscriber falls too far behind.
+	Subscribe(filter Filter) (<-chan Event, func())
+	// RunFinished publishes the events of a stored run. It is registered
+	// with pipeline.Runner.OnRunFinished.
+	RunFinished(run *pipeline.Run)
+}
+
+type subscriber struct {
+	filter Filter
+	ch     chan Event
+}
+
+type stream struct {
+	eventBroadcaster pg.EventBroadcaster
+	lggr             logger.Logger
+
+	queue  *utils.BoundedQueue[Event]
+	subsMu sync.RWMutex
+	subs   map[*subscriber]struct{}
+	pgSub  pg.Subscription
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+	utils.StartStopOnce
+}
+
+var _ Stream = (*stream)(nil)
+
+// NewStream creates a Stream which delivers events through eventBroadcaster.
+func NewStream(eventBroadcaster pg.EventBroadcaster, lggr logger.Logger) *stream {
+	return &stream{
+		eventBroadcaster: eventBroadcaster,
+		lggr:             lggr.Named("EventStream"),
+		queue:            utils.NewBoundedQueue[Event](10000),
+		subs:             make(map[*subscriber]struct{}),
+		chStop:           make(chan struct{}),
+	}
+}
+
+// Start subscribes to node events in the database.
+func (s *stream) Start(context.Context) error {
+	return s.StartOnce("EventStream", func() (err error) {
+		s.pgSub, err = s.eventBroadcaster.Subscribe(pg.ChannelNodeEvents, "")
+		if err != nil {
+			return errors.Wrap(err, "EventStream: could not subscribe to node events")
+		}
+
+		s.wgDone.Add(2)
+		go s.receiveLoop()
+		go s.publishLoop()
+		return nil
+	})
+}
+
+// Close stops the stream and ends all subscriptions.
+func (s *stream) Close() error {
+	return s.StopOnce("EventStream", func() error {
+		close(s.chStop)
+		s.wgDone.Wait()
+		s.pgSub.Close()
+
+		s.subsMu.Lock()
+		defer s.subsMu.Unlock()
+		for sub := range s.subs {
+			close(sub.ch)
+			delete(s.subs, sub)
+		}
+		return nil
+	})
+}
+
+func (s *stream) Subscribe(filter Filter) (<-chan Event, func()) {
+	sub := &subscriber{filter: filter, ch: make(chan Event, subscriberBufferSize)}
+
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	s.subs[sub] = struct{}{}
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			s.subsMu.Lock()
+			defer s.subsMu.Unlock()
+			if _, exists := s.subs[sub]; exists {
+				close(sub.ch)
+				delete(s.subs, sub)
+			}
+		})
+	}
+}
+
+func (s *stream) RunFinished(run *pipeline.Run) {
+	for _, ev := range RunEvents(run) {
+		s.queue.Add(ev)
+	}
+}
+
+func (s *stream) publishLoop() {
+	defer s.wgDone.Done()
+
+	ticker := time.NewTicker(publishInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.chStop:
+			s.publish()
+			return
+		case <-ticker.C:
+			s.publish()
+		}
+	}
+}
+
+// publish sends the queued events in as few notifications as possible
+func (s *stream) publish() {
+	var batch []json.RawMessage
+	size := 2
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		payload, err := json.Marshal(batch)
+		if err == nil {
+			err = s.eventBroadcaster.Notify(pg.ChannelNodeEvents, string(payload))
+		}
+		s.lggr.ErrorIf(err, "Failed to publish events")
+		batch, size = nil, 2
+	}
+
+	for !s.queue.Empty() {
+		ev := s.queue.Take()
+		b, err := json.Marshal(ev)
+		if err != nil {
+			s.lggr.Errorw("Failed to marshal event", "err", err, "event", ev)
+			continue
+		}
+		if size+len(b)+1 > maxPayloadSize {
+			flush()
+		}
+		batch = append(batch, b)
+		size += len(b) + 1
+	}
+	flush()
+}
+
+func (s *stream) receiveLoop() {
+	defer s.wgDone.Done()
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case notification, open := <-s.pgSub.Events():
+			if !open {
+				return
+			}
+			var evs []Event
+			if err := json.Unmarshal([]byte(notification.Payload), &evs); err != nil {
+				s.lggr.Errorw("Failed to unmarshal events", "err", err, "payload", notification.Payload)
+				continue
+			}
+			s.dispatch(evs)
+		}
+	}
+}
+
+func (s *stream) dispatch(evs []Event) {
+	s.subsMu.RLock()
+	defer s.subsMu.RUnlock()
+	for sub := range s.subs {
+		for _, ev := range evs {
+			if !sub.filter.Matches(ev) {
+				continue
+			}
+			select {
+			case sub.ch <- ev:
+			default:
+				s.lggr.Warnw("Subscriber is falling behind, dropping event", "event", ev)
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package events_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// newLoopbackStream returns a started Stream whose notifications are delivered
+// back to itself, as they would be by Postgres.
+func newLoopbackStream(t *testing.T) (events.Stream, chan pg.Event, *[]string) {
+	chEvents := make(chan pg.Event, 100)
+	var payloads []string
+
+	sub := new(pgmocks.Subscription)
+	sub.On("Events").Return((<-chan pg.Event)(chEvents))
+	sub.On("Close").Return()
+
+	eb := new(pgmocks.EventBroadcaster)
+	eb.On("Subscribe", pg.ChannelNodeEvents, "").Return(sub, nil)
+	eb.On("Notify", pg.ChannelNodeEvents, mock.AnythingOfType("string")).Return(nil).Maybe().Run(func(args mock.Arguments) {
+		payload := args.String(1)
+		payloads = append(payloads, payload)
+		chEvents <- pg.Event{Channel: pg.ChannelNodeEvents, Payload: payload}
+	})
+
+	s := events.NewStream(eb, logger.TestLogger(t))
+	require.NoError(t, s.Start(testutils.Context(t)))
+	t.Cleanup(func() {
+		assert.NoError(t, s.Close())
+		sub.AssertExpectations(t)
+		eb.AssertExpectations(t)
+	})
+	return s, chEvents, &payloads
+}
+
+func TestStream_RunFinished(t *testing.T) {
+	t.Parallel()
+
+	s, _, _ := newLoopbackStream(t)
+
+	jobID := int32(7)
+	runs, unsubscribeRuns := s.Subscribe(events.Filter{Types: []events.Type{events.TypeRun}})
+	defer unsubscribeRuns()
+	all, unsubscribeAll := s.Subscribe(events.Filter{JobID: &jobID})
+	defer unsubscribeAll()
+	other, unsubscribeOther := s.Subscribe(events.Filter{JobID: new(int32)})
+	defer unsubscribeOther()
+
+	s.RunFinished(&pipeline.Run{
+		ID:               1,
+		State:            pipeline.RunStatusCompleted,
+		PipelineSpec:     pipeline.Spec{JobID: jobID},
+		PipelineTaskRuns: []pipeline.TaskRun{{DotID: "ds", Type: pipeline.TaskTypeHTTP}},
+	})
+
+	select {
+	case ev := <-runs:
+		assert.Equal(t, events.TypeRun, ev.Type)
+		assert.Equal(t, int64(1), ev.RunID)
+		assert.Equal(t, "completed", ev.State)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for run event")
+	}
+
+	var types []events.Type
+	for len(types) < 2 {
+		select {
+		case ev := <-all:
+			types = append(types, ev.Type)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for events")
+		}
+	}
+	assert.Equal(t, []events.Type{events.TypeRun, events.TypeTaskRun}, types)
+
+	assert.Empty(t, other)
+	assert.Empty(t, runs)
+}
+
+func TestStream_BatchesNotifications(t *testing.T) {
+	t.Parallel()
+
+	s, _, payloads := newLoopbackStream(t)
+
+	evs, unsubscribe := s.Subscribe(events.Filter{Types: []events.Type{events.TypeRun}})
+	defer unsubscribe()
+
+	for i := 1; i <= 20; i++ {
+		s.RunFinished(&pipeline.Run{
+			ID:           int64(i),
+			State:        pipeline.RunStatusErrored,
+			FatalErrors:  pipeline.RunErrors{null.StringFrom(strings.Repeat("x", 2000))},
+			PipelineSpec: pipeline.Spec{JobID: 1},
+		})
+	}
+
+	for i := 1; i <= 20; i++ {
+		select {
+		case ev := <-evs:
+			assert.Equal(t, int64(i), ev.RunID)
+			assert.Len(t, ev.Error, 1000)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for run %d", i)
+		}
+	}
+
+	// Each notification holds several events but stays under the Postgres limit
+	require.Greater(t, len(*payloads), 1)
+	require.Less(t, len(*payloads), 20)
+	for _, payload := range *payloads {
+		assert.Less(t, len(payload), 8000)
+		var batch []events.Event
+		require.NoError(t, json.Unmarshal([]byte(payload), &batch))
+	}
+}
+
+func TestStream_ForeignEvents(t *testing.T) {
+	t.Parallel()
+
+	s, chEvents, _ := newLoopbackStream(t)
+
+	evs, unsubscribe := s.Subscribe(events.Filter{Types: []events.Type{events.TypeEthTx}})
+	defer unsubscribe()
+
+	// As sent by the database triggers, with an invalid payload in between
+	chEvents <- pg.Event{Channel: pg.ChannelNodeEvents, Payload: `[{"type":"job_error","time":"2022-05-01T00:00:00Z","jobID":1,"error":"boom","occurrences":3}]`}
+	chEvents <- pg.Event{Channel: pg.ChannelNodeEvents, Payload: `not json`}
+	chEvents <- pg.Event{Channel: pg.ChannelNodeEvents, Payload: `[{"type":"eth_tx","time":"2022-05-01T00:00:00Z","ethTxID":5,"evmChainID":"1","fromAddress":"0x0000000000000000000000000000000000000001","nonce":3,"state":"confirmed"}]`}
+
+	select {
+	case ev := <-evs:
+		assert.Equal(t, events.TypeEthTx, ev.Type)
+		assert.Equal(t, int64(5), ev.EthTxID)
+		assert.Equal(t, "1", ev.EVMChainID)
+		require.NotNil(t, ev.Nonce)
+		assert.Equal(t, int64(3), *ev.Nonce)
+		assert.Equal(t, "confirmed", ev.State)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for eth_tx event")
+	}
+}
+
+func TestStream_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	s, _, _ := newLoopbackStream(t)
+
+	evs, unsubscribe := s.Subscribe(events.Filter{})
+	unsubscribe()
+	unsubscribe()
+
+	_, open := <-evs
+	assert.False(t, open)
+}
@@ -765,6 +765,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 		newRoundLogger.Errorf("unable to create job run: %v", err)
 		return
 	}
+	fm.runner.NotifyRunsFinished(&run)
 }
 
 var (
@@ -992,6 +993,7 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		l.Errorw("can't create job run", "err", err)
 		return
 	}
+	fm.runner.NotifyRunsFinished(&run)
 
 	promfm.SetDecimal(promfm.ReportedValue.WithLabelValues(jobID), answer)
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
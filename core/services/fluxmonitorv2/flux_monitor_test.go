@@ -119,6 +119,7 @@ func newTestMocks(t *testing.T) *testMocks {
 
 	tm.flags.On("ContractExists").Maybe().Return(false)
 	tm.logBroadcast.On("String").Maybe().Return("")
+	tm.pipelineRunner.On("NotifyRunsFinished", mock.Anything).Maybe()
 
 	tm.fluxAggregator.Test(t)
 	tm.logBroadcast.Test(t)
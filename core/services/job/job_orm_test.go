@@ -3,7 +3,9 @@ package job_test
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -16,6 +18,8 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -30,6 +34,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestORM(t *testing.T) {
@@ -643,6 +648,153 @@ func Test_FindJob(t *testing.T) {
 	})
 }
 
+func Test_FindEthKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	require.NoError(t, keyStore.OCR().Add(cltest.DefaultOCRKey))
+	require.NoError(t, keyStore.P2P().Add(cltest.DefaultP2PKey))
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+	borm := cltest.NewTxmORM(t, db, config)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	externalJobID := uuid.NewV4()
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb, err := ocr.ValidatedOracleSpecToml(cc,
+		testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			JobID:              externalJobID.String(),
+			TransmitterAddress: address.Hex(),
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge2.Name.String(),
+		}).Toml(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	// a transaction of the OCR job, which has a receipt
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, address)
+	_, err = db.Exec(`UPDATE eth_txes SET subject = $1 WHERE id = $2`, externalJobID, etx.ID)
+	require.NoError(t, err)
+	receipt := evmtypes.Receipt{
+		TxHash:            etx.EthTxAttempts[0].Hash,
+		BlockHash:         utils.NewHash(),
+		BlockNumber:       big.NewInt(1),
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(2),
+	}
+	data, err := json.Marshal(receipt)
+	require.NoError(t, err)
+	require.NoError(t, borm.InsertEthReceipt(&txmgr.EthReceipt{BlockNumber: 1, BlockHash: receipt.BlockHash, TxHash: receipt.TxHash, Receipt: data}))
+
+	// a transaction without a job, which has no receipt yet
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, address)
+
+	usage, err := orm.FindEthKeyUsage(address, nil, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), false)
+	require.NoError(t, err)
+
+	require.Len(t, usage.Jobs, 1)
+	assert.Equal(t, jb.ID, usage.Jobs[0].JobID)
+	assert.Equal(t, job.OffchainReporting, usage.Jobs[0].JobType)
+
+	require.Len(t, usage.Transactions, 2)
+	tx := usage.Transactions[0]
+	assert.Equal(t, etx.ID, tx.EthTxID)
+	require.NotNil(t, tx.JobID)
+	assert.Equal(t, jb.ID, *tx.JobID)
+	assert.Equal(t, uint64(21000), *tx.GasUsed)
+	assert.Equal(t, "42", tx.Fee.ToInt().String())
+	assert.False(t, tx.FeeEstimated)
+	assert.Nil(t, usage.Transactions[1].JobID)
+	assert.Nil(t, usage.Transactions[1].Fee)
+
+	require.Len(t, usage.Spend, 2)
+	assert.Equal(t, jb.ID, *usage.Spend[0].JobID)
+	assert.Equal(t, 1, usage.Spend[0].TxCount)
+	assert.Equal(t, "42", usage.Spend[0].Fee.ToInt().String())
+	assert.Nil(t, usage.Spend[1].JobID)
+	assert.Equal(t, 1, usage.Spend[1].TxCount)
+
+	usage, err = orm.FindEthKeyUsage(address, nil, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), false)
+	require.NoError(t, err)
+	assert.Len(t, usage.Jobs, 1)
+	assert.Empty(t, usage.Transactions)
+
+	// the usage of a transaction is kept after it is reaped
+	_, err = db.Exec(`DELETE FROM eth_txes WHERE id = $1`, etx.ID)
+	require.NoError(t, err)
+	usage, err = orm.FindEthKeyUsage(address, nil, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), false)
+	require.NoError(t, err)
+	require.Len(t, usage.Transactions, 2)
+	assert.Equal(t, etx.ID, usage.Transactions[0].EthTxID)
+	assert.Equal(t, "confirmed", usage.Transactions[0].State)
+	assert.Equal(t, jb.ID, *usage.Spend[0].JobID)
+	assert.Equal(t, "42", usage.Spend[0].Fee.ToInt().String())
+
+	jobs, err := orm.FindJobsByOCRKeyBundle(cltest.DefaultOCRKeyBundleID, false)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, jb.ID, jobs[0].JobID)
+
+	jobs, err = orm.FindJobsUsingP2P()
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+}
+
+func Test_FindKeeperUpkeeps(t *testing.T) {
+	t.Parallel()
+
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), config, txmgr.SendEveryStrategy{})
+
+	registry1, jb1 := cltest.MustInsertKeeperRegistry(t, db, korm, keyStore.Eth(), 0, 1, 20)
+	registry2, jb2 := cltest.MustInsertKeeperRegistry(t, db, korm, keyStore.Eth(), 0, 1, 20)
+	upkeep1 := cltest.MustInsertUpkeepForRegistry(t, db, config, registry1)
+	cltest.MustInsertUpkeepForRegistry(t, db, config, registry1)
+	upkeep3 := cltest.MustInsertUpkeepForRegistry(t, db, config, registry2)
+
+	require.NoError(t, korm.RecordUpkeepChecks(map[int32]keeper.UpkeepCheckCounts{upkeep1.ID: {Checks: 1, Eligible: 1}}))
+	_, err := korm.RecordUpkeepPerformed(jb1.ID, upkeep1.UpkeepID, true, big.NewInt(1000), utils.NewHash())
+	require.NoError(t, err)
+
+	upkeeps, count, err := orm.FindKeeperUpkeeps(0, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, upkeeps, 3)
+	assert.Equal(t, upkeep1.ID, upkeeps[0].ID)
+	assert.Equal(t, jb1.ID, upkeeps[0].JobID)
+	assert.Equal(t, registry1.ContractAddress, upkeeps[0].RegistryAddress)
+	assert.Equal(t, upkeep1.UpkeepID.String(), upkeeps[0].UpkeepID.String())
+	assert.Equal(t, int64(1), upkeeps[0].ChecksCount)
+	assert.Equal(t, int64(1), upkeeps[0].EligibleCount)
+	assert.Equal(t, int64(1), upkeeps[0].PerformsSucceededCount)
+	assert.Equal(t, "1000", upkeeps[0].LinkPayment.String())
+	assert.Equal(t, int64(0), upkeeps[1].ChecksCount)
+
+	upkeeps, count, err = orm.FindKeeperUpkeeps(0, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Len(t, upkeeps, 1)
+
+	upkeeps, count, err = orm.FindKeeperUpkeeps(0, 10, &jb2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, upkeeps, 1)
+	assert.Equal(t, upkeep3.ID, upkeeps[0].ID)
+}
+
 func Test_FindJobsByPipelineSpecIDs(t *testing.T) {
 	t.Parallel()
 
@@ -797,6 +949,119 @@ func Test_PipelineRunsByJobID(t *testing.T) {
 	})
 }
 
+func Test_FilterPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, config)
+	require.NoError(t, keyStore.OCR().Add(cltest.DefaultOCRKey))
+	require.NoError(t, keyStore.P2P().Add(cltest.DefaultP2PKey))
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	externalJobID := uuid.NewV4()
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb, err := ocr.ValidatedOracleSpecToml(cc,
+		testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			JobID:              externalJobID.String(),
+			TransmitterAddress: address.Hex(),
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge2.Name.String(),
+		}).Toml(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	now := time.Now()
+	insertRun := func(createdAt time.Time, duration time.Duration, dsError string) pipeline.Run {
+		run := pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			State:          pipeline.RunStatusCompleted,
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{"1"}, Valid: true},
+			AllErrors:      pipeline.RunErrors{null.String{}},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			CreatedAt:      createdAt,
+			FinishedAt:     null.TimeFrom(createdAt.Add(duration)),
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{ID: uuid.NewV4(), DotID: "ds", Type: pipeline.TaskTypeBridge, Output: pipeline.JSONSerializable{Val: "1", Valid: true}, CreatedAt: createdAt, FinishedAt: null.TimeFrom(createdAt.Add(duration))},
+			},
+		}
+		if dsError != "" {
+			run.State = pipeline.RunStatusErrored
+			run.Outputs = pipeline.JSONSerializable{Val: []interface{}{nil}, Valid: true}
+			run.AllErrors = pipeline.RunErrors{null.StringFrom(dsError)}
+			run.FatalErrors = pipeline.RunErrors{null.StringFrom(dsError)}
+			run.PipelineTaskRuns[0].Output = pipeline.JSONSerializable{}
+			run.PipelineTaskRuns[0].Error = null.StringFrom(dsError)
+		}
+		require.NoError(t, pipelineORM.InsertFinishedRun(&run, true))
+		return run
+	}
+
+	old := insertRun(now.Add(-48*time.Hour), time.Second, "429 Too Many Requests")
+	rateLimited := insertRun(now.Add(-time.Hour), 2*time.Second, "429 Too Many Requests")
+	timedOut := insertRun(now.Add(-time.Hour), 30*time.Second, "context deadline exceeded")
+	ok := insertRun(now.Add(-time.Minute), 100*time.Millisecond, "")
+
+	dayAgo := now.Add(-24 * time.Hour)
+	tenSeconds := 10 * time.Second
+	tests := []struct {
+		name    string
+		filter  job.PipelineRunsFilter
+		wantIDs []int64
+	}{
+		{"no filter", job.PipelineRunsFilter{JobID: &jb.ID}, []int64{ok.ID, timedOut.ID, rateLimited.ID, old.ID}},
+		{"state", job.PipelineRunsFilter{JobID: &jb.ID, States: []pipeline.RunStatus{pipeline.RunStatusCompleted}}, []int64{ok.ID}},
+		{"created after", job.PipelineRunsFilter{JobID: &jb.ID, CreatedAfter: &dayAgo}, []int64{ok.ID, timedOut.ID, rateLimited.ID}},
+		{"created before", job.PipelineRunsFilter{JobID: &jb.ID, CreatedBefore: &dayAgo}, []int64{old.ID}},
+		{"failed task and error", job.PipelineRunsFilter{JobID: &jb.ID, FailedTaskDotID: "ds", ErrorContains: "too many", CreatedAfter: &dayAgo}, []int64{rateLimited.ID}},
+		{"failed task of another name", job.PipelineRunsFilter{JobID: &jb.ID, FailedTaskDotID: "ds2"}, nil},
+		{"min duration", job.PipelineRunsFilter{JobID: &jb.ID, MinDuration: &tenSeconds}, []int64{timedOut.ID}},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			runs, count, err := orm.FilterPipelineRuns(test.filter, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, len(test.wantIDs), count)
+			var ids []int64
+			for _, run := range runs {
+				ids = append(ids, run.ID)
+			}
+			assert.Equal(t, test.wantIDs, ids)
+		})
+	}
+
+	t.Run("task stats", func(t *testing.T) {
+		stats, err := orm.PipelineTaskRunStats(job.PipelineRunsFilter{JobID: &jb.ID, CreatedAfter: &dayAgo})
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+
+		assert.Equal(t, jb.ID, stats[0].JobID)
+		assert.Equal(t, "ds", stats[0].DotID)
+		assert.Equal(t, pipeline.TaskTypeBridge, stats[0].Type)
+		assert.Equal(t, int64(3), stats[0].Count)
+		assert.Equal(t, int64(2), stats[0].Errors)
+		assert.Equal(t, 2*time.Second, stats[0].LatencyP50.Round(time.Millisecond))
+		assert.Greater(t, int64(stats[0].LatencyP99), int64(stats[0].LatencyP50))
+	})
+
+	t.Run("task stats default to the last day", func(t *testing.T) {
+		stats, err := orm.PipelineTaskRunStats(job.PipelineRunsFilter{JobID: &jb.ID})
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, int64(3), stats[0].Count)
+	})
+}
+
 func Test_FindPipelineRunIDsByJobID(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,94 @@
+package job
+
+import (
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// UpkeepStats are the statistics of the checks and performs of an upkeep by
+// this node
+type UpkeepStats struct {
+	// ChecksCount is the number of times the upkeep was checked
+	ChecksCount int64 `db:"checks_count"`
+	// EligibleCount is the number of checks after which the upkeep was performed
+	EligibleCount int64 `db:"eligible_count"`
+	// PerformsCount is the number of UpkeepPerformed logs of this node, and
+	// PerformsSucceededCount the number of those where the upkeep succeeded
+	PerformsCount          int64 `db:"performs_count"`
+	PerformsSucceededCount int64 `db:"performs_succeeded_count"`
+	// PerformGasUsed totals the gas used by the perform transactions whose
+	// receipt is known, which are counted by PerformGasUsedCount
+	PerformGasUsed      int64 `db:"perform_gas_used"`
+	PerformGasUsedCount int64 `db:"perform_gas_used_count"`
+	// LinkPayment totals the payments of the registry to this node
+	LinkPayment assets.Link `db:"link_payment"`
+}
+
+// SuccessRate is the share of the performs of the upkeep which succeeded
+func (s UpkeepStats) SuccessRate() float64 {
+	if s.PerformsCount == 0 {
+		return 0
+	}
+	return float64(s.PerformsSucceededCount) / float64(s.PerformsCount)
+}
+
+// AverageGasUsed is the average gas used by the perform transactions of the
+// upkeep whose receipt is known
+func (s UpkeepStats) AverageGasUsed() int64 {
+	if s.PerformGasUsedCount == 0 {
+		return 0
+	}
+	return s.PerformGasUsed / s.PerformGasUsedCount
+}
+
+// KeeperUpkeep is an upkeep synced by a keeper job, along with its stats
+type KeeperUpkeep struct {
+	// ID is the ID of the upkeep registration
+	ID                 int32               `db:"id"`
+	JobID              int32               `db:"job_id"`
+	JobName            null.String         `db:"job_name"`
+	EVMChainID         *utils.Big          `db:"evm_chain_id"`
+	RegistryAddress    ethkey.EIP55Address `db:"registry_address"`
+	UpkeepID           utils.Big           `db:"upkeep_id"`
+	ExecuteGas         uint64              `db:"execute_gas"`
+	LastRunBlockHeight int64               `db:"last_run_block_height"`
+	UpkeepStats
+}
+
+// FindKeeperUpkeeps returns a page of the upkeeps of all keeper jobs, or of the
+// job with ID jobID if it is not nil, along with their total count
+func (o *orm) FindKeeperUpkeeps(offset, limit int, jobID *int32) (upkeeps []KeeperUpkeep, count int, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		err = tx.Get(&count, `
+SELECT count(*)
+FROM upkeep_registrations
+JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+WHERE $1::int IS NULL OR keeper_registries.job_id = $1`, jobID)
+		if err != nil {
+			return errors.Wrap(err, "failed to count upkeeps")
+		}
+
+		err = tx.Select(&upkeeps, `
+SELECT upkeep_registrations.id, keeper_registries.job_id, jobs.name AS job_name, keeper_specs.evm_chain_id,
+	keeper_registries.contract_address AS registry_address, upkeep_registrations.upkeep_id,
+	upkeep_registrations.execute_gas, upkeep_registrations.last_run_block_height,
+	upkeep_registrations.checks_count, upkeep_registrations.eligible_count,
+	upkeep_registrations.performs_count, upkeep_registrations.performs_succeeded_count,
+	upkeep_registrations.perform_gas_used, upkeep_registrations.perform_gas_used_count,
+	upkeep_registrations.link_payment
+FROM upkeep_registrations
+JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+JOIN jobs ON jobs.id = keeper_registries.job_id
+JOIN keeper_specs ON keeper_specs.id = jobs.keeper_spec_id
+WHERE $1::int IS NULL OR keeper_registries.job_id = $1
+ORDER BY upkeep_registrations.id
+OFFSET $2 LIMIT $3`, jobID, offset, limit)
+		return errors.Wrap(err, "failed to load upkeeps")
+	})
+	return upkeeps, count, errors.Wrap(err, "FindKeeperUpkeeps failed")
+}
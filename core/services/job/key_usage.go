@@ -0,0 +1,252 @@
+package job
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeyBinding is a job which is configured to use a key
+type KeyBinding struct {
+	JobID         int32       `db:"job_id"`
+	JobName       null.String `db:"job_name"`
+	JobType       Type        `db:"job_type"`
+	ExternalJobID uuid.UUID   `db:"external_job_id"`
+}
+
+// EthKeyTx is a transaction signed by an eth key, along with the job which
+// created it and the fee it paid
+type EthKeyTx struct {
+	EthTxID int64 `db:"eth_tx_id"`
+	// Hash is the hash of the attempt with a receipt, or of the latest attempt
+	Hash        common.Hash    `db:"hash"`
+	Nonce       *int64         `db:"nonce"`
+	ToAddress   common.Address `db:"to_address"`
+	Value       assets.Eth     `db:"value"`
+	State       string         `db:"state"`
+	EVMChainID  utils.Big      `db:"evm_chain_id"`
+	Subject     uuid.NullUUID  `db:"subject"`
+	JobID       *int32         `db:"job_id"`
+	JobName     null.String    `db:"job_name"`
+	BroadcastAt *time.Time     `db:"broadcast_at"`
+	// GasUsed, EffectiveGasPrice and Fee are nil until the transaction has a
+	// receipt
+	GasUsed           *uint64     `db:"-"`
+	EffectiveGasPrice *utils.Big  `db:"-"`
+	Fee               *assets.Eth `db:"-"`
+	// FeeEstimated is true if the receipt of a dynamic fee transaction has no
+	// effective gas price, in which case the fee cap is used instead
+	FeeEstimated bool `db:"-"`
+}
+
+// EthKeySpend totals the transactions of an eth key created by one job, or by
+// no job if JobID is nil
+type EthKeySpend struct {
+	JobID   *int32
+	JobName null.String
+	TxCount int
+	GasUsed uint64
+	Fee     assets.Eth
+}
+
+// EthKeyUsage reports which jobs used an eth key, and what it spent on gas
+// between Since and Until
+type EthKeyUsage struct {
+	Address common.Address
+	Since   time.Time
+	Until   time.Time
+	// Jobs are the jobs configured to send transactions from the key
+	Jobs []KeyBinding
+	// Transactions are the transactions broadcast by the key, oldest first
+	Transactions []EthKeyTx
+	// Spend totals the transactions per job, ordered by job ID
+	Spend []EthKeySpend
+}
+
+// ethKeyTxRow is a helper type for reading an EthKeyTx along with its usage
+type ethKeyTxRow struct {
+	EthKeyTx
+	GasUsed           *int64     `db:"gas_used"`
+	EffectiveGasPrice *utils.Big `db:"effective_gas_price"`
+	FeeEstimated      bool       `db:"fee_estimated"`
+}
+
+func (r ethKeyTxRow) toEthKeyTx() EthKeyTx {
+	tx := r.EthKeyTx
+	if r.GasUsed == nil {
+		return tx
+	}
+	gasUsed := uint64(*r.GasUsed)
+	tx.GasUsed = &gasUsed
+	tx.FeeEstimated = r.FeeEstimated
+	if r.EffectiveGasPrice != nil {
+		tx.EffectiveGasPrice = r.EffectiveGasPrice
+		tx.Fee = (*assets.Eth)(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), r.EffectiveGasPrice.ToInt()))
+	}
+	return tx
+}
+
+// FindEthKeyUsage returns the jobs which use the eth key with address, and
+// the transactions it broadcast between since and until, optionally only on
+// the chain with chainID. If isDefaultTransmitter is set, OCR jobs without a
+// transmitter address are included in the jobs.
+func (o *orm) FindEthKeyUsage(address common.Address, chainID *big.Int, since, until time.Time, isDefaultTransmitter bool) (usage EthKeyUsage, err error) {
+	usage = EthKeyUsage{Address: address, Since: since, Until: until}
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		stmt := `
+SELECT jobs.id AS job_id, jobs.name AS job_name, jobs.type AS job_type, jobs.external_job_id
+FROM jobs
+LEFT JOIN ocr_oracle_specs ON ocr_oracle_specs.id = jobs.ocr_oracle_spec_id
+LEFT JOIN ocr2_oracle_specs ON ocr2_oracle_specs.id = jobs.ocr2_oracle_spec_id
+LEFT JOIN keeper_specs ON keeper_specs.id = jobs.keeper_spec_id
+LEFT JOIN vrf_specs ON vrf_specs.id = jobs.vrf_spec_id
+LEFT JOIN blockhash_store_specs ON blockhash_store_specs.id = jobs.blockhash_store_spec_id
+WHERE ocr_oracle_specs.transmitter_address = $1
+OR ($2 AND ocr_oracle_specs.id IS NOT NULL AND ocr_oracle_specs.transmitter_address IS NULL AND NOT ocr_oracle_specs.is_bootstrap_peer)
+OR lower(ocr2_oracle_specs.transmitter_id) = lower($3)
+OR keeper_specs.from_address = $1
+OR $1 = ANY(vrf_specs.from_addresses)
+OR blockhash_store_specs.from_address = $1
+ORDER BY jobs.id`
+		if err = tx.Select(&usage.Jobs, stmt, address, isDefaultTransmitter, address.Hex()); err != nil {
+			return errors.Wrap(err, "failed to load jobs")
+		}
+
+		// Transactions with a receipt are read from eth_key_usage, which
+		// keeps them after they are reaped, and the others from eth_txes. A
+		// transaction's job is the one in its meta, the one whose external job
+		// ID is its subject, or the one whose pipeline run created it
+		args := []interface{}{address, since, until}
+		if chainID != nil {
+			args = append(args, utils.NewBig(chainID))
+		}
+		where := func(table, at string) string {
+			cond := fmt.Sprintf("%[1]s.from_address = $1 AND %[2]s >= $2 AND %[2]s < $3", table, at)
+			if chainID != nil {
+				cond += fmt.Sprintf(" AND %s.evm_chain_id = $4", table)
+			}
+			return cond
+		}
+		stmt = fmt.Sprintf(`
+SELECT eth_key_usage.eth_tx_id, eth_key_usage.tx_hash AS hash, eth_key_usage.nonce, eth_key_usage.to_address, eth_key_usage.value,
+	COALESCE(eth_txes.state, 'confirmed') AS state, eth_key_usage.evm_chain_id, eth_key_usage.subject, eth_key_usage.broadcast_at,
+	eth_key_usage.job_id, eth_key_usage.job_name, eth_key_usage.gas_used, eth_key_usage.effective_gas_price, eth_key_usage.fee_estimated
+FROM eth_key_usage
+LEFT JOIN eth_txes ON eth_txes.id = eth_key_usage.eth_tx_id
+WHERE %s
+UNION ALL
+SELECT eth_txes.id, a.hash, eth_txes.nonce, eth_txes.to_address, eth_txes.value, eth_txes.state,
+	eth_txes.evm_chain_id, eth_txes.subject, eth_txes.broadcast_at, j.id, j.name, NULL, NULL, false
+FROM eth_txes
+JOIN LATERAL (
+	SELECT eth_tx_attempts.hash FROM eth_tx_attempts
+	WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
+	ORDER BY eth_tx_attempts.id DESC
+	LIMIT 1
+) a ON true
+LEFT JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+LEFT JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+LEFT JOIN LATERAL (
+	SELECT jobs.id, jobs.name FROM jobs
+	WHERE jobs.id = (eth_txes.meta->>'JobID')::int
+	OR jobs.external_job_id = eth_txes.subject
+	OR jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+	ORDER BY jobs.id
+	LIMIT 1
+) j ON true
+WHERE %s
+AND NOT EXISTS (SELECT 1 FROM eth_key_usage WHERE eth_key_usage.eth_tx_id = eth_txes.id)
+ORDER BY eth_tx_id`,
+			where("eth_key_usage", "eth_key_usage.broadcast_at"),
+			where("eth_txes", "COALESCE(eth_txes.broadcast_at, eth_txes.created_at)"))
+		var rows []ethKeyTxRow
+		if err = tx.Select(&rows, stmt, args...); err != nil {
+			return errors.Wrap(err, "failed to load transactions")
+		}
+		for _, row := range rows {
+			usage.Transactions = append(usage.Transactions, row.toEthKeyTx())
+		}
+		return nil
+	})
+	if err != nil {
+		return usage, errors.Wrap(err, "FindEthKeyUsage failed")
+	}
+	usage.Spend = totalEthKeySpend(usage.Transactions)
+	return usage, nil
+}
+
+func totalEthKeySpend(txs []EthKeyTx) (spend []EthKeySpend) {
+	index := make(map[int32]int)
+	for _, tx := range txs {
+		var jobID int32
+		if tx.JobID != nil {
+			jobID = *tx.JobID
+		}
+		i, ok := index[jobID]
+		if !ok {
+			i = len(spend)
+			index[jobID] = i
+			spend = append(spend, EthKeySpend{JobID: tx.JobID, JobName: tx.JobName})
+		}
+		spend[i].TxCount++
+		if tx.GasUsed != nil {
+			spend[i].GasUsed += *tx.GasUsed
+		}
+		if tx.Fee != nil {
+			spend[i].Fee.ToInt().Add(spend[i].Fee.ToInt(), tx.Fee.ToInt())
+		}
+	}
+	sort.Slice(spend, func(i, j int) bool {
+		// transactions without a job come last
+		if spend[i].JobID == nil || spend[j].JobID == nil {
+			return spend[j].JobID == nil && spend[i].JobID != nil
+		}
+		return *spend[i].JobID < *spend[j].JobID
+	})
+	return spend
+}
+
+// FindJobsByOCRKeyBundle returns the OCR and OCR2 jobs which sign with the key
+// bundle with ID id. If isDefault is set, OCR jobs without a key bundle are
+// included.
+func (o *orm) FindJobsByOCRKeyBundle(id string, isDefault bool) (jobs []KeyBinding, err error) {
+	bundleID, err := hex.DecodeString(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid key bundle ID")
+	}
+	stmt := `
+SELECT jobs.id AS job_id, jobs.name AS job_name, jobs.type AS job_type, jobs.external_job_id
+FROM jobs
+LEFT JOIN ocr_oracle_specs ON ocr_oracle_specs.id = jobs.ocr_oracle_spec_id
+LEFT JOIN ocr2_oracle_specs ON ocr2_oracle_specs.id = jobs.ocr2_oracle_spec_id
+WHERE ocr_oracle_specs.encrypted_ocr_key_bundle_id = $1
+OR ($2 AND ocr_oracle_specs.id IS NOT NULL AND ocr_oracle_specs.encrypted_ocr_key_bundle_id IS NULL AND NOT ocr_oracle_specs.is_bootstrap_peer)
+OR ocr2_oracle_specs.ocr_key_bundle_id = $3
+ORDER BY jobs.id`
+	// ocr_key_bundle_id holds the hex ID, as it is written from a string
+	err = o.q.Select(&jobs, stmt, bundleID, isDefault, id)
+	return jobs, errors.Wrap(err, "FindJobsByOCRKeyBundle failed")
+}
+
+// FindJobsUsingP2P returns the jobs which use the node's P2P key, which are
+// its OCR, OCR2 and bootstrap jobs
+func (o *orm) FindJobsUsingP2P() (jobs []KeyBinding, err error) {
+	stmt := `
+SELECT id AS job_id, name AS job_name, type AS job_type, external_job_id
+FROM jobs
+WHERE ocr_oracle_spec_id IS NOT NULL OR ocr2_oracle_spec_id IS NOT NULL OR bootstrap_spec_id IS NOT NULL
+ORDER BY id`
+	err = o.q.Select(&jobs, stmt)
+	return jobs, errors.Wrap(err, "FindJobsUsingP2P failed")
+}
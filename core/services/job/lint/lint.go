@@ -0,0 +1,246 @@
+// Package lint validates TOML job specs of every type, and reports mistakes in
+// them which validation lets through, such as settings which are ignored and
+// pipelines which refer to variables that do not exist.
+package lint
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/cron"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+)
+
+// Config is what specs are validated against.
+type Config struct {
+	General config.GeneralConfig
+	// ChainSet provides the config of the chain of OCR jobs. If nil, they are
+	// validated against the default config of their chain.
+	ChainSet evm.ChainSet
+	// ExternalInitiatorManager finds the external initiators of webhook jobs.
+	// If nil, they are not looked up.
+	ExternalInitiatorManager webhook.ExternalInitiatorManager
+}
+
+// Validate validates a TOML job spec with the validator for its type, as when
+// the job is created.
+func Validate(cfg Config, spec string) (jb job.Job, err error) {
+	jobType, err := job.ValidateSpec(spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to parse TOML")
+	}
+	switch jobType {
+	case job.OffchainReporting:
+		if cfg.ChainSet != nil {
+			return ocr.ValidatedOracleSpecToml(cfg.ChainSet, spec)
+		}
+		return ocr.ValidatedOracleSpecTomlWithConfig(func(id *big.Int) (ocr.ValidationConfig, error) {
+			if id == nil {
+				id = cfg.General.DefaultChainID()
+			}
+			if id == nil {
+				id = big.NewInt(0)
+			}
+			return evmconfig.NewChainScopedConfig(id, evmtypes.ChainCfg{}, nil, logger.NullLogger, cfg.General), nil
+		}, spec)
+	case job.OffchainReporting2:
+		return validate.ValidatedOracleSpecToml(cfg.General, spec)
+	case job.DirectRequest:
+		return directrequest.ValidatedDirectRequestSpec(spec)
+	case job.FluxMonitor:
+		return fluxmonitorv2.ValidatedFluxMonitorSpec(cfg.General, spec)
+	case job.Keeper:
+		return keeper.ValidatedKeeperSpec(spec)
+	case job.Cron:
+		return cron.ValidatedCronSpec(spec)
+	case job.VRF:
+		return vrf.ValidatedVRFSpec(spec)
+	case job.Webhook:
+		eiManager := cfg.ExternalInitiatorManager
+		if eiManager == nil {
+			eiManager = webhook.NullExternalInitiatorManager{}
+		}
+		return webhook.ValidatedWebhookSpec(spec, eiManager)
+	case job.BlockhashStore:
+		return blockhashstore.ValidatedSpec(spec)
+	case job.Bootstrap:
+		return ocrbootstrap.ValidatedBootstrapSpecToml(spec)
+	default:
+		return jb, errors.Errorf("unknown job type: %s", jobType)
+	}
+}
+
+// Severity is how serious a Problem is.
+type Severity string
+
+const (
+	// SeverityError is for specs which cannot be created.
+	SeverityError Severity = "error"
+	// SeverityWarning is for specs which can be created, but likely do not do
+	// what was meant.
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is a mistake in a spec.
+type Problem struct {
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// Opts configures Lint.
+type Opts struct {
+	Config
+	// BridgeExists reports whether a bridge exists. If nil, bridges are not
+	// checked.
+	BridgeExists func(name string) (bool, error)
+}
+
+// typeSpecs are the structs the settings of each job type are decoded into,
+// besides job.Job.
+var typeSpecs = map[job.Type]reflect.Type{
+	job.Cron:               reflect.TypeOf(job.CronSpec{}),
+	job.DirectRequest:      reflect.TypeOf(directrequest.DirectRequestToml{}),
+	job.FluxMonitor:        reflect.TypeOf(job.FluxMonitorSpec{}),
+	job.OffchainReporting:  reflect.TypeOf(job.OCROracleSpec{}),
+	job.OffchainReporting2: reflect.TypeOf(job.OCR2OracleSpec{}),
+	job.Keeper:             reflect.TypeOf(job.KeeperSpec{}),
+	job.VRF:                reflect.TypeOf(job.VRFSpec{}),
+	job.Webhook:            reflect.TypeOf(webhook.TOMLWebhookSpec{}),
+	job.BlockhashStore:     reflect.TypeOf(job.BlockhashStoreSpec{}),
+	job.Bootstrap:          reflect.TypeOf(job.BootstrapSpec{}),
+}
+
+// pipelineVars are the variables each job type passes to its runs.
+var pipelineVars = map[job.Type][]string{
+	job.OffchainReporting:  {"jb", "jobRun"},
+	job.OffchainReporting2: {"jb", "jobRun"},
+	job.Keeper:             {"jobSpec"},
+}
+
+// Lint validates spec and reports its problems: the validation error, if any,
+// settings which are not used by its job type, mistakes in its pipelines, and
+// bridges which do not exist.
+func Lint(spec string, opts Opts) []Problem {
+	var problems []Problem
+	add := func(severity Severity, format string, args ...interface{}) {
+		problems = append(problems, Problem{severity, fmt.Sprintf(format, args...)})
+	}
+	tree, err := toml.Load(spec)
+	if err != nil {
+		add(SeverityError, "failed to parse TOML: %v", err)
+		return problems
+	}
+	if _, err = Validate(opts.Config, spec); err != nil {
+		add(SeverityError, "%v", err)
+	}
+
+	jobType, _ := tree.Get("type").(string)
+	if specType, ok := typeSpecs[job.Type(jobType)]; ok {
+		known := settings(specType)
+		for _, k := range tree.Keys() {
+			if !known[k] {
+				add(SeverityWarning, "%s jobs have no setting %q, so it is ignored", jobType, k)
+			}
+		}
+	}
+
+	vars, ok := pipelineVars[job.Type(jobType)]
+	if !ok {
+		vars = []string{"jobSpec", "jobRun"}
+	}
+	for _, key := range []string{"observationSource", "pluginConfig.juelsPerFeeCoinSource"} {
+		source, ok := tree.Get(key).(string)
+		if !ok || strings.TrimSpace(source) == "" {
+			continue
+		}
+		found, err := pipeline.Lint(source, vars...)
+		if err != nil {
+			add(SeverityError, "%s: %v", key, err)
+			continue
+		}
+		for _, p := range found {
+			add(SeverityWarning, "%s: %s", key, p)
+		}
+		if opts.BridgeExists != nil {
+			lintBridges(source, opts.BridgeExists, func(format string, args ...interface{}) {
+				add(SeverityError, key+": "+format, args...)
+			})
+		}
+	}
+	return problems
+}
+
+// lintBridges reports the bridge tasks of source whose bridge does not exist.
+// Bridges named by a variable are not checked.
+func lintBridges(source string, exists func(name string) (bool, error), report func(format string, args ...interface{})) {
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		return
+	}
+	tasks := append([]pipeline.Task(nil), p.Tasks...)
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID() < tasks[j].ID() })
+	for _, t := range tasks {
+		bt, ok := t.(*pipeline.BridgeTask)
+		if !ok || strings.Contains(bt.Name, "$(") {
+			continue
+		}
+		ok, err := exists(bt.Name)
+		if err != nil {
+			report("task %s: failed to look up bridge %q: %v", bt.DotID(), bt.Name, err)
+		} else if !ok {
+			report("task %s: bridge %q does not exist", bt.DotID(), bt.Name)
+		}
+	}
+}
+
+// settings returns the top level keys which are decoded into job.Job or into
+// specType. go-toml matches each field by several spellings of its name.
+func settings(specType reflect.Type) map[string]bool {
+	known := map[string]bool{}
+	addKey := func(name string) {
+		for _, k := range []string{name, strings.ToLower(name), strings.ToTitle(name), strings.ToLower(name[:1]) + name[1:]} {
+			known[k] = true
+		}
+	}
+	for _, name := range []string{"type", "schemaVersion", "name", "externalJobID", "maxTaskDuration", "observationSource"} {
+		addKey(name)
+	}
+	for i := 0; i < specType.NumField(); i++ {
+		f := specType.Field(i)
+		name := strings.Split(f.Tag.Get("toml"), ",")[0]
+		switch {
+		case f.PkgPath != "" || name == "-":
+			continue
+		case f.Name == "ID" || f.Name == "CreatedAt" || f.Name == "UpdatedAt":
+			continue
+		case f.Type.Kind() == reflect.Bool && strings.HasSuffix(f.Name, "Env"):
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		addKey(name)
+	}
+	return known
+}
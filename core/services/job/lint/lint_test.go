@@ -0,0 +1,83 @@
+package lint_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/job/lint"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+)
+
+func TestValidate_Offline(t *testing.T) {
+	t.Parallel()
+
+	cfg := lint.Config{General: configtest.NewTestGeneralConfig(t)}
+
+	jb, err := lint.Validate(cfg, testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{DS1BridgeName: "a", DS2BridgeName: "b"}).Toml())
+	require.NoError(t, err)
+	assert.Equal(t, job.OffchainReporting, jb.Type)
+
+	jb, err = lint.Validate(cfg, testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{
+		ExternalInitiators: []webhook.TOMLWebhookSpecExternalInitiator{{Name: "ei", Spec: models.JSON{}}},
+	}).Toml())
+	require.NoError(t, err)
+	assert.Equal(t, job.Webhook, jb.Type)
+
+	_, err = lint.Validate(cfg, `type = "nope"`)
+	assert.Error(t, err)
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	cfg := lint.Config{General: configtest.NewTestGeneralConfig(t)}
+	bridges := map[string]bool{"voter_turnout": true}
+	opts := lint.Opts{Config: cfg, BridgeExists: func(name string) (bool, error) { return bridges[name], nil }}
+
+	tests := []struct {
+		name string
+		spec string
+		want []lint.Problem
+	}{
+		{"valid", testspecs.CronSpec, nil},
+		{"invalid TOML", `type = `, []lint.Problem{
+			{lint.SeverityError, "failed to parse TOML: (1, 8): expecting a value"},
+		}},
+		{"unknown setting", "schedul = \"@every 1m\"\n" + testspecs.CronSpec, []lint.Problem{
+			{lint.SeverityWarning, `cron jobs have no setting "schedul", so it is ignored`},
+		}},
+		{"invalid", strings.Replace(testspecs.CronSpec, "schedule", "schedul", 1), []lint.Problem{
+			{lint.SeverityError, "while validating cron schedule '': cron schedule must specify a time zone using CRON_TZ, e.g. 'CRON_TZ=UTC 5 * * * *', or use the @every syntax, e.g. '@every 1h30m'"},
+			{lint.SeverityWarning, `cron jobs have no setting "schedul", so it is ignored`},
+		}},
+		{"pipeline", `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC * 0 0 1 1 *"
+observationSource = """
+ds        [type=bridge name=voter_turnout requestData=<{"id": $(jobSpec.externalJobID)}>];
+ds_parse  [type=jsonparse path="data" data="$(ds)" lax="$(jb.name)"];
+ds_submit [type=bridge name=submit];
+unused    [type=multiply times=2];
+ds -> ds_parse -> ds_submit;
+"""
+`, []lint.Problem{
+			{lint.SeverityWarning, `observationSource: task ds_parse: $(jb.name) refers to "jb", which is neither a task nor a variable of the job`},
+			{lint.SeverityWarning, "observationSource: task unused is not connected to the rest of the pipeline"},
+			{lint.SeverityError, `observationSource: task ds_submit: bridge "submit" does not exist`},
+		}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lint.Lint(tt.spec, opts))
+		})
+	}
+}
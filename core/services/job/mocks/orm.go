@@ -3,6 +3,10 @@
 package mocks
 
 import (
+	big "math/big"
+
+	common "github.com/ethereum/go-ethereum/common"
+
 	context "context"
 
 	job "github.com/smartcontractkit/chainlink/core/services/job"
@@ -14,6 +18,8 @@ import (
 
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
 
+	time "time"
+
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -113,6 +119,57 @@ func (_m *ORM) DismissError(ctx context.Context, errorID int64) error {
 	return r0
 }
 
+// FilterPipelineRuns provides a mock function with given fields: filter, offset, size
+func (_m *ORM) FilterPipelineRuns(filter job.PipelineRunsFilter, offset int, size int) ([]pipeline.Run, int, error) {
+	ret := _m.Called(filter, offset, size)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter, int, int) []pipeline.Run); ok {
+		r0 = rf(filter, offset, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter, int, int) int); ok {
+		r1 = rf(filter, offset, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.PipelineRunsFilter, int, int) error); ok {
+		r2 = rf(filter, offset, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindEthKeyUsage provides a mock function with given fields: address, chainID, since, until, isDefaultTransmitter
+func (_m *ORM) FindEthKeyUsage(address common.Address, chainID *big.Int, since time.Time, until time.Time, isDefaultTransmitter bool) (job.EthKeyUsage, error) {
+	ret := _m.Called(address, chainID, since, until, isDefaultTransmitter)
+
+	var r0 job.EthKeyUsage
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, time.Time, time.Time, bool) job.EthKeyUsage); ok {
+		r0 = rf(address, chainID, since, until, isDefaultTransmitter)
+	} else {
+		r0 = ret.Get(0).(job.EthKeyUsage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, time.Time, time.Time, bool) error); ok {
+		r1 = rf(address, chainID, since, until, isDefaultTransmitter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJob provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJob(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -264,6 +321,29 @@ func (_m *ORM) FindJobs(offset int, limit int) ([]job.Job, int, error) {
 	return r0, r1, r2
 }
 
+// FindJobsByOCRKeyBundle provides a mock function with given fields: id, isDefault
+func (_m *ORM) FindJobsByOCRKeyBundle(id string, isDefault bool) ([]job.KeyBinding, error) {
+	ret := _m.Called(id, isDefault)
+
+	var r0 []job.KeyBinding
+	if rf, ok := ret.Get(0).(func(string, bool) []job.KeyBinding); ok {
+		r0 = rf(id, isDefault)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.KeyBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(id, isDefault)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobsByPipelineSpecIDs provides a mock function with given fields: ids
 func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) ([]job.Job, error) {
 	ret := _m.Called(ids)
@@ -287,6 +367,59 @@ func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) ([]job.Job, error) {
 	return r0, r1
 }
 
+// FindJobsUsingP2P provides a mock function with given fields:
+func (_m *ORM) FindJobsUsingP2P() ([]job.KeyBinding, error) {
+	ret := _m.Called()
+
+	var r0 []job.KeyBinding
+	if rf, ok := ret.Get(0).(func() []job.KeyBinding); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.KeyBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindKeeperUpkeeps provides a mock function with given fields: offset, limit, jobID
+func (_m *ORM) FindKeeperUpkeeps(offset int, limit int, jobID *int32) ([]job.KeeperUpkeep, int, error) {
+	ret := _m.Called(offset, limit, jobID)
+
+	var r0 []job.KeeperUpkeep
+	if rf, ok := ret.Get(0).(func(int, int, *int32) []job.KeeperUpkeep); ok {
+		r0 = rf(offset, limit, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.KeeperUpkeep)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int, int, *int32) int); ok {
+		r1 = rf(offset, limit, jobID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int, int, *int32) error); ok {
+		r2 = rf(offset, limit, jobID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FindPipelineRunByID provides a mock function with given fields: id
 func (_m *ORM) FindPipelineRunByID(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
@@ -454,6 +587,29 @@ func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run,
 	return r0, r1, r2
 }
 
+// PipelineTaskRunStats provides a mock function with given fields: filter
+func (_m *ORM) PipelineTaskRunStats(filter job.PipelineRunsFilter) ([]job.PipelineTaskRunStats, error) {
+	ret := _m.Called(filter)
+
+	var r0 []job.PipelineTaskRunStats
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter) []job.PipelineTaskRunStats); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.PipelineTaskRunStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -59,6 +61,8 @@ type ORM interface {
 	FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error)
 	Close() error
 	PipelineRuns(jobID *int32, offset, size int) ([]pipeline.Run, int, error)
+	FilterPipelineRuns(filter PipelineRunsFilter, offset, size int) ([]pipeline.Run, int, error)
+	PipelineTaskRunStats(filter PipelineRunsFilter) ([]PipelineTaskRunStats, error)
 
 	FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error)
 	FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error)
@@ -66,6 +70,11 @@ type ORM interface {
 
 	FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error)
 	FindPipelineRunByID(id int64) (pipeline.Run, error)
+
+	FindEthKeyUsage(address common.Address, chainID *big.Int, since, until time.Time, isDefaultTransmitter bool) (EthKeyUsage, error)
+	FindJobsByOCRKeyBundle(id string, isDefault bool) ([]KeyBinding, error)
+	FindJobsUsingP2P() ([]KeyBinding, error)
+	FindKeeperUpkeeps(offset, limit int, jobID *int32) ([]KeeperUpkeep, int, error)
 }
 
 type orm struct {
@@ -922,13 +931,79 @@ func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 // PipelineRuns returns pipeline runs for a job, with spec and taskruns loaded, latest first
 // If jobID is nil, returns all pipeline runs
 func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run, count int, err error) {
-	err = o.q.Transaction(func(tx pg.Queryer) error {
-		var args []interface{}
-		var where string
-		if jobID != nil {
-			where = " WHERE jobs.id = $1"
-			args = append(args, *jobID)
+	runs, count, err = o.FilterPipelineRuns(PipelineRunsFilter{JobID: jobID}, offset, size)
+	return runs, count, errors.Wrap(err, "PipelineRuns failed")
+}
+
+// PipelineRunsFilter selects pipeline runs. The zero value matches all runs.
+type PipelineRunsFilter struct {
+	JobID  *int32
+	States []pipeline.RunStatus
+	// CreatedAfter and CreatedBefore bound the run's creation time, inclusive
+	// and exclusive respectively
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// FailedTaskDotID matches runs in which the task with this dot ID errored
+	FailedTaskDotID string
+	// ErrorContains matches runs with a task error containing this substring,
+	// ignoring case. If FailedTaskDotID is also set, the error must be that
+	// task's.
+	ErrorContains string
+	// MinDuration matches finished runs that took at least this long
+	MinDuration *time.Duration
+}
+
+// where returns the SQL conditions for the filter, numbering its placeholders
+// from $1. Queries using it must join pipeline_runs with jobs.
+func (f PipelineRunsFilter) where() (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.JobID != nil {
+		conds = append(conds, "jobs.id = "+arg(*f.JobID))
+	}
+	if len(f.States) > 0 {
+		states := make([]string, len(f.States))
+		for i, state := range f.States {
+			states[i] = string(state)
+		}
+		conds = append(conds, "pipeline_runs.state = ANY("+arg(states)+"::pipeline_runs_state[])")
+	}
+	if f.CreatedAfter != nil {
+		conds = append(conds, "pipeline_runs.created_at >= "+arg(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		conds = append(conds, "pipeline_runs.created_at < "+arg(*f.CreatedBefore))
+	}
+	if f.MinDuration != nil {
+		conds = append(conds, "EXTRACT(EPOCH FROM pipeline_runs.finished_at - pipeline_runs.created_at) >= "+arg(f.MinDuration.Seconds()))
+	}
+	if f.FailedTaskDotID != "" || f.ErrorContains != "" {
+		taskConds := []string{"ptr.pipeline_run_id = pipeline_runs.id", "ptr.error IS NOT NULL"}
+		if f.FailedTaskDotID != "" {
+			taskConds = append(taskConds, "ptr.dot_id = "+arg(f.FailedTaskDotID))
 		}
+		if f.ErrorContains != "" {
+			taskConds = append(taskConds, "strpos(lower(ptr.error), lower("+arg(f.ErrorContains)+")) > 0")
+		}
+		conds = append(conds, "EXISTS (SELECT 1 FROM pipeline_task_runs ptr WHERE "+strings.Join(taskConds, " AND ")+")")
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// FilterPipelineRuns returns the pipeline runs matching filter, with spec and taskruns loaded, latest first,
+// and the total number of matching runs
+func (o *orm) FilterPipelineRuns(filter PipelineRunsFilter, offset, size int) (runs []pipeline.Run, count int, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		where, args := filter.where()
 		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id%s`, where)
 		if err = tx.QueryRowx(sql, args...).Scan(&count); err != nil {
 			return errors.Wrap(err, "error counting runs")
@@ -948,7 +1023,50 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 		return err
 	})
 
-	return runs, count, errors.Wrap(err, "PipelineRuns failed")
+	return runs, count, errors.Wrap(err, "FilterPipelineRuns failed")
+}
+
+// PipelineTaskRunStats aggregates the task runs of one task of a job
+type PipelineTaskRunStats struct {
+	JobID  int32             `db:"job_id"`
+	DotID  string            `db:"dot_id"`
+	Type   pipeline.TaskType `db:"type"`
+	Count  int64             `db:"count"`
+	Errors int64             `db:"errors"`
+	// Latency percentiles of the finished task runs
+	LatencyP50 time.Duration `db:"latency_p50"`
+	LatencyP90 time.Duration `db:"latency_p90"`
+	LatencyP99 time.Duration `db:"latency_p99"`
+}
+
+// PipelineTaskRunStatsWindow bounds the runs aggregated by PipelineTaskRunStats
+// when the filter has no CreatedAfter
+const PipelineTaskRunStatsWindow = 24 * time.Hour
+
+// PipelineTaskRunStats returns error counts and latency percentiles for each task of
+// the runs matching filter, ordered by job and dot ID. Runs are only aggregated over
+// the last PipelineTaskRunStatsWindow unless filter has a CreatedAfter. Note that the
+// task runs of successful runs are only stored for jobs that save them.
+func (o *orm) PipelineTaskRunStats(filter PipelineRunsFilter) (stats []PipelineTaskRunStats, err error) {
+	if filter.CreatedAfter == nil {
+		createdAfter := time.Now().Add(-PipelineTaskRunStatsWindow)
+		filter.CreatedAfter = &createdAfter
+	}
+	where, args := filter.where()
+	percentile := func(p string) string {
+		return fmt.Sprintf(`COALESCE((percentile_cont(%s) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pipeline_task_runs.finished_at - pipeline_task_runs.created_at)) * 1e9)::bigint, 0)`, p)
+	}
+	sql := fmt.Sprintf(`SELECT jobs.id AS job_id, pipeline_task_runs.dot_id, pipeline_task_runs.type,
+		count(*) AS count, count(pipeline_task_runs.error) AS errors,
+		%s AS latency_p50, %s AS latency_p90, %s AS latency_p99
+	FROM pipeline_task_runs
+	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+	INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id%s
+	GROUP BY jobs.id, pipeline_task_runs.dot_id, pipeline_task_runs.type
+	ORDER BY jobs.id, pipeline_task_runs.dot_id`, percentile("0.5"), percentile("0.9"), percentile("0.99"), where)
+
+	err = o.q.Select(&stats, sql, args...)
+	return stats, errors.Wrap(err, "PipelineTaskRunStats failed")
 }
 
 func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]pipeline.Run, error) {
@@ -0,0 +1,485 @@
+package job
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// requiredTOMLKeys are encoded even at their zero value, as validation fails
+// without them.
+var requiredTOMLKeys = map[string]struct{}{
+	"isBootstrapPeer": {},
+}
+
+// EncodeTOML returns a TOML spec which validates to jb. The TOML a job was
+// created from is not stored, so this is used to export jobs.
+//
+// Settings of the type specific spec which were filled in from the node config
+// rather than the job spec, and settings at their zero value, are left out.
+// Webhook jobs must have their ExternalInitiatorWebhookSpecs loaded, along with
+// the name of each ExternalInitiator.
+func EncodeTOML(jb Job) (string, error) {
+	header := [][2]interface{}{
+		{"type", jb.Type.String()},
+		{"schemaVersion", int64(jb.SchemaVersion)},
+	}
+	if jb.Name.Valid {
+		header = append(header, [2]interface{}{"name", jb.Name.String})
+	}
+	header = append(header, [2]interface{}{"externalJobID", jb.ExternalJobID.String()})
+	if jb.MaxTaskDuration != 0 {
+		header = append(header, [2]interface{}{"maxTaskDuration", jb.MaxTaskDuration.Duration().String()})
+	}
+	scalars, tables := map[string]interface{}{}, map[string]interface{}{}
+	spec, err := typeSpec(jb)
+	if err != nil {
+		return "", err
+	}
+	if spec.IsValid() {
+		if err := encodeSpecFields(spec, scalars, tables); err != nil {
+			return "", errors.Wrapf(err, "failed to encode %s spec", jb.Type)
+		}
+	}
+	if jb.WebhookSpec != nil && len(jb.WebhookSpec.ExternalInitiatorWebhookSpecs) > 0 {
+		var eis []map[string]interface{}
+		for _, eiSpec := range jb.WebhookSpec.ExternalInitiatorWebhookSpecs {
+			if eiSpec.ExternalInitiator.Name == "" {
+				return "", errors.Errorf("external initiator %d of webhook job is not loaded", eiSpec.ExternalInitiatorID)
+			}
+			eis = append(eis, map[string]interface{}{"name": eiSpec.ExternalInitiator.Name, "spec": eiSpec.Spec.String()})
+		}
+		tables["externalInitiators"] = eis
+	}
+
+	source := jb.Pipeline.Source
+	if jb.PipelineSpec != nil && jb.PipelineSpec.DotDagSource != "" {
+		source = jb.PipelineSpec.DotDagSource
+	}
+	ts := tomlSpec{header: header, scalars: scalars, tables: tables}
+	if source != "" || jb.Type.RequiresPipelineSpec() {
+		ts.source = &source
+	}
+	var b strings.Builder
+	if err := ts.write(&b); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// tomlPipelines are the paths of the settings holding pipelines.
+var tomlPipelines = [][]string{{"observationSource"}, {"pluginConfig", "juelsPerFeeCoinSource"}}
+
+// FormatTOML returns spec with its pipelines formatted by pipeline.Format and
+// written as multi-line strings, as EncodeTOML writes them. The rest of spec,
+// including its comments, is left as it is.
+func FormatTOML(spec string) (string, error) {
+	tree, err := toml.Load(spec)
+	if err != nil {
+		return "", errors.Wrap(err, "toml error on load")
+	}
+	type edit struct {
+		start, end int
+		value      string
+	}
+	var edits []edit
+	for _, path := range tomlPipelines {
+		name := strings.Join(path, ".")
+		v := tree.GetPath(path)
+		if v == nil {
+			continue
+		}
+		source, ok := v.(string)
+		if !ok {
+			return "", errors.Errorf("%s is not a string", name)
+		}
+		if source, err = pipeline.Format(source); err != nil {
+			return "", errors.Wrap(err, name)
+		}
+		start, end, err := tomlStringSpan(spec, tree.GetPositionPath(path))
+		if err != nil {
+			return "", errors.Wrap(err, name)
+		}
+		edits = append(edits, edit{start, end, multilineString(source)})
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	for _, e := range edits {
+		spec = spec[:e.start] + e.value + spec[e.end:]
+	}
+	return spec, nil
+}
+
+// tomlStringSpan returns the offsets in spec of the string value of the key at
+// pos, including its delimiters.
+func tomlStringSpan(spec string, pos toml.Position) (start, end int, err error) {
+	if pos.Invalid() || pos.Col == 0 {
+		return 0, 0, errors.New("cannot find the value in the spec")
+	}
+	lines := strings.SplitAfter(spec, "\n")
+	if pos.Line > len(lines) {
+		return 0, 0, errors.New("cannot find the value in the spec")
+	}
+	for _, l := range lines[:pos.Line-1] {
+		start += len(l)
+	}
+	start += pos.Col - 1
+	eq := strings.IndexByte(spec[start:], '=')
+	if eq < 0 {
+		return 0, 0, errors.New("cannot find the value in the spec")
+	}
+	start += eq + 1
+	for start < len(spec) && (spec[start] == ' ' || spec[start] == '\t') {
+		start++
+	}
+	rest := spec[start:]
+	// Multi-line strings may end with up to two quotes before the closing
+	// delimiter, which are part of the string.
+	closing := func(i int, q byte) int {
+		for n := 0; n < 2 && i < len(rest) && rest[i] == q; n++ {
+			i++
+		}
+		return i
+	}
+	switch {
+	case strings.HasPrefix(rest, `"""`):
+		for i := 3; i < len(rest); i++ {
+			if rest[i] == '\\' {
+				i++
+			} else if strings.HasPrefix(rest[i:], `"""`) {
+				return start, start + closing(i+3, '"'), nil
+			}
+		}
+	case strings.HasPrefix(rest, "'''"):
+		if i := strings.Index(rest[3:], "'''"); i >= 0 {
+			return start, start + closing(i+6, '\''), nil
+		}
+	case strings.HasPrefix(rest, `"`):
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == '\\' {
+				i++
+			} else if rest[i] == '"' {
+				return start, start + i + 1, nil
+			}
+		}
+	case strings.HasPrefix(rest, "'"):
+		if i := strings.IndexByte(rest[1:], '\''); i >= 0 {
+			return start, start + i + 2, nil
+		}
+	}
+	return 0, 0, errors.New("value is not a string")
+}
+
+// typeSpec returns the type specific spec of jb, which is invalid for job
+// types without one.
+func typeSpec(jb Job) (reflect.Value, error) {
+	var spec interface{}
+	switch jb.Type {
+	case Cron:
+		spec = jb.CronSpec
+	case DirectRequest:
+		spec = jb.DirectRequestSpec
+	case FluxMonitor:
+		spec = jb.FluxMonitorSpec
+	case OffchainReporting:
+		spec = jb.OCROracleSpec
+	case OffchainReporting2:
+		spec = jb.OCR2OracleSpec
+	case Keeper:
+		spec = jb.KeeperSpec
+	case VRF:
+		spec = jb.VRFSpec
+	case BlockhashStore:
+		spec = jb.BlockhashStoreSpec
+	case Bootstrap:
+		spec = jb.BootstrapSpec
+	case Webhook:
+		return reflect.Value{}, nil
+	default:
+		return reflect.Value{}, errors.Errorf("unknown job type: %s", jb.Type)
+	}
+	v := reflect.ValueOf(spec)
+	if v.IsNil() {
+		return reflect.Value{}, errors.Errorf("%s job has no %s spec", jb.Type, jb.Type)
+	}
+	return v.Elem(), nil
+}
+
+// encodeSpecFields adds the settings of spec to scalars, or to tables for
+// those which are maps. Keys are named as go-toml matches them when decoding:
+// by toml tag, or else by the field name with its first letter in lower case.
+func encodeSpecFields(spec reflect.Value, scalars, tables map[string]interface{}) error {
+	t := spec.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("toml"), ",")[0]
+		switch {
+		case f.PkgPath != "" || name == "-":
+			continue
+		case f.Name == "ID" || f.Name == "CreatedAt" || f.Name == "UpdatedAt":
+			continue
+		case f.Type.Kind() == reflect.Bool && strings.HasSuffix(f.Name, "Env"):
+			continue
+		case f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.Struct && !f.Type.Elem().Implements(textMarshalerType):
+			continue
+		}
+		if env := spec.FieldByName(f.Name + "Env"); env.IsValid() && env.Kind() == reflect.Bool && env.Bool() {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name[:1]) + f.Name[1:]
+		}
+		val, ok, err := tomlValue(spec.Field(i))
+		if err != nil {
+			return errors.Wrap(err, name)
+		}
+		if !ok {
+			if _, required := requiredTOMLKeys[name]; !required {
+				continue
+			}
+			val = reflect.Zero(f.Type).Interface()
+		}
+		if _, isTable := val.(map[string]interface{}); isTable {
+			tables[name] = val
+		} else {
+			scalars[name] = val
+		}
+	}
+	return nil
+}
+
+// tomlValue converts v to a value go-toml can encode, and reports false if it is
+// unset: nil, or the zero value of a type other than a pointer.
+func tomlValue(v reflect.Value) (interface{}, bool, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false, nil
+		}
+		v = v.Elem()
+	} else if v.IsZero() {
+		return nil, false, nil
+	}
+	if v.Kind() == reflect.Struct {
+		// null types
+		if valid := v.FieldByName("Valid"); valid.IsValid() && valid.Kind() == reflect.Bool && !valid.Bool() {
+			return nil, false, nil
+		}
+	}
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String(), true, nil
+	}
+	if m, ok := textMarshaler(v); ok {
+		b, err := m.MarshalText()
+		if err != nil {
+			return nil, false, err
+		}
+		return string(b), true, nil
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok && v.Kind() == reflect.Array {
+		// hashes
+		return s.String(), true, nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return v.Bool(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), true, nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true, nil
+	case reflect.Slice, reflect.Array:
+		var vals []interface{}
+		for i := 0; i < v.Len(); i++ {
+			val, ok, err := tomlValue(v.Index(i))
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				vals = append(vals, val)
+			}
+		}
+		return vals, len(vals) > 0, nil
+	case reflect.Map:
+		m := map[string]interface{}{}
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = iter.Value().Interface()
+		}
+		return m, len(m) > 0, nil
+	default:
+		return nil, false, errors.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+func textMarshaler(v reflect.Value) (encoding.TextMarshaler, bool) {
+	if v.Type().Implements(textMarshalerType) {
+		return v.Interface().(encoding.TextMarshaler), true
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(textMarshalerType) {
+		return v.Addr().Interface().(encoding.TextMarshaler), true
+	}
+	if reflect.PtrTo(v.Type()).Implements(textMarshalerType) {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		return p.Interface().(encoding.TextMarshaler), true
+	}
+	return nil, false
+}
+
+// tomlSpec is a job spec as it is written: the settings common to all job
+// types in order, the other settings in alphabetical order, the pipeline, and
+// then the tables.
+type tomlSpec struct {
+	header  [][2]interface{}
+	scalars map[string]interface{}
+	source  *string
+	tables  map[string]interface{}
+}
+
+func (s tomlSpec) write(b *strings.Builder) error {
+	for _, kv := range s.header {
+		if err := writeTOMLKey(b, kv[0].(string), kv[1]); err != nil {
+			return err
+		}
+	}
+	for _, k := range sortedKeys(s.scalars) {
+		if err := writeTOMLKey(b, k, s.scalars[k]); err != nil {
+			return err
+		}
+	}
+	if s.source != nil {
+		writeMultiline(b, "observationSource", *s.source)
+	}
+	return writeTOMLTables(b, "", s.tables)
+}
+
+// writeTOMLKey writes a key and its value, which is not a table.
+func writeTOMLKey(b *strings.Builder, key string, val interface{}) error {
+	if s, ok := val.(string); ok && strings.Contains(s, "\n") {
+		writeMultiline(b, key, s)
+		return nil
+	}
+	tree, err := toml.TreeFromMap(map[string]interface{}{key: val})
+	if err != nil {
+		return err
+	}
+	out, err := tree.Marshal()
+	if err != nil {
+		return err
+	}
+	b.Write(out)
+	return nil
+}
+
+// writeMultiline writes s as a multi-line basic string, as pipelines are
+// written by hand.
+func writeMultiline(b *strings.Builder, key, s string) {
+	fmt.Fprintf(b, "%s = %s\n", tomlKey(key), multilineString(s))
+}
+
+// multilineString returns s as a multi-line basic string.
+func multilineString(s string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"""`, `""\"`).Replace
+	src := escape(s)
+	if strings.HasSuffix(s, `"`) {
+		// a quote before the closing delimiter would be taken as part of it
+		src = escape(s[:len(s)-1]) + `\"`
+	}
+	// The newline after the opening delimiter is trimmed when decoding.
+	return fmt.Sprintf("\"\"\"\n%s\"\"\"", src)
+}
+
+// writeTOMLTables writes the tables and arrays of tables of m, with their
+// names prefixed by prefix.
+func writeTOMLTables(b *strings.Builder, prefix string, m map[string]interface{}) error {
+	for _, k := range sortedKeys(m) {
+		name := prefix + tomlKey(k)
+		var tables []map[string]interface{}
+		header := "[" + name + "]"
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			tables = append(tables, v)
+		case []map[string]interface{}:
+			tables, header = v, "[["+name+"]]"
+		case []interface{}:
+			for _, elem := range v {
+				tables = append(tables, elem.(map[string]interface{}))
+			}
+			header = "[[" + name + "]]"
+		default:
+			continue
+		}
+		for _, t := range tables {
+			scalars, nested := splitTOMLTables(t)
+			fmt.Fprintf(b, "\n%s\n", header)
+			for _, tk := range sortedKeys(scalars) {
+				if err := writeTOMLKey(b, tk, scalars[tk]); err != nil {
+					return err
+				}
+			}
+			if err := writeTOMLTables(b, name+".", nested); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// splitTOMLTables splits m into its values which are tables or arrays of
+// tables, and the others.
+func splitTOMLTables(m map[string]interface{}) (scalars, tables map[string]interface{}) {
+	scalars, tables = map[string]interface{}{}, map[string]interface{}{}
+	for k, v := range m {
+		switch v := v.(type) {
+		case map[string]interface{}, []map[string]interface{}:
+			tables[k] = v
+		case []interface{}:
+			isTables := len(v) > 0
+			for _, elem := range v {
+				if _, ok := elem.(map[string]interface{}); !ok {
+					isTables = false
+				}
+			}
+			if isTables {
+				tables[k] = v
+			} else {
+				scalars[k] = v
+			}
+		default:
+			scalars[k] = v
+		}
+	}
+	return
+}
+
+var bareTOMLKeyRegexp = regexp.MustCompile(`\A[A-Za-z0-9_-]+\z`)
+
+func tomlKey(k string) string {
+	if bareTOMLKeyRegexp.MatchString(k) {
+		return k
+	}
+	return strconv.Quote(k)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,224 @@
+package job_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/cron"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+)
+
+func TestEncodeTOML_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.Dev = null.BoolFrom(false)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	chainSet := evmtest.NewChainSet(t, evmtest.TestChainOpts{GeneralConfig: cfg})
+
+	ei := bridges.ExternalInitiator{ID: 42, Name: "someei"}
+	eiManager := new(webhookmocks.ExternalInitiatorManager)
+	eiManager.On("FindExternalInitiatorByName", ei.Name).Return(ei, nil)
+
+	tests := []struct {
+		name     string
+		toml     string
+		validate func(string) (job.Job, error)
+	}{
+		{"cron", testspecs.CronSpec, cron.ValidatedCronSpec},
+		{"directrequest", testspecs.DirectRequestSpec, directrequest.ValidatedDirectRequestSpec},
+		{"fluxmonitor", testspecs.FluxMonitorSpec, func(s string) (job.Job, error) {
+			return fluxmonitorv2.ValidatedFluxMonitorSpec(cfg, s)
+		}},
+		{"keeper", testspecs.GenerateKeeperSpec(testspecs.KeeperSpecParams{
+			ContractAddress: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+			FromAddress:     "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+			EvmChainID:      4,
+		}).Toml(), keeper.ValidatedKeeperSpec},
+		{"vrf", testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{
+			MinIncomingConfirmations: 10,
+			RequestTimeout:           time.Hour,
+		}).Toml(), vrf.ValidatedVRFSpec},
+		{"webhook", testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{
+			ExternalInitiators: []webhook.TOMLWebhookSpecExternalInitiator{
+				{Name: ei.Name, Spec: cltestJSON(t, `{"foo": "bar"}`)},
+			},
+		}).Toml(), func(s string) (job.Job, error) {
+			jb, err := webhook.ValidatedWebhookSpec(s, eiManager)
+			if err == nil {
+				// as loaded from the database
+				for i := range jb.WebhookSpec.ExternalInitiatorWebhookSpecs {
+					jb.WebhookSpec.ExternalInitiatorWebhookSpecs[i].ExternalInitiator = ei
+				}
+			}
+			return jb, err
+		}},
+		{"blockhashstore", testspecs.GenerateBlockhashStoreSpec(testspecs.BlockhashStoreSpecParams{}).Toml(), blockhashstore.ValidatedSpec},
+		{"offchainreporting", testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			TransmitterAddress: "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4",
+			DS1BridgeName:      "bridge1",
+			DS2BridgeName:      "bridge2",
+		}).Toml(), func(s string) (job.Job, error) {
+			return ocr.ValidatedOracleSpecToml(chainSet, s)
+		}},
+		{"offchainreporting2", `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "ocr2"
+contractID = "0x613a38AC1659769640aaE063C651F48E0250454C"
+relay = "evm"
+pluginType = "median"
+transmitterID = "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+
+[relayConfig]
+chainID = 1337
+
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds [type=http method=GET url="https://chain.link/LINK-USD"];
+"""
+`, func(s string) (job.Job, error) {
+			return validate.ValidatedOracleSpecToml(cfg, s)
+		}},
+		{"bootstrap", `
+type = "bootstrap"
+schemaVersion = 1
+name = "bootstrap"
+contractID = "0x613a38AC1659769640aaE063C651F48E0250454C"
+relay = "evm"
+blockchainTimeout = "20s"
+
+[relayConfig]
+chainID = 1337
+`, ocrbootstrap.ValidatedBootstrapSpecToml},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.validate(tt.toml)
+			require.NoError(t, err)
+
+			encoded, err := job.EncodeTOML(want)
+			require.NoError(t, err)
+
+			got, err := tt.validate(encoded)
+			require.NoError(t, err, encoded)
+			assert.Equal(t, want.Pipeline.Source, got.Pipeline.Source)
+			want.Pipeline, got.Pipeline = pipeline.Pipeline{}, pipeline.Pipeline{}
+			assert.Equal(t, want, got, encoded)
+		})
+	}
+}
+
+func TestEncodeTOML(t *testing.T) {
+	t.Parallel()
+
+	jb, err := cron.ValidatedCronSpec(testspecs.CronSpec)
+	require.NoError(t, err)
+	jb.PipelineSpec = &pipeline.Spec{DotDagSource: `ds [type=http url="https://chain.link" requestData="{\"a\": 1}"]` + "\n"}
+
+	s, err := job.EncodeTOML(jb)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`type = "cron"
+schemaVersion = 1
+externalJobID = %q
+schedule = "CRON_TZ=UTC * 0 0 1 1 *"
+observationSource = """
+ds [type=http url="https://chain.link" requestData="{\\"a\\": 1}"]
+"""
+`, jb.ExternalJobID.String()), s)
+
+	jb.Type = "unknown"
+	_, err = job.EncodeTOML(jb)
+	assert.EqualError(t, err, "unknown job type: unknown")
+}
+
+func TestFormatTOML(t *testing.T) {
+	t.Parallel()
+
+	spec := `
+# comments are kept
+schedule = "CRON_TZ=UTC * 0 0 1 1 *"
+name     = "formatted"
+type            = "cron"
+schemaVersion = 1
+observationSource   = """
+ds [type=http method=GET url="https://chain.link"]; ds_parse [type=jsonparse path="data"];
+ds->ds_parse
+""" # the pipeline
+[pluginConfig]
+juelsPerFeeCoinSource = "ds [type=http method=GET url=\"https://chain.link\"];"
+[[externalInitiators]]
+name = "ei"
+spec = '{"foo": "bar"}'
+`
+	want := `
+# comments are kept
+schedule = "CRON_TZ=UTC * 0 0 1 1 *"
+name     = "formatted"
+type            = "cron"
+schemaVersion = 1
+observationSource   = """
+ds       [type=http method=GET url="https://chain.link"];
+ds_parse [type=jsonparse path=data];
+
+ds -> ds_parse;
+""" # the pipeline
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds [type=http method=GET url="https://chain.link"];
+"""
+[[externalInitiators]]
+name = "ei"
+spec = '{"foo": "bar"}'
+`
+	got, err := job.FormatTOML(spec)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	again, err := job.FormatTOML(got)
+	require.NoError(t, err)
+	assert.Equal(t, got, again)
+
+	got, err = job.FormatTOML(`observationSource = 'ds [type=memo value="x"]' # memo` + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, "observationSource = \"\"\"\nds [type=memo value=x];\n\"\"\" # memo\n", got)
+
+	_, err = job.FormatTOML(`observationSource = "ds1 -> "`)
+	assert.Error(t, err)
+	_, err = job.FormatTOML(`pluginConfig = { juelsPerFeeCoinSource = "ds [type=memo value=1];" }`)
+	assert.EqualError(t, err, "pluginConfig.juelsPerFeeCoinSource: cannot find the value in the spec")
+	_, err = job.FormatTOML(`type = `)
+	assert.Error(t, err)
+}
+
+func cltestJSON(t *testing.T, s string) models.JSON {
+	j, err := models.ParseJSON([]byte(s))
+	require.NoError(t, err)
+	return j
+}
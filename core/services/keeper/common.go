@@ -5,9 +5,11 @@ import (
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper1_2"
 )
 
 var RegistryABI = evmtypes.MustGetABI(keeper_registry_wrapper.KeeperRegistryABI)
+var Registry1_2ABI = evmtypes.MustGetABI(keeper_registry_wrapper1_2.KeeperRegistryABI)
 
 type Config interface {
 	EvmEIP1559DynamicFees() bool
@@ -15,6 +17,8 @@ type Config interface {
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
 	KeeperBaseFeeBufferPercent() uint32
+	KeeperCheckUpkeepBatchSize() uint32
+	KeeperExecutionQueueSize() uint32
 	KeeperMaximumGracePeriod() int64
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
@@ -6,7 +6,6 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
-	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -64,12 +63,9 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 	}
 
 	contractAddress := spec.KeeperSpec.ContractAddress
-	contract, err := keeper_registry_wrapper.NewKeeperRegistry(
-		contractAddress.Address(),
-		chain.Client(),
-	)
+	registryWrapper, err := NewRegistryWrapper(contractAddress, chain.Client())
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to create keeper registry contract wrapper")
+		return nil, errors.Wrap(err, "unable to create keeper registry wrapper")
 	}
 	strategy := txmgr.NewQueueingTxStrategy(spec.ExternalJobID, chain.Config().KeeperDefaultTransactionQueueDepth())
 
@@ -87,7 +83,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 
 	registrySynchronizer := NewRegistrySynchronizer(RegistrySynchronizerOptions{
 		Job:                      spec,
-		Contract:                 contract,
+		RegistryWrapper:          registryWrapper,
 		ORM:                      orm,
 		JRM:                      d.jrm,
 		LogBroadcaster:           chain.LogBroadcaster(),
@@ -103,6 +99,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 		d.pr,
 		chain.Client(),
 		chain.HeadBroadcaster(),
+		registryWrapper,
 		chain.TxManager().GetGasEstimator(),
 		svcLogger,
 		chain.Config(),
@@ -1,5 +1,7 @@
 package keeper
 
+import "math/big"
+
 func (rs *RegistrySynchronizer) ExportedFullSync() {
 	rs.fullSync()
 }
@@ -7,3 +9,11 @@ func (rs *RegistrySynchronizer) ExportedFullSync() {
 func (rs *RegistrySynchronizer) ExportedProcessLogs() {
 	rs.processLogs()
 }
+
+func ExportedTurnKeeperIndex(upkeepID *big.Int, turnBinary string, numKeepers int32) (int64, error) {
+	return turnKeeperIndex(upkeepID, turnBinary, numKeepers)
+}
+
+func ExportedIsCheckUpkeepRevert(returnData []byte) bool {
+	return isCheckUpkeepRevert(returnData)
+}
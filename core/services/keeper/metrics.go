@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promUpkeepChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeep_checks",
+		Help: "The number of times upkeeps were checked",
+	}, []string{"registryAddress"})
+
+	promUpkeepEligible = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeep_eligible",
+		Help: "The number of checks after which upkeeps were performed",
+	}, []string{"registryAddress"})
+
+	promUpkeepPerforms = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeep_performs",
+		Help: "The number of UpkeepPerformed logs of this node, by whether the upkeep succeeded",
+	}, []string{"registryAddress", "success"})
+
+	promUpkeepPerformGasUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeep_perform_gas_used",
+		Help: "The gas used by the perform transactions of this node whose receipt is known",
+	}, []string{"registryAddress"})
+
+	promUpkeepLinkPayment = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeep_link_payment",
+		Help: "The LINK paid by registries to this node for performing upkeeps",
+	}, []string{"registryAddress"})
+)
+
+func incUpkeepChecks(registryAddress string, count int, eligible bool) {
+	promUpkeepChecks.WithLabelValues(registryAddress).Add(float64(count))
+	if eligible {
+		promUpkeepEligible.WithLabelValues(registryAddress).Add(float64(count))
+	}
+}
+
+func incUpkeepPerformed(registryAddress string, success bool, gasUsed *uint64, payment *big.Int) {
+	promUpkeepPerforms.WithLabelValues(registryAddress, strconv.FormatBool(success)).Inc()
+	if gasUsed != nil {
+		promUpkeepPerformGasUsed.WithLabelValues(registryAddress).Add(float64(*gasUsed))
+	}
+	// payments are in juels
+	link, _ := new(big.Float).Quo(new(big.Float).SetInt(payment), big.NewFloat(1e18)).Float64()
+	promUpkeepLinkPayment.WithLabelValues(registryAddress).Add(link)
+}
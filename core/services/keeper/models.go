@@ -6,7 +6,9 @@ import (
 	"fmt"
 
 	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type KeeperIndexMap map[ethkey.EIP55Address]int32
@@ -29,9 +31,17 @@ type UpkeepRegistration struct {
 	LastRunBlockHeight  int64
 	RegistryID          int64
 	Registry            Registry
-	UpkeepID            int64
+	UpkeepID            *utils.Big
 	LastKeeperIndex     null.Int64
 	PositioningConstant int32
+	job.UpkeepStats
+}
+
+// UpkeepCheckCounts are the numbers of checks of an upkeep registration, and
+// of those after which it was performed
+type UpkeepCheckCounts struct {
+	Checks   int64
+	Eligible int64
 }
 
 func (k *KeeperIndexMap) Scan(val interface{}) error {
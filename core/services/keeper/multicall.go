@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+)
+
+// MulticallAddress is the address of Multicall3, which is deployed at the same
+// address on most chains
+var MulticallAddress = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicallABI = `[
+{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}
+]`
+
+var MulticallABI = evmtypes.MustGetABI(multicallABI)
+
+// errNoMulticall is returned by multicall when Multicall3 is not deployed
+var errNoMulticall = errors.New("multicall is not deployed")
+
+// MulticallCall is a call made through Multicall3
+type MulticallCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// MulticallResult is the result of a call made through Multicall3
+type MulticallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+const (
+	// multicallGasOverhead is the gas used by Multicall3 outside of the calls
+	// it makes
+	multicallGasOverhead uint64 = 50_000
+	// multicallCallGasOverhead is the gas used by Multicall3 for each call it
+	// makes, on top of the gas used by the call itself
+	multicallCallGasOverhead uint64 = 10_000
+	// maxMulticallGas is the maximum gas limit of a multicall, well under the
+	// default eth_call gas cap of geth
+	maxMulticallGas uint64 = 25_000_000
+)
+
+// multicallGasLimit returns the gas limit of a multicall making calls with a
+// total gas limit of callsGas. Only 63/64 of the remaining gas is forwarded
+// to each call, so 1/63 more is added for the last one.
+func multicallGasLimit(callsGas uint64, calls int) uint64 {
+	gas := callsGas + uint64(calls)*multicallCallGasOverhead
+	return gas + gas/63 + multicallGasOverhead
+}
+
+// multicall makes calls in a single eth_call to Multicall3 at blockNumber and
+// returns their results in the same order. msg sets the sender, gas limit and
+// gas prices of the eth_call, its recipient and data are set by multicall.
+func multicall(ctx context.Context, client evmclient.Client, msg ethereum.CallMsg, calls []MulticallCall, blockNumber *big.Int) ([]MulticallResult, error) {
+	data, err := MulticallABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to pack multicall")
+	}
+	msg.To = &MulticallAddress
+	msg.Data = data
+	b, err := client.CallContract(ctx, msg, blockNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "multicall failed")
+	}
+	if len(b) == 0 {
+		// calls to an address without code succeed without returning data
+		return nil, errNoMulticall
+	}
+	out, err := MulticallABI.Unpack("aggregate3", b)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unpack multicall results")
+	}
+	results := *abi.ConvertType(out[0], new([]MulticallResult)).(*[]MulticallResult)
+	if len(results) != len(calls) {
+		return nil, errors.Errorf("expected %d multicall results, got %d", len(calls), len(results))
+	}
+	return results, nil
+}
+
+// checkUpkeepCallData returns the call data of checkUpkeep for the upkeep
+// with ID upkeepID, as checked by from
+func checkUpkeepCallData(upkeepID *big.Int, from common.Address) ([]byte, error) {
+	return RegistryABI.Pack("checkUpkeep", upkeepID, from)
+}
+
+// checkUpkeepErrorsABI holds the errors checkUpkeep of KeeperRegistry 1.2
+// reverts with when the upkeep cannot be performed
+const checkUpkeepErrorsABI = `[
+{"inputs":[],"name":"InsufficientFunds","type":"error"},
+{"inputs":[],"name":"KeepersMustTakeTurns","type":"error"},
+{"inputs":[],"name":"OnlyActiveKeepers","type":"error"},
+{"inputs":[{"internalType":"bytes","name":"reasonBytes","type":"bytes"}],"name":"TargetCheckReverted","type":"error"},
+{"inputs":[],"name":"UpkeepNotNeeded","type":"error"}
+]`
+
+var checkUpkeepErrors = evmtypes.MustGetABI(checkUpkeepErrorsABI)
+
+// isCheckUpkeepRevert returns whether the return data of a failed checkUpkeep
+// call decodes as a revert of the registry, rather than the call running out
+// of gas or failing for another reason
+func isCheckUpkeepRevert(returnData []byte) bool {
+	if _, err := abi.UnpackRevert(returnData); err == nil {
+		return true
+	}
+	if len(returnData) < 4 {
+		return false
+	}
+	for _, e := range checkUpkeepErrors.Errors {
+		if bytes.Equal(returnData[:4], e.ID[:4]) {
+			return true
+		}
+	}
+	return false
+}
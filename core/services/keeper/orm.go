@@ -1,17 +1,23 @@
 package keeper
 
 import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
 	"math/rand"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // ORM implements ORM layer using PostgreSQL
@@ -91,12 +97,16 @@ RETURNING *
 }
 
 // BatchDeleteUpkeepsForJob deletes all upkeeps by the given IDs for the job with the given ID
-func (korm ORM) BatchDeleteUpkeepsForJob(jobID int32, upkeepIDs []int64) (int64, error) {
+func (korm ORM) BatchDeleteUpkeepsForJob(jobID int32, upkeepIDs []utils.Big) (int64, error) {
+	strIDs := make([]string, len(upkeepIDs))
+	for i, upkeepID := range upkeepIDs {
+		strIDs[i] = upkeepID.String()
+	}
 	res, err := korm.q.Exec(`
 DELETE FROM upkeep_registrations WHERE registry_id IN (
 	SELECT id FROM keeper_registries WHERE job_id = $1
-) AND upkeep_id = ANY($2)
-`, jobID, upkeepIDs)
+) AND upkeep_id = ANY($2::numeric[])
+`, jobID, pq.Array(strIDs))
 	if err != nil {
 		return 0, errors.Wrap(err, "BatchDeleteUpkeepsForJob failed to delete")
 	}
@@ -120,7 +130,7 @@ WHERE
 	keeper_registries.contract_address = $1 AND
 	keeper_registries.num_keepers > 0 AND 
     ((
-                keeper_registries.keeper_index = ((CAST(upkeep_registrations.upkeep_id AS bit(32)) #
+                keeper_registries.keeper_index = ((CAST((upkeep_registrations.upkeep_id % 4294967296)::bigint AS bit(32)) #
                                                    CAST($4 AS bit(32)))::bigint % keeper_registries.num_keepers)
             AND
                 (
@@ -132,7 +142,7 @@ WHERE
    OR
     (
                     (keeper_registries.keeper_index + 1) % keeper_registries.num_keepers =
-                    ((CAST(upkeep_registrations.upkeep_id AS bit(32)) #
+                    ((CAST((upkeep_registrations.upkeep_id % 4294967296)::bigint AS bit(32)) #
                       CAST($4 AS bit(32)))::bigint % keeper_registries.num_keepers)
             AND
                     upkeep_registrations.last_keeper_index IS NOT DISTINCT FROM (keeper_registries.keeper_index + 1) % keeper_registries.num_keepers
@@ -212,10 +222,33 @@ func loadUpkeepsRegistry(q pg.Queryer, upkeeps []UpkeepRegistration) error {
 	return nil
 }
 
+// UpkeepForJob returns the upkeep with the given ID of the registry of the
+// job with the given ID, along with its registry
+func (korm ORM) UpkeepForJob(jobID int32, upkeepID *utils.Big) (upkeep UpkeepRegistration, err error) {
+	err = korm.q.Transaction(func(tx pg.Queryer) error {
+		err = tx.Get(&upkeep, `
+SELECT upkeep_registrations.* FROM upkeep_registrations
+INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+WHERE keeper_registries.job_id = $1 AND upkeep_registrations.upkeep_id = $2
+`, jobID, upkeepID)
+		if err != nil {
+			return errors.Wrap(err, "UpkeepForJob failed to get upkeep_registration")
+		}
+		upkeeps := []UpkeepRegistration{upkeep}
+		if err = loadUpkeepsRegistry(tx, upkeeps); err != nil {
+			return errors.Wrap(err, "UpkeepForJob failed to load Registry on upkeep")
+		}
+		upkeep = upkeeps[0]
+		return nil
+	}, pg.OptReadOnlyTx())
+	return upkeep, err
+}
+
 // LowestUnsyncedID returns the largest upkeepID + 1, indicating the expected next upkeepID
 // to sync from the contract
-func (korm ORM) LowestUnsyncedID(regID int64) (nextID int64, err error) {
-	err = korm.q.Get(&nextID, `
+func (korm ORM) LowestUnsyncedID(regID int64) (nextID *utils.Big, err error) {
+	nextID = new(utils.Big)
+	err = korm.q.Get(nextID, `
 SELECT coalesce(max(upkeep_id), -1) + 1
 FROM upkeep_registrations
 WHERE registry_id = $1
@@ -223,8 +256,18 @@ WHERE registry_id = $1
 	return nextID, errors.Wrap(err, "LowestUnsyncedID failed")
 }
 
+// AllUpkeepIDsForRegistry returns the IDs of all the upkeeps of the registry with the given ID
+func (korm ORM) AllUpkeepIDsForRegistry(regID int64) (upkeeps []utils.Big, err error) {
+	err = korm.q.Select(&upkeeps, `
+SELECT upkeep_id
+FROM upkeep_registrations
+WHERE registry_id = $1
+`, regID)
+	return upkeeps, errors.Wrap(err, "failed to get all upkeeps for registry")
+}
+
 //SetLastRunInfoForUpkeepOnJob sets the last run block height and the associated keeper index only if the new block height is greater than the previous.
-func (korm ORM) SetLastRunInfoForUpkeepOnJob(jobID int32, upkeepID, height int64, fromAddress ethkey.EIP55Address, qopts ...pg.QOpt) error {
+func (korm ORM) SetLastRunInfoForUpkeepOnJob(jobID int32, upkeepID *utils.Big, height int64, fromAddress ethkey.EIP55Address, qopts ...pg.QOpt) error {
 	_, err := korm.q.WithOpts(qopts...).Exec(`
 	UPDATE upkeep_registrations
 	SET last_run_block_height = $1,
@@ -234,3 +277,60 @@ func (korm ORM) SetLastRunInfoForUpkeepOnJob(jobID int32, upkeepID, height int64
 	last_run_block_height < $1`, height, upkeepID, jobID, fromAddress.Hex())
 	return errors.Wrap(err, "SetLastRunInfoForUpkeepOnJob failed")
 }
+
+// RecordUpkeepChecks adds the check counts of upkeep registrations, keyed by
+// their IDs, in a single statement
+func (korm ORM) RecordUpkeepChecks(counts map[int32]UpkeepCheckCounts, qopts ...pg.QOpt) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(counts))
+	checks := make([]int64, 0, len(counts))
+	eligible := make([]int64, 0, len(counts))
+	for id, c := range counts {
+		ids = append(ids, int64(id))
+		checks = append(checks, c.Checks)
+		eligible = append(eligible, c.Eligible)
+	}
+	_, err := korm.q.WithOpts(qopts...).Exec(`
+	UPDATE upkeep_registrations
+	SET checks_count = checks_count + counts.checks,
+		eligible_count = eligible_count + counts.eligible
+	FROM unnest($1::int[], $2::bigint[], $3::bigint[]) AS counts(id, checks, eligible)
+	WHERE upkeep_registrations.id = counts.id`, pq.Array(ids), pq.Array(checks), pq.Array(eligible))
+	return errors.Wrap(err, "RecordUpkeepChecks failed")
+}
+
+// RecordUpkeepPerformed counts a perform of the upkeep by this node, from its
+// UpkeepPerformed log. The gas used by the transaction is read from its receipt,
+// if it has one yet, and returned.
+func (korm ORM) RecordUpkeepPerformed(jobID int32, upkeepID *utils.Big, success bool, payment *big.Int, txHash common.Hash, qopts ...pg.QOpt) (gasUsed *uint64, err error) {
+	q := korm.q.WithOpts(qopts...)
+	var receiptJSON []byte
+	err = q.Get(&receiptJSON, `SELECT receipt FROM eth_receipts WHERE tx_hash = $1`, txHash)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "RecordUpkeepPerformed failed to get receipt")
+	}
+	if len(receiptJSON) > 0 {
+		var receipt evmtypes.Receipt
+		if err = json.Unmarshal(receiptJSON, &receipt); err != nil {
+			return nil, errors.Wrap(err, "RecordUpkeepPerformed failed to decode receipt")
+		}
+		gasUsed = &receipt.GasUsed
+	}
+	var gas *int64
+	if gasUsed != nil {
+		g := int64(*gasUsed)
+		gas = &g
+	}
+	_, err = q.Exec(`
+	UPDATE upkeep_registrations
+	SET performs_count = performs_count + 1,
+		performs_succeeded_count = performs_succeeded_count + CASE WHEN $3 THEN 1 ELSE 0 END,
+		link_payment = link_payment + $4,
+		perform_gas_used = perform_gas_used + COALESCE($5::bigint, 0),
+		perform_gas_used_count = perform_gas_used_count + CASE WHEN $5::bigint IS NULL THEN 0 ELSE 1 END
+	WHERE upkeep_id = $2 AND
+	registry_id = (SELECT id FROM keeper_registries WHERE job_id = $1)`, jobID, upkeepID, success, utils.NewBig(payment), gas)
+	return gasUsed, errors.Wrap(err, "RecordUpkeepPerformed failed")
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -41,7 +43,7 @@ func setupKeeperDB(t *testing.T) (
 
 func newUpkeep(registry keeper.Registry, upkeepID int64) keeper.UpkeepRegistration {
 	return keeper.UpkeepRegistration{
-		UpkeepID:   upkeepID,
+		UpkeepID:   utils.NewBigI(upkeepID),
 		ExecuteGas: executeGas,
 		Registry:   registry,
 		RegistryID: registry.ID,
@@ -99,7 +101,7 @@ func TestKeeperDB_UpsertUpkeep(t *testing.T) {
 
 	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
 	upkeep := keeper.UpkeepRegistration{
-		UpkeepID:            0,
+		UpkeepID:            utils.NewBigI(0),
 		ExecuteGas:          executeGas,
 		Registry:            registry,
 		RegistryID:          registry.ID,
@@ -140,14 +142,14 @@ func TestKeeperDB_BatchDeleteUpkeepsForJob(t *testing.T) {
 
 	cltest.AssertCount(t, db, "upkeep_registrations", 3)
 
-	_, err := orm.BatchDeleteUpkeepsForJob(job.ID, []int64{0, 2})
+	_, err := orm.BatchDeleteUpkeepsForJob(job.ID, []utils.Big{*utils.NewBigI(0), *utils.NewBigI(2)})
 	require.NoError(t, err)
 	cltest.AssertCount(t, db, "upkeep_registrations", 1)
 
 	var remainingUpkeep keeper.UpkeepRegistration
 	err = db.Get(&remainingUpkeep, `SELECT * FROM upkeep_registrations ORDER BY id LIMIT 1`)
 	require.NoError(t, err)
-	require.Equal(t, int64(1), remainingUpkeep.UpkeepID)
+	require.Equal(t, int64(1), remainingUpkeep.UpkeepID.ToInt().Int64())
 }
 
 func TestKeeperDB_EligibleUpkeeps_Shuffle(t *testing.T) {
@@ -175,7 +177,7 @@ func TestKeeperDB_EligibleUpkeeps_Shuffle(t *testing.T) {
 	require.Len(t, eligibleUpkeeps, 100)
 	shuffled := [100]int64{}
 	for i := 0; i < 100; i++ {
-		shuffled[i] = eligibleUpkeeps[i].UpkeepID
+		shuffled[i] = eligibleUpkeeps[i].UpkeepID.ToInt().Int64()
 	}
 	assert.NotEqualValues(t, ordered, shuffled)
 }
@@ -233,16 +235,16 @@ func TestKeeperDB_EligibleUpkeeps_TurnsRandom(t *testing.T) {
 
 	// sort before compare
 	sort.Slice(list1, func(i, j int) bool {
-		return list1[i].UpkeepID < list1[j].UpkeepID
+		return list1[i].UpkeepID.Cmp(list1[j].UpkeepID) < 0
 	})
 	sort.Slice(list2, func(i, j int) bool {
-		return list2[i].UpkeepID < list2[j].UpkeepID
+		return list2[i].UpkeepID.Cmp(list2[j].UpkeepID) < 0
 	})
 	sort.Slice(list3, func(i, j int) bool {
-		return list3[i].UpkeepID < list3[j].UpkeepID
+		return list3[i].UpkeepID.Cmp(list3[j].UpkeepID) < 0
 	})
 	sort.Slice(list4, func(i, j int) bool {
-		return list4[i].UpkeepID < list4[j].UpkeepID
+		return list4[i].UpkeepID.Cmp(list4[j].UpkeepID) < 0
 	})
 
 	assert.NotEqual(t, list1, list2, "list1 vs list2")
@@ -359,3 +361,41 @@ func TestKeeperDB_NewSetLastRunInfoForUpkeepOnJob(t *testing.T) {
 	require.NoError(t, orm.SetLastRunInfoForUpkeepOnJob(j.ID, upkeep.UpkeepID, 101, registry.FromAddress))
 	assertLastRunHeight(t, db, upkeep, 101, 0)
 }
+
+func TestKeeperDB_RecordUpkeepStats(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+	other := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	require.NoError(t, orm.RecordUpkeepChecks(map[int32]keeper.UpkeepCheckCounts{
+		upkeep.ID: {Checks: 2, Eligible: 1},
+		other.ID:  {Checks: 1},
+	}))
+	require.NoError(t, orm.RecordUpkeepChecks(nil))
+
+	// the perform tx has no receipt yet, so its gas used is unknown
+	gasUsed, err := orm.RecordUpkeepPerformed(j.ID, upkeep.UpkeepID, true, big.NewInt(1000), utils.NewHash())
+	require.NoError(t, err)
+	assert.Nil(t, gasUsed)
+	_, err = orm.RecordUpkeepPerformed(j.ID, upkeep.UpkeepID, false, big.NewInt(500), utils.NewHash())
+	require.NoError(t, err)
+
+	var stats job.UpkeepStats
+	require.NoError(t, db.Get(&stats, `SELECT checks_count, eligible_count, performs_count, performs_succeeded_count, perform_gas_used, perform_gas_used_count, link_payment FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.Equal(t, int64(2), stats.ChecksCount)
+	assert.Equal(t, int64(1), stats.EligibleCount)
+	assert.Equal(t, int64(2), stats.PerformsCount)
+	assert.Equal(t, int64(1), stats.PerformsSucceededCount)
+	assert.Equal(t, int64(0), stats.PerformGasUsedCount)
+	assert.Equal(t, 0.5, stats.SuccessRate())
+	assert.Equal(t, "1500", stats.LinkPayment.String())
+
+	require.NoError(t, db.Get(&stats, `SELECT checks_count, eligible_count, performs_count, performs_succeeded_count, perform_gas_used, perform_gas_used_count, link_payment FROM upkeep_registrations WHERE id = $1`, other.ID))
+	assert.Equal(t, int64(1), stats.ChecksCount)
+	assert.Equal(t, int64(0), stats.EligibleCount)
+	assert.Equal(t, int64(0), stats.PerformsCount)
+}
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper1_2"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// RegistryVersion is the version of a KeeperRegistry contract
+type RegistryVersion int32
+
+const (
+	RegistryVersion_1_1 RegistryVersion = iota
+	RegistryVersion_1_2
+)
+
+func (rv RegistryVersion) String() string {
+	switch rv {
+	case RegistryVersion_1_1:
+		return "v1.1"
+	case RegistryVersion_1_2:
+		return "v1.2"
+	default:
+		return fmt.Sprintf("RegistryVersion(%d)", int32(rv))
+	}
+}
+
+// ParseRegistryVersion returns the version of a registry from its typeAndVersion
+func ParseRegistryVersion(typeAndVersion string) (RegistryVersion, error) {
+	switch {
+	case strings.HasPrefix(typeAndVersion, "KeeperRegistry 1.1"):
+		return RegistryVersion_1_1, nil
+	case strings.HasPrefix(typeAndVersion, "KeeperRegistry 1.2"):
+		return RegistryVersion_1_2, nil
+	default:
+		return 0, errors.Errorf("unsupported registry %q", typeAndVersion)
+	}
+}
+
+// RegistryConfig is the config of a registry used by keepers
+type RegistryConfig struct {
+	BlockCountPerTurn int32
+	CheckGas          int32
+	KeeperAddresses   []common.Address
+}
+
+// UpkeepConfig is the config of an upkeep used by keepers
+type UpkeepConfig struct {
+	ExecuteGas uint32
+	CheckData  []byte
+}
+
+// RegistryWrapper calls a KeeperRegistry of any supported version. The
+// version is read from the registry's typeAndVersion on first use, and read
+// again on the next use if that fails, so that a registry which cannot be
+// reached when its job starts does not fail the job.
+type RegistryWrapper struct {
+	Address     ethkey.EIP55Address
+	contract1_1 *keeper_registry_wrapper.KeeperRegistry
+	contract1_2 *keeper_registry_wrapper1_2.KeeperRegistry
+
+	versionMu sync.Mutex
+	version   *RegistryVersion
+}
+
+// NewRegistryWrapper returns a RegistryWrapper for the registry at address
+func NewRegistryWrapper(address ethkey.EIP55Address, backend bind.ContractBackend) (*RegistryWrapper, error) {
+	contract1_1, err := keeper_registry_wrapper.NewKeeperRegistry(address.Address(), backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create keeper registry 1.1 contract wrapper")
+	}
+	contract1_2, err := keeper_registry_wrapper1_2.NewKeeperRegistry(address.Address(), backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create keeper registry 1.2 contract wrapper")
+	}
+	return &RegistryWrapper{
+		Address:     address,
+		contract1_1: contract1_1,
+		contract1_2: contract1_2,
+	}, nil
+}
+
+// Version returns the version of the registry, reading it from its
+// typeAndVersion if it is not known yet
+func (rw *RegistryWrapper) Version() (RegistryVersion, error) {
+	rw.versionMu.Lock()
+	defer rw.versionMu.Unlock()
+	if rw.version != nil {
+		return *rw.version, nil
+	}
+	typeAndVersion, err := rw.contract1_1.TypeAndVersion(nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get registry type and version")
+	}
+	version, err := ParseRegistryVersion(typeAndVersion)
+	if err != nil {
+		return 0, err
+	}
+	rw.version = &version
+	return version, nil
+}
+
+// GetConfig returns the config and keepers of the registry
+func (rw *RegistryWrapper) GetConfig(opts *bind.CallOpts) (RegistryConfig, error) {
+	version, err := rw.Version()
+	if err != nil {
+		return RegistryConfig{}, err
+	}
+	switch version {
+	case RegistryVersion_1_1:
+		config, err := rw.contract1_1.GetConfig(opts)
+		if err != nil {
+			return RegistryConfig{}, errors.Wrap(err, "failed to get contract config")
+		}
+		keeperAddresses, err := rw.contract1_1.GetKeeperList(opts)
+		if err != nil {
+			return RegistryConfig{}, errors.Wrap(err, "failed to get keeper list")
+		}
+		return RegistryConfig{
+			BlockCountPerTurn: int32(config.BlockCountPerTurn.Int64()),
+			CheckGas:          int32(config.CheckGasLimit),
+			KeeperAddresses:   keeperAddresses,
+		}, nil
+	case RegistryVersion_1_2:
+		state, err := rw.contract1_2.GetState(opts)
+		if err != nil {
+			return RegistryConfig{}, errors.Wrap(err, "failed to get contract state")
+		}
+		return RegistryConfig{
+			BlockCountPerTurn: int32(state.Config.BlockCountPerTurn.Int64()),
+			CheckGas:          int32(state.Config.CheckGasLimit),
+			KeeperAddresses:   state.Keepers,
+		}, nil
+	default:
+		return RegistryConfig{}, errors.Errorf("unsupported registry version %s", version)
+	}
+}
+
+// GetActiveUpkeepIDs returns the IDs of the upkeeps of the registry which are
+// not canceled
+func (rw *RegistryWrapper) GetActiveUpkeepIDs(opts *bind.CallOpts) ([]*big.Int, error) {
+	version, err := rw.Version()
+	if err != nil {
+		return nil, err
+	}
+	switch version {
+	case RegistryVersion_1_1:
+		// upkeep IDs are sequential, and canceled upkeeps are kept
+		count, err := rw.contract1_1.GetUpkeepCount(opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get upkeep count")
+		}
+		canceledList, err := rw.contract1_1.GetCanceledUpkeepList(opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get canceled upkeep list")
+		}
+		canceled := make(map[int64]bool)
+		for _, id := range canceledList {
+			canceled[id.Int64()] = true
+		}
+		var ids []*big.Int
+		for id := int64(0); id < count.Int64(); id++ {
+			if !canceled[id] {
+				ids = append(ids, big.NewInt(id))
+			}
+		}
+		return ids, nil
+	case RegistryVersion_1_2:
+		// a max count of 0 returns all the active upkeeps
+		ids, err := rw.contract1_2.GetActiveUpkeepIDs(opts, big.NewInt(0), big.NewInt(0))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get active upkeep IDs")
+		}
+		return ids, nil
+	default:
+		return nil, errors.Errorf("unsupported registry version %s", version)
+	}
+}
+
+// GetUpkeep returns the config of the upkeep with ID id
+func (rw *RegistryWrapper) GetUpkeep(opts *bind.CallOpts, id *big.Int) (UpkeepConfig, error) {
+	version, err := rw.Version()
+	if err != nil {
+		return UpkeepConfig{}, err
+	}
+	switch version {
+	case RegistryVersion_1_1:
+		upkeep, err := rw.contract1_1.GetUpkeep(opts, id)
+		if err != nil {
+			return UpkeepConfig{}, errors.Wrap(err, "failed to get upkeep config")
+		}
+		return UpkeepConfig{ExecuteGas: upkeep.ExecuteGas, CheckData: upkeep.CheckData}, nil
+	case RegistryVersion_1_2:
+		upkeep, err := rw.contract1_2.GetUpkeep(opts, id)
+		if err != nil {
+			return UpkeepConfig{}, errors.Wrap(err, "failed to get upkeep config")
+		}
+		return UpkeepConfig{ExecuteGas: upkeep.ExecuteGas, CheckData: upkeep.CheckData}, nil
+	default:
+		return UpkeepConfig{}, errors.Errorf("unsupported registry version %s", version)
+	}
+}
+
+// SupportsBatchedChecks returns whether checkUpkeep can be called through
+// multicall. KeeperRegistry 1.1 requires checkUpkeep to be called by the zero
+// address, whereas later versions only require the zero address as tx.origin.
+func (rw *RegistryWrapper) SupportsBatchedChecks() (bool, error) {
+	version, err := rw.Version()
+	if err != nil {
+		return false, err
+	}
+	return version != RegistryVersion_1_1, nil
+}
+
+// ParseLog parses a log of the registry. Events which differ between versions
+// have different topics, so logs are parsed without knowing the version.
+func (rw *RegistryWrapper) ParseLog(lg types.Log) (generated.AbigenLog, error) {
+	if len(lg.Topics) > 0 {
+		switch lg.Topics[0] {
+		case keeper_registry_wrapper1_2.KeeperRegistryConfigSet{}.Topic(),
+			keeper_registry_wrapper1_2.KeeperRegistryUpkeepGasLimitSet{}.Topic(),
+			keeper_registry_wrapper1_2.KeeperRegistryUpkeepMigrated{}.Topic(),
+			keeper_registry_wrapper1_2.KeeperRegistryUpkeepReceived{}.Topic():
+			return rw.contract1_2.ParseLog(lg)
+		}
+	}
+	// The other events keepers listen to are the same in all versions
+	return rw.contract1_1.ParseLog(lg)
+}
+
+// logTopics returns the topics of the logs of the registry which keepers
+// listen to, with upkeepPerformedFilter as the filter of UpkeepPerformed logs.
+// Events of other versions are never emitted by the registry, so they are
+// listened to regardless of its version.
+func (rw *RegistryWrapper) logTopics(upkeepPerformedFilter [][]log.Topic) map[common.Hash][][]log.Topic {
+	return map[common.Hash][][]log.Topic{
+		keeper_registry_wrapper.KeeperRegistryKeepersUpdated{}.Topic():       nil,
+		keeper_registry_wrapper.KeeperRegistryUpkeepCanceled{}.Topic():       nil,
+		keeper_registry_wrapper.KeeperRegistryUpkeepRegistered{}.Topic():     nil,
+		keeper_registry_wrapper.KeeperRegistryUpkeepPerformed{}.Topic():      upkeepPerformedFilter,
+		keeper_registry_wrapper.KeeperRegistryConfigSet{}.Topic():            nil,
+		keeper_registry_wrapper1_2.KeeperRegistryConfigSet{}.Topic():         nil,
+		keeper_registry_wrapper1_2.KeeperRegistryUpkeepGasLimitSet{}.Topic(): nil,
+		keeper_registry_wrapper1_2.KeeperRegistryUpkeepMigrated{}.Topic():    nil,
+		keeper_registry_wrapper1_2.KeeperRegistryUpkeepReceived{}.Topic():    nil,
+	}
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper1_2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+)
+
+func TestParseRegistryVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		typeAndVersion string
+		want           keeper.RegistryVersion
+		wantErr        bool
+	}{
+		{"KeeperRegistry 1.1.0", keeper.RegistryVersion_1_1, false},
+		{"KeeperRegistry 1.2.0", keeper.RegistryVersion_1_2, false},
+		{"KeeperRegistry 2.0.0", 0, true},
+		{"VRFCoordinatorV2 1.0.0", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.typeAndVersion, func(t *testing.T) {
+			version, err := keeper.ParseRegistryVersion(tt.typeAndVersion)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, version)
+		})
+	}
+}
+
+func TestRegistryWrapper_Version(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	address := cltest.NewEIP55Address()
+	registryWrapper, err := keeper.NewRegistryWrapper(address, ethClient)
+	require.NoError(t, err)
+
+	// the version is read on first use, and read again if that failed
+	registryMock := cltest.NewContractMockReceiver(t, ethClient, keeper.RegistryABI, address.Address())
+	registryMock.MockRevertResponse("typeAndVersion").Once()
+	_, err = registryWrapper.Version()
+	require.Error(t, err)
+
+	registryMock.MockResponse("typeAndVersion", "KeeperRegistry 1.2.0").Once()
+	version, err := registryWrapper.Version()
+	require.NoError(t, err)
+	assert.Equal(t, keeper.RegistryVersion_1_2, version)
+
+	// and is then cached
+	version, err = registryWrapper.Version()
+	require.NoError(t, err)
+	assert.Equal(t, keeper.RegistryVersion_1_2, version)
+	ethClient.AssertExpectations(t)
+}
+
+func TestRegistryWrapper_1_2(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	address := cltest.NewEIP55Address()
+	registryWrapper := newRegistryWrapper(t, ethClient, address, "KeeperRegistry 1.2.0")
+	batched, err := registryWrapper.SupportsBatchedChecks()
+	require.NoError(t, err)
+	assert.True(t, batched)
+
+	registryMock := cltest.NewContractMockReceiver(t, ethClient, keeper.Registry1_2ABI, address.Address())
+
+	t.Run("GetConfig", func(t *testing.T) {
+		keeperAddress := testutils.NewAddress()
+		config := keeper_registry_wrapper1_2.Config{
+			PaymentPremiumPPB: 100,
+			BlockCountPerTurn: big.NewInt(20),
+			CheckGasLimit:     2_000_000,
+			StalenessSeconds:  big.NewInt(3600),
+			MinUpkeepSpend:    big.NewInt(0),
+			FallbackGasPrice:  big.NewInt(1000000),
+			FallbackLinkPrice: big.NewInt(1000000),
+		}
+		state := keeper_registry_wrapper1_2.State{
+			OwnerLinkBalance:    big.NewInt(0),
+			ExpectedLinkBalance: big.NewInt(0),
+			NumUpkeeps:          big.NewInt(2),
+		}
+		registryMock.MockResponse("getState", keeper_registry_wrapper1_2.GetState{
+			State:   state,
+			Config:  config,
+			Keepers: []common.Address{keeperAddress},
+		}).Once()
+
+		registryConfig, err := registryWrapper.GetConfig(nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(20), registryConfig.BlockCountPerTurn)
+		assert.Equal(t, int32(2_000_000), registryConfig.CheckGas)
+		assert.Equal(t, []common.Address{keeperAddress}, registryConfig.KeeperAddresses)
+	})
+
+	t.Run("GetActiveUpkeepIDs", func(t *testing.T) {
+		ids := []*big.Int{new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(42)}
+		registryMock.MockResponse("getActiveUpkeepIDs", ids).Once()
+
+		activeIDs, err := registryWrapper.GetActiveUpkeepIDs(nil)
+		require.NoError(t, err)
+		assert.Equal(t, ids, activeIDs)
+	})
+
+	t.Run("ParseLog", func(t *testing.T) {
+		id := new(big.Int).Lsh(big.NewInt(1), 200)
+		event := keeper.Registry1_2ABI.Events["UpkeepMigrated"]
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(100), testutils.NewAddress())
+		require.NoError(t, err)
+		lg := types.Log{
+			Address: address.Address(),
+			Topics:  []common.Hash{event.ID, common.BigToHash(id)},
+			Data:    data,
+		}
+
+		parsed, err := registryWrapper.ParseLog(lg)
+		require.NoError(t, err)
+		migrated, ok := parsed.(*keeper_registry_wrapper1_2.KeeperRegistryUpkeepMigrated)
+		require.True(t, ok)
+		assert.Equal(t, id, migrated.Id)
+		assert.Equal(t, lg, migrated.Raw)
+
+		// events unchanged from 1.1 are parsed with the 1.1 wrapper
+		registered := keeper_registry_wrapper.KeeperRegistryUpkeepRegistered{}
+		lg.Topics = []common.Hash{registered.Topic(), common.BigToHash(id)}
+		lg.Data, err = keeper.RegistryABI.Events["UpkeepRegistered"].Inputs.NonIndexed().Pack(uint32(2_000_000), testutils.NewAddress())
+		require.NoError(t, err)
+
+		parsed, err = registryWrapper.ParseLog(lg)
+		require.NoError(t, err)
+		upkeepRegistered, ok := parsed.(*keeper_registry_wrapper.KeeperRegistryUpkeepRegistered)
+		require.True(t, ok)
+		assert.Equal(t, id, upkeepRegistered.Id)
+	})
+}
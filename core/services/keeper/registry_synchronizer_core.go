@@ -5,10 +5,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
-
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
-	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -30,7 +27,7 @@ type MailRoom struct {
 
 type RegistrySynchronizerOptions struct {
 	Job                      job.Job
-	Contract                 *keeper_registry_wrapper.KeeperRegistry
+	RegistryWrapper          *RegistryWrapper
 	ORM                      ORM
 	JRM                      job.ORM
 	LogBroadcaster           log.Broadcaster
@@ -44,7 +41,7 @@ type RegistrySynchronizerOptions struct {
 type RegistrySynchronizer struct {
 	chStop                   chan struct{}
 	newTurnEnabled           bool
-	contract                 *keeper_registry_wrapper.KeeperRegistry
+	registryWrapper          *RegistryWrapper
 	interval                 time.Duration
 	job                      job.Job
 	jrm                      job.ORM
@@ -68,7 +65,7 @@ func NewRegistrySynchronizer(opts RegistrySynchronizerOptions) *RegistrySynchron
 	}
 	return &RegistrySynchronizer{
 		chStop:                   make(chan struct{}),
-		contract:                 opts.Contract,
+		registryWrapper:          opts.RegistryWrapper,
 		interval:                 opts.SyncInterval,
 		job:                      opts.Job,
 		jrm:                      opts.JRM,
@@ -88,40 +85,22 @@ func (rs *RegistrySynchronizer) Start(context.Context) error {
 		rs.wgDone.Add(2)
 		go rs.run()
 
-		var logListenerOpts log.ListenerOpts
-		if rs.newTurnEnabled {
-			logListenerOpts = log.ListenerOpts{
-				Contract: rs.contract.Address(),
-				ParseLog: rs.contract.ParseLog,
-				LogsWithTopics: map[common.Hash][][]log.Topic{
-					keeper_registry_wrapper.KeeperRegistryKeepersUpdated{}.Topic():   nil,
-					keeper_registry_wrapper.KeeperRegistryConfigSet{}.Topic():        nil,
-					keeper_registry_wrapper.KeeperRegistryUpkeepCanceled{}.Topic():   nil,
-					keeper_registry_wrapper.KeeperRegistryUpkeepRegistered{}.Topic(): nil,
-					keeper_registry_wrapper.KeeperRegistryUpkeepPerformed{}.Topic():  nil,
-				},
-				MinIncomingConfirmations: rs.minIncomingConfirmations,
-			}
-		} else {
-			logListenerOpts = log.ListenerOpts{
-				Contract: rs.contract.Address(),
-				ParseLog: rs.contract.ParseLog,
-				LogsWithTopics: map[common.Hash][][]log.Topic{
-					keeper_registry_wrapper.KeeperRegistryKeepersUpdated{}.Topic():   nil,
-					keeper_registry_wrapper.KeeperRegistryConfigSet{}.Topic():        nil,
-					keeper_registry_wrapper.KeeperRegistryUpkeepCanceled{}.Topic():   nil,
-					keeper_registry_wrapper.KeeperRegistryUpkeepRegistered{}.Topic(): nil,
-					keeper_registry_wrapper.KeeperRegistryUpkeepPerformed{}.Topic(): {
-						{},
-						{},
-						{
-							log.Topic(rs.job.KeeperSpec.FromAddress.Hash()),
-						},
-					},
+		var upkeepPerformedFilter [][]log.Topic
+		if !rs.newTurnEnabled {
+			upkeepPerformedFilter = [][]log.Topic{
+				{},
+				{},
+				{
+					log.Topic(rs.job.KeeperSpec.FromAddress.Hash()),
 				},
-				MinIncomingConfirmations: rs.minIncomingConfirmations,
 			}
 		}
+		logListenerOpts := log.ListenerOpts{
+			Contract:                 rs.registryWrapper.Address.Address(),
+			ParseLog:                 rs.registryWrapper.ParseLog,
+			LogsWithTopics:           rs.registryWrapper.logTopics(upkeepPerformedFilter),
+			MinIncomingConfirmations: rs.minIncomingConfirmations,
+		}
 
 		lbUnsubscribe := rs.logBroadcaster.Register(rs, logListenerOpts)
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper1_2"
 )
 
 func (rs *RegistrySynchronizer) JobID() int32 {
@@ -31,14 +32,14 @@ func (rs *RegistrySynchronizer) HandleLog(broadcast log.Broadcast) {
 	case *keeper_registry_wrapper.KeeperRegistryKeepersUpdated:
 		wasOverCapacity = rs.mailRoom.mbSyncRegistry.Deliver(broadcast) // same mailbox because same action
 		mailboxName = "mbSyncRegistry"
-	case *keeper_registry_wrapper.KeeperRegistryConfigSet:
+	case *keeper_registry_wrapper.KeeperRegistryConfigSet, *keeper_registry_wrapper1_2.KeeperRegistryConfigSet:
 		wasOverCapacity = rs.mailRoom.mbSyncRegistry.Deliver(broadcast) // same mailbox because same action
 		mailboxName = "mbSyncRegistry"
-	case *keeper_registry_wrapper.KeeperRegistryUpkeepCanceled:
-		wasOverCapacity = rs.mailRoom.mbUpkeepCanceled.Deliver(broadcast)
+	case *keeper_registry_wrapper.KeeperRegistryUpkeepCanceled, *keeper_registry_wrapper1_2.KeeperRegistryUpkeepMigrated:
+		wasOverCapacity = rs.mailRoom.mbUpkeepCanceled.Deliver(broadcast) // same mailbox because same action
 		mailboxName = "mbUpkeepCanceled"
-	case *keeper_registry_wrapper.KeeperRegistryUpkeepRegistered:
-		wasOverCapacity = rs.mailRoom.mbUpkeepRegistered.Deliver(broadcast)
+	case *keeper_registry_wrapper.KeeperRegistryUpkeepRegistered, *keeper_registry_wrapper1_2.KeeperRegistryUpkeepReceived, *keeper_registry_wrapper1_2.KeeperRegistryUpkeepGasLimitSet:
+		wasOverCapacity = rs.mailRoom.mbUpkeepRegistered.Deliver(broadcast) // same mailbox because same action
 		mailboxName = "mbUpkeepRegistered"
 	case *keeper_registry_wrapper.KeeperRegistryUpkeepPerformed:
 		wasOverCapacity = rs.mailRoom.mbUpkeepPerformed.Deliver(broadcast)
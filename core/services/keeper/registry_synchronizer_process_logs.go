@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper1_2"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func (rs *RegistrySynchronizer) processLogs() {
@@ -67,12 +70,17 @@ func (rs *RegistrySynchronizer) handleUpkeepCancelled(broadcast log.Broadcast) {
 	if was {
 		return
 	}
-	broadcastedLog, ok := broadcast.DecodedLog().(*keeper_registry_wrapper.KeeperRegistryUpkeepCanceled)
-	if !ok {
-		rs.logger.AssumptionViolationf("expected UpkeepCanceled log but got %T", broadcastedLog)
+	var upkeepID *big.Int
+	switch broadcastedLog := broadcast.DecodedLog().(type) {
+	case *keeper_registry_wrapper.KeeperRegistryUpkeepCanceled:
+		upkeepID = broadcastedLog.Id
+	case *keeper_registry_wrapper1_2.KeeperRegistryUpkeepMigrated:
+		upkeepID = broadcastedLog.Id
+	default:
+		rs.logger.AssumptionViolationf("expected UpkeepCanceled or UpkeepMigrated log but got %T", broadcastedLog)
 		return
 	}
-	affected, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, []int64{broadcastedLog.Id.Int64()})
+	affected, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, []utils.Big{*utils.NewBig(upkeepID)})
 	if err != nil {
 		rs.logger.With("error", err).Error("unable to batch delete upkeeps")
 		return
@@ -111,12 +119,21 @@ func (rs *RegistrySynchronizer) HandleUpkeepRegistered(broadcast log.Broadcast,
 	if was {
 		return
 	}
-	broadcastedLog, ok := broadcast.DecodedLog().(*keeper_registry_wrapper.KeeperRegistryUpkeepRegistered)
-	if !ok {
-		rs.logger.AssumptionViolationf("expected UpkeepRegistered log but got %T", broadcastedLog)
+	// Upkeeps which are received from another registry or whose gas limit
+	// changes are synced like new upkeeps
+	var upkeepID *big.Int
+	switch broadcastedLog := broadcast.DecodedLog().(type) {
+	case *keeper_registry_wrapper.KeeperRegistryUpkeepRegistered:
+		upkeepID = broadcastedLog.Id
+	case *keeper_registry_wrapper1_2.KeeperRegistryUpkeepReceived:
+		upkeepID = broadcastedLog.Id
+	case *keeper_registry_wrapper1_2.KeeperRegistryUpkeepGasLimitSet:
+		upkeepID = broadcastedLog.Id
+	default:
+		rs.logger.AssumptionViolationf("expected UpkeepRegistered, UpkeepReceived or UpkeepGasLimitSet log but got %T", broadcastedLog)
 		return
 	}
-	err = rs.syncUpkeep(registry, broadcastedLog.Id.Int64())
+	err = rs.syncUpkeep(registry, utils.NewBig(upkeepID))
 	if err != nil {
 		rs.logger.With("error", err).Error("failed to sync upkeep, log: %v", broadcast.String())
 		return
@@ -155,17 +172,27 @@ func (rs *RegistrySynchronizer) handleUpkeepPerformed(broadcast log.Broadcast) {
 		rs.logger.AssumptionViolationf("expected UpkeepPerformed log but got %T", log)
 		return
 	}
-	err = rs.orm.SetLastRunInfoForUpkeepOnJob(rs.job.ID, log.Id.Int64(), int64(broadcast.RawLog().BlockNumber), ethkey.EIP55AddressFromAddress(log.From))
+	err = rs.orm.SetLastRunInfoForUpkeepOnJob(rs.job.ID, utils.NewBig(log.Id), int64(broadcast.RawLog().BlockNumber), ethkey.EIP55AddressFromAddress(log.From))
 	if err != nil {
 		rs.logger.With("error", err).Error("failed to set last run to 0")
 		return
 	}
 	rs.logger.Debugw("updated db for UpkeepPerformed log",
 		"jobID", rs.job.ID,
-		"upkeepID", log.Id.Int64(),
+		"upkeepID", log.Id.String(),
 		"blockNumber", int64(broadcast.RawLog().BlockNumber),
 		"fromAddr", ethkey.EIP55AddressFromAddress(log.From))
 
+	// Only the performs of this node are counted in the stats of the upkeep
+	if log.From == rs.job.KeeperSpec.FromAddress.Address() {
+		gasUsed, err := rs.orm.RecordUpkeepPerformed(rs.job.ID, utils.NewBig(log.Id), log.Success, log.Payment, broadcast.RawLog().TxHash)
+		if err != nil {
+			rs.logger.With("error", err).Error("failed to record upkeep perform")
+		} else {
+			incUpkeepPerformed(rs.job.KeeperSpec.ContractAddress.Hex(), log.Success, gasUsed, log.Payment)
+		}
+	}
+
 	if err := rs.logBroadcaster.MarkConsumed(broadcast); err != nil {
 		rs.logger.With("error", err).With("log", broadcast.String()).Error("unable to mark KeeperRegistryUpkeepPerformed log as consumed")
 	}
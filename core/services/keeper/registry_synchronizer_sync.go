@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"math"
 	"math/big"
 	"sync"
 
@@ -20,12 +21,8 @@ func (rs *RegistrySynchronizer) fullSync() {
 		rs.logger.With("error", err).Error("failed to sync registry during fullSyncing registry")
 		return
 	}
-	if err := rs.addNewUpkeeps(registry); err != nil {
-		rs.logger.With("error", err).Error("failed to add new upkeeps during fullSyncing registry")
-		return
-	}
-	if err := rs.deleteCanceledUpkeeps(); err != nil {
-		rs.logger.With("error", err).Error("failed to delete canceled upkeeps during fullSyncing registry")
+	if err := rs.fullSyncUpkeeps(registry); err != nil {
+		rs.logger.With("error", err).Error("failed to sync upkeeps during fullSyncing registry")
 		return
 	}
 }
@@ -43,59 +40,78 @@ func (rs *RegistrySynchronizer) syncRegistry() (Registry, error) {
 	return registry, nil
 }
 
-func (rs *RegistrySynchronizer) addNewUpkeeps(reg Registry) error {
-	nextUpkeepID, err := rs.orm.LowestUnsyncedID(reg.ID)
+// fullSyncUpkeeps adds the active upkeeps of the registry which are not in the
+// DB yet, and deletes the upkeeps in the DB which are no longer active
+func (rs *RegistrySynchronizer) fullSyncUpkeeps(reg Registry) error {
+	activeUpkeepIDs, err := rs.registryWrapper.GetActiveUpkeepIDs(nil)
 	if err != nil {
-		return errors.Wrap(err, "unable to find next ID for registry")
+		return errors.Wrap(err, "unable to get active upkeep IDs")
 	}
-
-	countOnContractBig, err := rs.contract.GetUpkeepCount(nil)
+	existingUpkeepIDs, err := rs.orm.AllUpkeepIDsForRegistry(reg.ID)
 	if err != nil {
-		return errors.Wrapf(err, "unable to get upkeep count")
+		return errors.Wrap(err, "unable to get existing upkeep IDs")
 	}
-	countOnContract := countOnContractBig.Int64()
 
-	if nextUpkeepID > countOnContract {
-		return errors.New("invariant, contract should always have at least as many upkeeps as DB")
+	activeSet := make(map[string]bool, len(activeUpkeepIDs))
+	for _, upkeepID := range activeUpkeepIDs {
+		activeSet[upkeepID.String()] = true
+	}
+	existingSet := make(map[string]bool, len(existingUpkeepIDs))
+	var canceled []utils.Big
+	for _, upkeepID := range existingUpkeepIDs {
+		existingSet[upkeepID.String()] = true
+		if !activeSet[upkeepID.String()] {
+			canceled = append(canceled, upkeepID)
+		}
+	}
+	var newUpkeepIDs []*utils.Big
+	for _, upkeepID := range activeUpkeepIDs {
+		if !existingSet[upkeepID.String()] {
+			newUpkeepIDs = append(newUpkeepIDs, utils.NewBig(upkeepID))
+		}
 	}
 
-	rs.batchSyncUpkeepsOnRegistry(reg, nextUpkeepID, countOnContract)
+	rs.batchSyncUpkeepsOnRegistry(reg, newUpkeepIDs)
+
+	if _, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, canceled); err != nil {
+		return errors.Wrap(err, "failed to batch delete upkeeps from job")
+	}
 	return nil
 }
 
 // batchSyncUpkeepsOnRegistry syncs <syncUpkeepQueueSize> upkeeps at a time in parallel
-// starting at upkeep ID <start> and up to (but not including) <end>
-func (rs *RegistrySynchronizer) batchSyncUpkeepsOnRegistry(reg Registry, start, end int64) {
+// for all the IDs within newUpkeepIDs
+func (rs *RegistrySynchronizer) batchSyncUpkeepsOnRegistry(reg Registry, newUpkeepIDs []*utils.Big) {
 	wg := sync.WaitGroup{}
-	wg.Add(int(end - start))
+	wg.Add(len(newUpkeepIDs))
 	chSyncUpkeepQueue := make(chan struct{}, rs.syncUpkeepQueueSize)
 
 	done := func() { <-chSyncUpkeepQueue; wg.Done() }
-	for upkeepID := start; upkeepID < end; upkeepID++ {
+	for i := range newUpkeepIDs {
 		select {
 		case <-rs.chStop:
 			return
 		case chSyncUpkeepQueue <- struct{}{}:
-			go rs.syncUpkeepWithCallback(reg, upkeepID, done)
+			go rs.syncUpkeepWithCallback(reg, newUpkeepIDs[i], done)
 		}
 	}
 
 	wg.Wait()
 }
 
-func (rs *RegistrySynchronizer) syncUpkeepWithCallback(registry Registry, upkeepID int64, doneCallback func()) {
+func (rs *RegistrySynchronizer) syncUpkeepWithCallback(registry Registry, upkeepID *utils.Big, doneCallback func()) {
 	defer doneCallback()
 
 	if err := rs.syncUpkeep(registry, upkeepID); err != nil {
 		rs.logger.With("error", err).With(
-			"upkeepID", upkeepID,
+			"upkeepID", upkeepID.String(),
 			"registryContract", registry.ContractAddress.Hex(),
 		).Error("unable to sync upkeep on registry")
 	}
 }
 
-func (rs *RegistrySynchronizer) syncUpkeep(registry Registry, upkeepID int64) error {
-	upkeepConfig, err := rs.contract.GetUpkeep(nil, big.NewInt(upkeepID))
+func (rs *RegistrySynchronizer) syncUpkeep(registry Registry, upkeepID *utils.Big) error {
+	upkeepConfig, err := rs.registryWrapper.GetUpkeep(nil, upkeepID.ToInt())
 	if err != nil {
 		return errors.Wrap(err, "failed to get upkeep config")
 	}
@@ -117,38 +133,18 @@ func (rs *RegistrySynchronizer) syncUpkeep(registry Registry, upkeepID int64) er
 	return nil
 }
 
-func (rs *RegistrySynchronizer) deleteCanceledUpkeeps() error {
-	canceledBigs, err := rs.contract.GetCanceledUpkeepList(nil)
-	if err != nil {
-		return errors.Wrap(err, "failed to get canceled upkeep list")
-	}
-	canceled := make([]int64, len(canceledBigs))
-	for idx, upkeepID := range canceledBigs {
-		canceled[idx] = upkeepID.Int64()
-	}
-	if _, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, canceled); err != nil {
-		return errors.Wrap(err, "failed to batch delete upkeeps from job")
-	}
-
-	return nil
-}
-
 // newRegistryFromChain returns a Registry struct with fields synched from those on chain
 func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 	fromAddress := rs.job.KeeperSpec.FromAddress
 	contractAddress := rs.job.KeeperSpec.ContractAddress
-	config, err := rs.contract.GetConfig(nil)
+	registryConfig, err := rs.registryWrapper.GetConfig(nil)
 	if err != nil {
 		rs.jrm.TryRecordError(rs.job.ID, err.Error())
 		return Registry{}, errors.Wrap(err, "failed to get contract config")
 	}
-	keeperAddresses, err := rs.contract.GetKeeperList(nil)
-	if err != nil {
-		return Registry{}, errors.Wrap(err, "failed to get keeper list")
-	}
 	keeperIndex := int32(-1)
 	keeperMap := map[ethkey.EIP55Address]int32{}
-	for idx, address := range keeperAddresses {
+	for idx, address := range registryConfig.KeeperAddresses {
 		keeperMap[ethkey.EIP55AddressFromAddress(address)] = int32(idx)
 		if address == fromAddress.Address() {
 			keeperIndex = int32(idx)
@@ -159,22 +155,24 @@ func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 	}
 
 	return Registry{
-		BlockCountPerTurn: int32(config.BlockCountPerTurn.Int64()),
-		CheckGas:          int32(config.CheckGasLimit),
+		BlockCountPerTurn: registryConfig.BlockCountPerTurn,
+		CheckGas:          registryConfig.CheckGas,
 		ContractAddress:   contractAddress,
 		FromAddress:       fromAddress,
 		JobID:             rs.job.ID,
 		KeeperIndex:       keeperIndex,
-		NumKeepers:        int32(len(keeperAddresses)),
+		NumKeepers:        int32(len(registryConfig.KeeperAddresses)),
 		KeeperIndexMap:    keeperMap,
 	}, nil
 }
 
 // CalcPositioningConstant calculates a positioning constant.
-// The positioning constant is fixed because upkeepID and registryAddress are immutable
-func CalcPositioningConstant(upkeepID int64, registryAddress ethkey.EIP55Address) (int32, error) {
+// The positioning constant is fixed because upkeepID and registryAddress are immutable.
+// Upkeep IDs which do not fit in an int64, as in KeeperRegistry 1.2, are reduced
+// modulo math.MaxInt64 first.
+func CalcPositioningConstant(upkeepID *utils.Big, registryAddress ethkey.EIP55Address) (int32, error) {
 	upkeepBytes := make([]byte, binary.MaxVarintLen64)
-	binary.PutVarint(upkeepBytes, upkeepID)
+	binary.PutVarint(upkeepBytes, new(big.Int).Mod(upkeepID.ToInt(), big.NewInt(math.MaxInt64)).Int64())
 	bytesToHash := utils.ConcatBytes(upkeepBytes, registryAddress.Bytes())
 	checksum, err := utils.Keccak256(bytesToHash)
 	if err != nil {
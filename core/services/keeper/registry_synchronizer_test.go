@@ -25,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 const syncInterval = 1000 * time.Hour // prevents sync timer from triggering during test
@@ -69,10 +70,9 @@ func setupRegistrySync(t *testing.T) (
 	keyStore := cltest.NewKeyStore(t, db, cfg)
 	jpv2 := cltest.NewJobPipelineV2(t, cfg, cc, db, keyStore)
 	contractAddress := j.KeeperSpec.ContractAddress.Address()
-	contract, err := keeper_registry_wrapper.NewKeeperRegistry(
-		contractAddress,
-		ethClient,
-	)
+	registryMock := cltest.NewContractMockReceiver(t, ethClient, keeper.RegistryABI, contractAddress)
+	registryMock.MockResponse("typeAndVersion", "KeeperRegistry 1.1.0").Once()
+	registryWrapper, err := keeper.NewRegistryWrapper(j.KeeperSpec.ContractAddress, ethClient)
 	require.NoError(t, err)
 
 	lbMock.On("Register", mock.Anything, mock.MatchedBy(func(opts log.ListenerOpts) bool {
@@ -83,7 +83,7 @@ func setupRegistrySync(t *testing.T) (
 	orm := keeper.NewORM(db, logger.TestLogger(t), ch.Config(), txmgr.SendEveryStrategy{})
 	synchronizer := keeper.NewRegistrySynchronizer(keeper.RegistrySynchronizerOptions{
 		Job:                      j,
-		Contract:                 contract,
+		RegistryWrapper:          registryWrapper,
 		ORM:                      orm,
 		JRM:                      jpv2.Jrm,
 		LogBroadcaster:           lbMock,
@@ -130,9 +130,12 @@ func Test_RegistrySynchronizer_Start(t *testing.T) {
 func Test_RegistrySynchronizer_CalcPositioningConstant(t *testing.T) {
 	t.Parallel()
 	for _, upkeepID := range []int64{0, 1, 100, 10_000} {
-		_, err := keeper.CalcPositioningConstant(upkeepID, cltest.NewEIP55Address())
+		_, err := keeper.CalcPositioningConstant(utils.NewBigI(upkeepID), cltest.NewEIP55Address())
 		require.NoError(t, err)
 	}
+	// upkeep IDs of KeeperRegistry 1.2 are 256 bit hashes
+	_, err := keeper.CalcPositioningConstant(utils.NewBig(utils.NewHash().Big()), cltest.NewEIP55Address())
+	require.NoError(t, err)
 }
 
 func Test_RegistrySynchronizer_FullSync(t *testing.T) {
@@ -147,7 +150,7 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
-	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(3) // sync all 3, then delete
+	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(2) // sync the 2 active upkeeps
 
 	synchronizer.ExportedFullSync()
 
@@ -175,7 +178,7 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(5)).Once()
-	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(2) // two new upkeeps to sync
+	registryMock.MockResponse("getUpkeep", upkeepConfig).Once() // one new upkeep to sync, 0 to delete
 
 	synchronizer.ExportedFullSync()
 
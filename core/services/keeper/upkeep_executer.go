@@ -4,14 +4,15 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strconv"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/atomic"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
@@ -25,16 +26,16 @@ import (
 	bigmath "github.com/smartcontractkit/chainlink/core/utils/big_math"
 )
 
-const (
-	executionQueueSize = 10
-)
-
 // UpkeepExecuter fulfills Service and HeadTrackable interfaces
 var (
 	_ job.ServiceCtx        = (*UpkeepExecuter)(nil)
 	_ httypes.HeadTrackable = (*UpkeepExecuter)(nil)
 )
 
+// upkeepChecksFlushInterval is how often the checks of upkeeps counted by the
+// executer are written to the database
+const upkeepChecksFlushInterval = 30 * time.Second
+
 var (
 	promCheckUpkeepExecutionTime = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "keeper_check_upkeep_execution_time",
@@ -56,8 +57,16 @@ type UpkeepExecuter struct {
 	mailbox         *utils.Mailbox[*evmtypes.Head]
 	orm             ORM
 	pr              pipeline.Runner
+	registryWrapper *RegistryWrapper
 	logger          logger.Logger
 	wgDone          sync.WaitGroup
+	// noMulticall is set once Multicall3 is found not to be deployed on the
+	// chain, after which upkeeps are no longer batch checked
+	noMulticall atomic.Bool
+	// checks are counted in memory and flushed periodically, rather than
+	// written on every head
+	checksMu sync.Mutex
+	checks   map[int32]UpkeepCheckCounts
 	utils.StartStopOnce
 }
 
@@ -68,10 +77,16 @@ func NewUpkeepExecuter(
 	pr pipeline.Runner,
 	ethClient evmclient.Client,
 	headBroadcaster httypes.HeadBroadcaster,
+	registryWrapper *RegistryWrapper,
 	gasEstimator gas.Estimator,
 	logger logger.Logger,
 	config Config,
 ) *UpkeepExecuter {
+	executionQueueSize := config.KeeperExecutionQueueSize()
+	if executionQueueSize == 0 {
+		// at least one upkeep has to be executed at a time
+		executionQueueSize = 1
+	}
 	return &UpkeepExecuter{
 		chStop:          make(chan struct{}),
 		ethClient:       ethClient,
@@ -83,7 +98,9 @@ func NewUpkeepExecuter(
 		config:          config,
 		orm:             orm,
 		pr:              pr,
+		registryWrapper: registryWrapper,
 		logger:          logger.Named("UpkeepExecuter"),
+		checks:          make(map[int32]UpkeepCheckCounts),
 	}
 }
 
@@ -121,16 +138,61 @@ func (ex *UpkeepExecuter) OnNewLongestChain(_ context.Context, head *evmtypes.He
 
 func (ex *UpkeepExecuter) run() {
 	defer ex.wgDone.Done()
+	ticker := time.NewTicker(upkeepChecksFlushInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ex.chStop:
+			// the executer is stopping, so the checks are flushed without
+			// its context
+			ex.flushChecks()
 			return
+		case <-ticker.C:
+			ctx, cancel := utils.ContextFromChan(ex.chStop)
+			ex.flushChecks(pg.WithParentCtx(ctx))
+			cancel()
 		case <-ex.mailbox.Notify():
 			ex.processActiveUpkeeps()
 		}
 	}
 }
 
+// countChecks counts a check of each of the upkeep registrations with the
+// given IDs, after which they were performed if eligible is true
+func (ex *UpkeepExecuter) countChecks(registrationIDs []int32, eligible bool) {
+	ex.checksMu.Lock()
+	defer ex.checksMu.Unlock()
+	for _, id := range registrationIDs {
+		counts := ex.checks[id]
+		counts.Checks++
+		if eligible {
+			counts.Eligible++
+		}
+		ex.checks[id] = counts
+	}
+}
+
+// flushChecks records the checks counted since the last flush. If that fails,
+// they are kept to be recorded by the next flush.
+func (ex *UpkeepExecuter) flushChecks(qopts ...pg.QOpt) {
+	ex.checksMu.Lock()
+	checks := ex.checks
+	ex.checks = make(map[int32]UpkeepCheckCounts)
+	ex.checksMu.Unlock()
+
+	if err := ex.orm.RecordUpkeepChecks(checks, qopts...); err != nil {
+		ex.logger.With("error", err).Error("failed to record upkeep checks")
+		ex.checksMu.Lock()
+		defer ex.checksMu.Unlock()
+		for id, counts := range checks {
+			c := ex.checks[id]
+			c.Checks += counts.Checks
+			c.Eligible += counts.Eligible
+			ex.checks[id] = c
+		}
+	}
+}
+
 func (ex *UpkeepExecuter) processActiveUpkeeps() {
 	// Keepers could miss their turn in the turn taking algo if they are too overloaded
 	// with work because processActiveUpkeeps() blocks
@@ -150,7 +212,7 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 
 	var activeUpkeeps []UpkeepRegistration
 	if ex.config.KeeperTurnFlagEnabled() {
-		turnBinary, err2 := ex.turnBlockHashBinary(registry, head, ex.config.KeeperTurnLookBack())
+		_, turnBinary, err2 := turnBlockHashBinary(context.Background(), ex.ethClient, registry, head.Number, ex.config.KeeperTurnLookBack())
 		if err2 != nil {
 			ex.logger.With("error", err2).Error("unable to get turn block number hash")
 			return
@@ -176,6 +238,15 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 		}
 	}
 
+	if ex.config.KeeperCheckUpkeepBatchSize() > 0 && !ex.noMulticall.Load() {
+		batched, err2 := ex.registryWrapper.SupportsBatchedChecks()
+		if err2 != nil {
+			ex.logger.With("error", err2).Warn("unable to get registry version, checking upkeeps one by one")
+		} else if batched {
+			activeUpkeeps = ex.batchCheckUpkeeps(head, activeUpkeeps)
+		}
+	}
+
 	wg := sync.WaitGroup{}
 	wg.Add(len(activeUpkeeps))
 	done := func() {
@@ -190,12 +261,118 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 	wg.Wait()
 }
 
+// batchCheckUpkeeps checks the upkeeps through multicall, KeeperCheckUpkeepBatchSize
+// at a time, and returns those which need to be performed. Their pipeline runs
+// check them again before performing them. If a batch cannot be checked, for
+// example because multicall is not deployed on the chain, all of its upkeeps
+// are returned to be checked by their pipeline runs. Once multicall is found
+// not to be deployed, upkeeps are no longer batch checked.
+func (ex *UpkeepExecuter) batchCheckUpkeeps(head *evmtypes.Head, upkeeps []UpkeepRegistration) []UpkeepRegistration {
+	ctx, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
+	defer cancel()
+
+	var performable []UpkeepRegistration
+	var notNeeded []int32
+	for start := 0; start < len(upkeeps); {
+		batch := ex.nextCheckUpkeepBatch(upkeeps[start:])
+		results, err := ex.checkUpkeepBatch(ctx, head, batch)
+		if errors.Is(err, errNoMulticall) {
+			ex.logger.With("multicallAddress", MulticallAddress.Hex()).Warn("Multicall3 is not deployed on this chain, upkeeps will be checked one by one")
+			ex.noMulticall.Store(true)
+			performable = append(performable, upkeeps[start:]...)
+			break
+		}
+		start += len(batch)
+		if err != nil {
+			ex.logger.With("error", err, "blockNum", head.Number).Warn("unable to batch check upkeeps, checking them one by one")
+			performable = append(performable, batch...)
+			continue
+		}
+		for i, result := range results {
+			switch {
+			case result.Success:
+				performable = append(performable, batch[i])
+			case isCheckUpkeepRevert(result.ReturnData):
+				ex.logger.Debugw("upkeep does not need to be performed", "blockNum", head.Number, "upkeepID", batch[i].UpkeepID.String())
+				notNeeded = append(notNeeded, batch[i].ID)
+			default:
+				// the call ran out of gas or failed for another reason, so the
+				// upkeep is checked again by its pipeline run
+				ex.logger.Debugw("unable to batch check upkeep, checking it by itself", "blockNum", head.Number, "upkeepID", batch[i].UpkeepID.String())
+				performable = append(performable, batch[i])
+			}
+		}
+	}
+	if len(notNeeded) > 0 {
+		// the other upkeeps are counted once their pipeline run completes
+		ex.countChecks(notNeeded, false)
+		incUpkeepChecks(upkeeps[0].Registry.ContractAddress.Hex(), len(notNeeded), false)
+	}
+	ex.logger.Debugw("batch checked upkeeps", "blockNum", head.Number, "checked", len(upkeeps), "performable", len(performable))
+	return performable
+}
+
+// nextCheckUpkeepBatch returns the first upkeeps to check in a single
+// multicall, as many as fit in its gas limit, up to
+// KeeperCheckUpkeepBatchSize. It always returns at least one upkeep.
+func (ex *UpkeepExecuter) nextCheckUpkeepBatch(upkeeps []UpkeepRegistration) []UpkeepRegistration {
+	maxSize := int(ex.config.KeeperCheckUpkeepBatchSize())
+	var gas uint64
+	for i, upkeep := range upkeeps {
+		gas += checkUpkeepGasLimit(ex.config, upkeep)
+		if i > 0 && (i == maxSize || multicallGasLimit(gas, i+1) > maxMulticallGas) {
+			return upkeeps[:i]
+		}
+	}
+	return upkeeps
+}
+
+// checkUpkeepBatch checks the upkeeps in a single multicall at the head, with
+// the gas limits and gas prices of their pipeline checks
+func (ex *UpkeepExecuter) checkUpkeepBatch(ctx context.Context, head *evmtypes.Head, upkeeps []UpkeepRegistration) ([]MulticallResult, error) {
+	var msg ethereum.CallMsg
+	var gas uint64
+	calls := make([]MulticallCall, len(upkeeps))
+	for i, upkeep := range upkeeps {
+		data, err := checkUpkeepCallData(upkeep.UpkeepID.ToInt(), upkeep.Registry.FromAddress.Address())
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to construct checkUpkeep data")
+		}
+		calls[i] = MulticallCall{
+			Target:       upkeep.Registry.ContractAddress.Address(),
+			AllowFailure: true,
+			CallData:     data,
+		}
+		gas += checkUpkeepGasLimit(ex.config, upkeep)
+
+		// the eth_call has a single set of gas prices, so the highest of the
+		// upkeeps is used
+		gasPrice, gasTipCap, gasFeeCap, err := checkUpkeepGasPrices(ex.config, ex.gasEstimator, upkeep, head)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to estimate gas price")
+		}
+		msg.GasPrice = maxBig(msg.GasPrice, gasPrice)
+		msg.GasTipCap = maxBig(msg.GasTipCap, gasTipCap)
+		msg.GasFeeCap = maxBig(msg.GasFeeCap, gasFeeCap)
+	}
+	msg.Gas = multicallGasLimit(gas, len(calls))
+	return multicall(ctx, ex.ethClient, msg, calls, big.NewInt(head.Number))
+}
+
+// maxBig returns the larger of a and b, where nil is smaller than any value
+func maxBig(a, b *big.Int) *big.Int {
+	if a == nil || (b != nil && b.Cmp(a) > 0) {
+		return b
+	}
+	return a
+}
+
 // execute triggers the pipeline run
 func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, done func()) {
 	defer done()
 
 	start := time.Now()
-	svcLogger := ex.logger.With("jobID", ex.job.ID, "blockNum", head.Number, "upkeepID", upkeep.UpkeepID)
+	svcLogger := ex.logger.With("jobID", ex.job.ID, "blockNum", head.Number, "upkeepID", upkeep.UpkeepID.String())
 	svcLogger.Debug("checking upkeep", "lastRunBlockHeight", upkeep.LastRunBlockHeight, "lastKeeperIndex", upkeep.LastKeeperIndex)
 
 	ctxService, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
@@ -206,24 +383,10 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		evmChainID = ex.job.KeeperSpec.EVMChainID.String()
 	}
 
-	var gasPrice, gasTipCap, gasFeeCap *big.Int
-	if ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
-		price, fee, err := ex.estimateGasPrice(upkeep)
-		if err != nil {
-			svcLogger.With("error", err).Error("estimating gas price")
-			return
-		}
-		gasPrice, gasTipCap, gasFeeCap = price, fee.TipCap, fee.FeeCap
-
-		// Make sure the gas price is at least as large as the basefee to avoid ErrFeeCapTooLow error from geth during eth call.
-		// If head.BaseFeePerGas, we assume it is a EIP-1559 chain.
-		// Note: gasPrice will be nil if EvmEIP1559DynamicFees is enabled.
-		if head.BaseFeePerGas != nil && head.BaseFeePerGas.ToInt().BitLen() > 0 {
-			baseFee := addBuffer(head.BaseFeePerGas.ToInt(), ex.config.KeeperBaseFeeBufferPercent())
-			if gasPrice == nil || gasPrice.Cmp(baseFee) < 0 {
-				gasPrice = baseFee
-			}
-		}
+	gasPrice, gasTipCap, gasFeeCap, err := checkUpkeepGasPrices(ex.config, ex.gasEstimator, upkeep, head)
+	if err != nil {
+		svcLogger.With("error", err).Error("estimating gas price")
+		return
 	}
 
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
@@ -231,14 +394,13 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 			"jobID":                 ex.job.ID,
 			"fromAddress":           upkeep.Registry.FromAddress.String(),
 			"contractAddress":       upkeep.Registry.ContractAddress.String(),
-			"upkeepID":              upkeep.UpkeepID,
-			"performUpkeepGasLimit": upkeep.ExecuteGas + ex.orm.config.KeeperRegistryPerformGasOverhead(),
-			"checkUpkeepGasLimit": ex.config.KeeperRegistryCheckGasOverhead() + uint64(upkeep.Registry.CheckGas) +
-				ex.config.KeeperRegistryPerformGasOverhead() + upkeep.ExecuteGas,
-			"gasPrice":   gasPrice,
-			"gasTipCap":  gasTipCap,
-			"gasFeeCap":  gasFeeCap,
-			"evmChainID": evmChainID,
+			"upkeepID":              upkeep.UpkeepID.String(),
+			"performUpkeepGasLimit": performUpkeepGasLimit(ex.orm.config, upkeep),
+			"checkUpkeepGasLimit":   checkUpkeepGasLimit(ex.config, upkeep),
+			"gasPrice":              gasPrice,
+			"gasTipCap":             gasTipCap,
+			"gasFeeCap":             gasFeeCap,
+			"evmChainID":            evmChainID,
 		},
 	})
 
@@ -248,6 +410,12 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		return
 	}
 
+	// The upkeep is performed if the run completes, otherwise checkUpkeep
+	// reverted or the perform transaction could not be created
+	eligible := run.State == pipeline.RunStatusCompleted
+	ex.countChecks([]int32{upkeep.ID}, eligible)
+	incUpkeepChecks(upkeep.Registry.ContractAddress.Hex(), 1, eligible)
+
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
 		err := ex.orm.SetLastRunInfoForUpkeepOnJob(ex.job.ID, upkeep.UpkeepID, head.Number, upkeep.Registry.FromAddress, pg.WithParentCtx(ctxService))
@@ -258,28 +426,65 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 
 		elapsed := time.Since(start)
 		promCheckUpkeepExecutionTime.
-			WithLabelValues(strconv.Itoa(int(upkeep.UpkeepID))).
+			WithLabelValues(upkeep.UpkeepID.String()).
 			Set(float64(elapsed))
 	}
 }
 
-func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
+// checkUpkeepGasPrices returns the gas price, or tip and fee caps, with which
+// checkUpkeep is called for the upkeep at head. They are all nil unless
+// KeeperCheckUpkeepGasPriceFeatureEnabled.
+func checkUpkeepGasPrices(config Config, estimator gas.Estimator, upkeep UpkeepRegistration, head *evmtypes.Head) (gasPrice, gasTipCap, gasFeeCap *big.Int, err error) {
+	if !config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
+		return nil, nil, nil, nil
+	}
+	price, fee, err := estimateGasPrice(config, estimator, upkeep)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	gasPrice, gasTipCap, gasFeeCap = price, fee.TipCap, fee.FeeCap
+
+	// Make sure the gas price is at least as large as the basefee to avoid ErrFeeCapTooLow error from geth during eth call.
+	// If head.BaseFeePerGas, we assume it is a EIP-1559 chain.
+	// Note: gasPrice will be nil if EvmEIP1559DynamicFees is enabled.
+	if head.BaseFeePerGas != nil && head.BaseFeePerGas.ToInt().BitLen() > 0 {
+		baseFee := addBuffer(head.BaseFeePerGas.ToInt(), config.KeeperBaseFeeBufferPercent())
+		if gasPrice == nil || gasPrice.Cmp(baseFee) < 0 {
+			gasPrice = baseFee
+		}
+	}
+	return gasPrice, gasTipCap, gasFeeCap, nil
+}
+
+// checkUpkeepGasLimit returns the gas limit of the checkUpkeep call of the upkeep
+func checkUpkeepGasLimit(config Config, upkeep UpkeepRegistration) uint64 {
+	return config.KeeperRegistryCheckGasOverhead() + uint64(upkeep.Registry.CheckGas) +
+		config.KeeperRegistryPerformGasOverhead() + upkeep.ExecuteGas
+}
+
+// performUpkeepGasLimit returns the gas limit of the performUpkeep transaction
+// of the upkeep
+func performUpkeepGasLimit(config Config, upkeep UpkeepRegistration) uint64 {
+	return upkeep.ExecuteGas + config.KeeperRegistryPerformGasOverhead()
+}
+
+func estimateGasPrice(config Config, estimator gas.Estimator, upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
 	var performTxData []byte
 	performTxData, err = RegistryABI.Pack(
 		"performUpkeep",
-		big.NewInt(upkeep.UpkeepID),
+		upkeep.UpkeepID.ToInt(),
 		common.Hex2Bytes("1234"), // placeholder
 	)
 	if err != nil {
 		return nil, fee, errors.Wrap(err, "unable to construct performUpkeep data")
 	}
 
-	if ex.config.EvmEIP1559DynamicFees() {
-		fee, _, err = ex.gasEstimator.GetDynamicFee(upkeep.ExecuteGas)
-		fee.TipCap = addBuffer(fee.TipCap, ex.config.KeeperGasTipCapBufferPercent())
+	if config.EvmEIP1559DynamicFees() {
+		fee, _, err = estimator.GetDynamicFee(upkeep.ExecuteGas)
+		fee.TipCap = addBuffer(fee.TipCap, config.KeeperGasTipCapBufferPercent())
 	} else {
-		gasPrice, _, err = ex.gasEstimator.GetLegacyGas(performTxData, upkeep.ExecuteGas)
-		gasPrice = addBuffer(gasPrice, ex.config.KeeperGasPriceBufferPercent())
+		gasPrice, _, err = estimator.GetLegacyGas(performTxData, upkeep.ExecuteGas)
+		gasPrice = addBuffer(gasPrice, config.KeeperGasPriceBufferPercent())
 	}
 	if err != nil {
 		return nil, fee, errors.Wrap(err, "unable to estimate gas")
@@ -295,13 +500,16 @@ func addBuffer(val *big.Int, prct uint32) *big.Int {
 	)
 }
 
-func (ex *UpkeepExecuter) turnBlockHashBinary(registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
-	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
-	block, err := ex.ethClient.BlockByNumber(context.Background(), big.NewInt(turnBlock))
+// turnBlockHashBinary returns the hash of the block at which the turn of
+// blockNumber starts, lookback blocks earlier, and its binary representation,
+// from which the turns of the upkeeps are computed
+func turnBlockHashBinary(ctx context.Context, client evmclient.Client, registry Registry, blockNumber, lookback int64) (common.Hash, string, error) {
+	turnBlock := blockNumber - (blockNumber % int64(registry.BlockCountPerTurn)) - lookback
+	block, err := client.BlockByNumber(ctx, big.NewInt(turnBlock))
 	if err != nil {
-		return "", err
+		return common.Hash{}, "", err
 	}
 	hashAtHeight := block.Hash()
 	binaryString := fmt.Sprintf("%b", hashAtHeight.Big())
-	return binaryString, nil
+	return hashAtHeight, binaryString, nil
 }
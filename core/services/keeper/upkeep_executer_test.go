@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +36,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	bigmath "github.com/smartcontractkit/chainlink/core/utils/big_math"
 )
@@ -42,6 +45,15 @@ func newHead() evmtypes.Head {
 	return evmtypes.NewHead(big.NewInt(20), utils.NewHash(), utils.NewHash(), 1000, utils.NewBigI(0))
 }
 
+func newRegistryWrapper(t *testing.T, ethClient *evmmocks.Client, address ethkey.EIP55Address, typeAndVersion string) *keeper.RegistryWrapper {
+	registryMock := cltest.NewContractMockReceiver(t, ethClient, keeper.RegistryABI, address.Address())
+	// the version is read when the executer first checks upkeeps
+	registryMock.MockResponse("typeAndVersion", typeAndVersion).Maybe()
+	registryWrapper, err := keeper.NewRegistryWrapper(address, ethClient)
+	require.NoError(t, err)
+	return registryWrapper
+}
+
 func setup(t *testing.T) (
 	*sqlx.DB,
 	*configtest.TestGeneralConfig,
@@ -84,7 +96,8 @@ func setup(t *testing.T) (
 	orm := keeper.NewORM(db, logger.TestLogger(t), ch.Config(), txmgr.SendEveryStrategy{})
 	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth(), 0, 1, 20)
 	lggr := logger.TestLogger(t)
-	executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), ch.TxManager().GetGasEstimator(), lggr, ch.Config())
+	registryWrapper := newRegistryWrapper(t, ethClient, registry.ContractAddress, "KeeperRegistry 1.1.0")
+	executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), registryWrapper, ch.TxManager().GetGasEstimator(), lggr, ch.Config())
 	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, ch.Config(), registry)
 	err := executer.Start(testutils.Context(t))
 	t.Cleanup(func() { txm.AssertExpectations(t); estimator.AssertExpectations(t); executer.Close() })
@@ -246,12 +259,13 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 	})
 
 	t.Run("errors if submission chain not found", func(t *testing.T) {
-		db, _, ethMock, _, _, _, job, jpv2, _, _, ch, orm := setup(t)
+		db, _, ethMock, _, registry, _, job, jpv2, _, _, ch, orm := setup(t)
 
 		// change chain ID to non-configured chain
 		job.KeeperSpec.EVMChainID = (*utils.Big)(big.NewInt(999))
 		lggr := logger.TestLogger(t)
-		executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), ch.TxManager().GetGasEstimator(), lggr, ch.Config())
+		registryWrapper := newRegistryWrapper(t, ethMock, registry.ContractAddress, "KeeperRegistry 1.1.0")
+		executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), registryWrapper, ch.TxManager().GetGasEstimator(), lggr, ch.Config())
 		err := executer.Start(testutils.Context(t))
 		require.NoError(t, err)
 		head := newHead()
@@ -337,3 +351,168 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	cltest.AssertCountStays(t, db, "eth_txes", 0)
 	ethMock.AssertExpectations(t)
 }
+
+func Test_UpkeepExecuter_BatchedChecks(t *testing.T) {
+	t.Parallel()
+
+	newBatchingExecuter := func(t *testing.T) (*sqlx.DB, *configtest.TestGeneralConfig, *evmmocks.Client, *keeper.UpkeepExecuter, keeper.Registry, keeper.UpkeepRegistration, job.Job, cltest.JobPipelineV2TestHelper, *txmmocks.TxManager) {
+		db, config, ethMock, _, registry, upkeep, job, jpv2, txm, _, ch, orm := setup(t)
+		// The executer started by setup is for a KeeperRegistry 1.1, which
+		// does not support batched checks, so a second one is used for 1.2.
+		// Its wrapper only reads the version, which is mocked at another
+		// address so as not to match the typeAndVersion mock of setup.
+		registryWrapper := newRegistryWrapper(t, ethMock, cltest.NewEIP55Address(), "KeeperRegistry 1.2.0")
+		executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), registryWrapper, ch.TxManager().GetGasEstimator(), logger.TestLogger(t), ch.Config())
+		require.NoError(t, executer.Start(testutils.Context(t)))
+		t.Cleanup(func() { executer.Close() })
+		return db, config, ethMock, executer, registry, upkeep, job, jpv2, txm
+	}
+
+	t.Run("does not run upkeeps which do not need performing", func(t *testing.T) {
+		db, _, ethMock, executer, _, upkeep, job, _, _ := newBatchingExecuter(t)
+
+		wasCalled := atomic.NewBool(false)
+		upkeepNotNeeded := crypto.Keccak256([]byte("UpkeepNotNeeded()"))[:4]
+		multicallMock := cltest.NewContractMockReceiver(t, ethMock, keeper.MulticallABI, keeper.MulticallAddress)
+		multicallMock.MockResponse("aggregate3", []keeper.MulticallResult{{Success: false, ReturnData: upkeepNotNeeded}}).Run(func(args mock.Arguments) {
+			// the upkeeps are checked at the head, with enough gas for their checks
+			msg := args.Get(1).(ethereum.CallMsg)
+			assert.Greater(t, msg.Gas, upkeep.ExecuteGas)
+			assert.Equal(t, big.NewInt(20), args.Get(2).(*big.Int))
+			wasCalled.Store(true)
+		})
+
+		head := newHead()
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+
+		gomega.NewWithT(t).Eventually(wasCalled.Load).Should(gomega.Equal(true))
+		cltest.AssertPipelineRunsStays(t, job.PipelineSpecID, db, 0)
+		ethMock.AssertExpectations(t)
+
+		// the check is counted in memory, and recorded when the executer closes
+		require.NoError(t, executer.Close())
+		var checks, eligible int64
+		require.NoError(t, db.QueryRow(`SELECT checks_count, eligible_count FROM upkeep_registrations WHERE id = $1`, upkeep.ID).Scan(&checks, &eligible))
+		assert.Equal(t, int64(1), checks)
+		assert.Equal(t, int64(0), eligible)
+	})
+
+	t.Run("runs upkeeps which need performing", func(t *testing.T) {
+		db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := newBatchingExecuter(t)
+
+		multicallMock := cltest.NewContractMockReceiver(t, ethMock, keeper.MulticallABI, keeper.MulticallAddress)
+		multicallMock.MockResponse("aggregate3", []keeper.MulticallResult{{Success: true}})
+
+		gasLimit := upkeep.ExecuteGas + config.KeeperRegistryPerformGasOverhead()
+		ethTxCreated := cltest.NewAwaiter()
+		txm.On("CreateEthTransaction",
+			mock.MatchedBy(func(newTx txmgr.NewTx) bool { return newTx.GasLimit == gasLimit }),
+		).
+			Once().
+			Return(txmgr.EthTx{
+				ID: 1,
+			}, nil).
+			Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+
+		head := newHead()
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+		ethTxCreated.AwaitOrFail(t)
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 1)
+		assert.False(t, runs[0].HasErrors())
+		waitLastRunHeight(t, db, upkeep, 20)
+	})
+
+	t.Run("checks upkeeps one by one if their check fails without reverting", func(t *testing.T) {
+		db, _, ethMock, executer, registry, _, _, _, _ := newBatchingExecuter(t)
+
+		// a call which runs out of gas fails without return data
+		multicallMock := cltest.NewContractMockReceiver(t, ethMock, keeper.MulticallABI, keeper.MulticallAddress)
+		multicallMock.MockResponse("aggregate3", []keeper.MulticallResult{{Success: false}})
+
+		wasCalled := atomic.NewBool(false)
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockRevertResponse("checkUpkeep").Run(func(args mock.Arguments) {
+			wasCalled.Store(true)
+		})
+
+		head := newHead()
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+
+		gomega.NewWithT(t).Eventually(wasCalled.Load).Should(gomega.Equal(true))
+		cltest.AssertCountStays(t, db, "eth_txes", 0)
+		ethMock.AssertExpectations(t)
+	})
+
+	t.Run("falls back to checking upkeeps one by one if multicall fails", func(t *testing.T) {
+		db, _, ethMock, executer, registry, _, _, _, _ := newBatchingExecuter(t)
+
+		multicallMock := cltest.NewContractMockReceiver(t, ethMock, keeper.MulticallABI, keeper.MulticallAddress)
+		multicallMock.MockRevertResponse("aggregate3")
+
+		wasCalled := atomic.NewBool(false)
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockRevertResponse("checkUpkeep").Run(func(args mock.Arguments) {
+			wasCalled.Store(true)
+		})
+
+		head := newHead()
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+
+		gomega.NewWithT(t).Eventually(wasCalled.Load).Should(gomega.Equal(true))
+		cltest.AssertCountStays(t, db, "eth_txes", 0)
+		ethMock.AssertExpectations(t)
+	})
+	t.Run("stops batch checking upkeeps if multicall is not deployed", func(t *testing.T) {
+		_, _, ethMock, executer, registry, _, _, _, _ := newBatchingExecuter(t)
+
+		// calls to an address without code succeed without returning data
+		multicallMock := cltest.NewContractMockReceiver(t, ethMock, keeper.MulticallABI, keeper.MulticallAddress)
+		multicallMock.MockResponse("aggregate3").Once()
+
+		checks := atomic.NewInt32(0)
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockRevertResponse("checkUpkeep").Run(func(args mock.Arguments) {
+			checks.Inc()
+		})
+
+		g := gomega.NewWithT(t)
+		head := newHead()
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+		g.Eventually(checks.Load).Should(gomega.Equal(int32(1)))
+
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+		g.Eventually(checks.Load).Should(gomega.Equal(int32(2)))
+		ethMock.AssertExpectations(t)
+	})
+}
+
+func Test_IsCheckUpkeepRevert(t *testing.T) {
+	t.Parallel()
+
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	revertReason, err := abi.Arguments{{Type: stringType}}.Pack("upkeep not needed")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		returnData []byte
+		revert     bool
+	}{
+		{"out of gas", nil, false},
+		{"unknown error", []byte{1, 2, 3, 4}, false},
+		{"revert reason", append(common.FromHex("0x08c379a0"), revertReason...), true},
+		{"UpkeepNotNeeded", crypto.Keccak256([]byte("UpkeepNotNeeded()"))[:4], true},
+		{"TargetCheckReverted", crypto.Keccak256([]byte("TargetCheckReverted(bytes)"))[:4], true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.revert, keeper.ExportedIsCheckUpkeepRevert(test.returnData))
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// UpkeepSimulation is the outcome, as of a block, of the turn taking and the
+// checks with which a keeper job decides whether to perform an upkeep
+type UpkeepSimulation struct {
+	JobID           int32
+	UpkeepID        *utils.Big
+	RegistryAddress ethkey.EIP55Address
+	BlockNumber     int64
+
+	// Turn taking
+	TurnFlagEnabled     bool
+	KeeperIndex         int32
+	NumKeepers          int32
+	BlockCountPerTurn   int32
+	PositioningConstant int32
+	TurnBlockNumber     int64
+	TurnLookBack        int64
+	TurnBlockHash       *common.Hash
+	TurnKeeperIndex     null.Int64
+	LastRunBlockHeight  int64
+	LastKeeperIndex     null.Int64
+	GracePeriod         int64
+	Eligible            bool
+	EligibilityReason   string
+
+	// Gas prices of the checkUpkeep call, which are also those of the
+	// performUpkeep transaction
+	GasPrice           *big.Int
+	GasTipCap          *big.Int
+	GasFeeCap          *big.Int
+	GasEstimationError string
+
+	// checkUpkeep
+	CheckUpkeepGasLimit     uint64
+	CheckUpkeepSuccess      bool
+	CheckUpkeepError        string
+	CheckUpkeepRevertReason string
+	PerformData             []byte
+	MaxLinkPayment          *big.Int
+
+	// performUpkeep
+	PerformUpkeepGasLimit         uint64
+	PerformUpkeepGasEstimate      *uint64
+	PerformUpkeepGasEstimateError string
+
+	// Sending key
+	FromAddress        ethkey.EIP55Address
+	FromAddressBalance *assets.Eth
+	FromAddressError   string
+}
+
+// SimulatorKeyStore is the part of the ETH keystore used by UpkeepSimulator
+type SimulatorKeyStore interface {
+	SendingKeys(chainID *big.Int) ([]ethkey.KeyV2, error)
+}
+
+// UpkeepSimulator evaluates whether a keeper job would perform an upkeep,
+// the way UpkeepExecuter does, without running its pipeline
+type UpkeepSimulator struct {
+	job          job.Job
+	orm          ORM
+	ethClient    evmclient.Client
+	gasEstimator gas.Estimator
+	keyStore     SimulatorKeyStore
+	config       Config
+}
+
+// NewUpkeepSimulator is the constructor of UpkeepSimulator
+func NewUpkeepSimulator(
+	job job.Job,
+	orm ORM,
+	ethClient evmclient.Client,
+	gasEstimator gas.Estimator,
+	keyStore SimulatorKeyStore,
+	config Config,
+) *UpkeepSimulator {
+	return &UpkeepSimulator{
+		job:          job,
+		orm:          orm,
+		ethClient:    ethClient,
+		gasEstimator: gasEstimator,
+		keyStore:     keyStore,
+		config:       config,
+	}
+}
+
+// Simulate evaluates whether the job would perform the upkeep with ID upkeepID
+// at the block with number blockNumber, or at the latest block if it is nil.
+// Failures of the calls made to the chain for the upkeep are part of the
+// simulation, whereas failures to load the upkeep or the block are returned.
+func (s *UpkeepSimulator) Simulate(ctx context.Context, upkeepID *big.Int, blockNumber *big.Int) (sim UpkeepSimulation, err error) {
+	upkeep, err := s.orm.UpkeepForJob(s.job.ID, utils.NewBig(upkeepID))
+	if err != nil {
+		return sim, err
+	}
+	head, err := s.ethClient.HeadByNumber(ctx, blockNumber)
+	if err != nil {
+		return sim, errors.Wrap(err, "unable to get head")
+	}
+	if head == nil {
+		return sim, errors.Errorf("block %s not found", blockNumber)
+	}
+	registry := upkeep.Registry
+
+	sim = UpkeepSimulation{
+		JobID:                 s.job.ID,
+		UpkeepID:              upkeep.UpkeepID,
+		RegistryAddress:       registry.ContractAddress,
+		BlockNumber:           head.Number,
+		TurnFlagEnabled:       s.config.KeeperTurnFlagEnabled(),
+		KeeperIndex:           registry.KeeperIndex,
+		NumKeepers:            registry.NumKeepers,
+		BlockCountPerTurn:     registry.BlockCountPerTurn,
+		PositioningConstant:   upkeep.PositioningConstant,
+		LastRunBlockHeight:    upkeep.LastRunBlockHeight,
+		LastKeeperIndex:       upkeep.LastKeeperIndex,
+		GracePeriod:           s.config.KeeperMaximumGracePeriod(),
+		CheckUpkeepGasLimit:   checkUpkeepGasLimit(s.config, upkeep),
+		PerformUpkeepGasLimit: performUpkeepGasLimit(s.config, upkeep),
+		FromAddress:           registry.FromAddress,
+	}
+
+	if err = s.simulateTurn(ctx, &sim, registry, upkeep); err != nil {
+		return sim, err
+	}
+	s.simulateSendingKey(ctx, &sim)
+
+	sim.GasPrice, sim.GasTipCap, sim.GasFeeCap, err = checkUpkeepGasPrices(s.config, s.gasEstimator, upkeep, head)
+	if err != nil {
+		// the executer does not check the upkeep without gas prices
+		sim.GasEstimationError = err.Error()
+		return sim, nil
+	}
+	s.simulateCheckUpkeep(ctx, &sim, registry)
+	if sim.CheckUpkeepSuccess {
+		s.simulatePerformUpkeep(ctx, &sim, registry)
+	}
+	return sim, nil
+}
+
+// simulateTurn sets whether the upkeep is eligible to be performed by this
+// keeper at the block of sim, following NewEligibleUpkeepsForRegistry if the
+// turn flag is enabled, or EligibleUpkeepsForRegistry otherwise.
+func (s *UpkeepSimulator) simulateTurn(ctx context.Context, sim *UpkeepSimulation, registry Registry, upkeep UpkeepRegistration) error {
+	if registry.NumKeepers == 0 {
+		sim.EligibilityReason = "the registry has no keepers"
+		return nil
+	}
+	if registry.KeeperIndex < 0 {
+		sim.EligibilityReason = fmt.Sprintf("%s is not a keeper of the registry", registry.FromAddress)
+		return nil
+	}
+	if registry.BlockCountPerTurn <= 0 {
+		sim.EligibilityReason = "the registry has no block count per turn"
+		return nil
+	}
+	sim.TurnBlockNumber = sim.BlockNumber - (sim.BlockNumber % int64(registry.BlockCountPerTurn))
+
+	if !sim.TurnFlagEnabled {
+		turnIndex := (int64(upkeep.PositioningConstant) + sim.TurnBlockNumber/int64(registry.BlockCountPerTurn)) % int64(registry.NumKeepers)
+		sim.TurnKeeperIndex = null.Int64From(turnIndex)
+		switch {
+		case int64(registry.KeeperIndex) != turnIndex:
+			sim.EligibilityReason = fmt.Sprintf("it is the turn of keeper %d", turnIndex)
+		case upkeep.LastRunBlockHeight == 0:
+			sim.Eligible = true
+			sim.EligibilityReason = "it is this keeper's turn, and the upkeep was never performed"
+		case upkeep.LastRunBlockHeight+sim.GracePeriod >= sim.BlockNumber:
+			sim.EligibilityReason = fmt.Sprintf("the upkeep was performed at block %d, within the grace period of %d blocks", upkeep.LastRunBlockHeight, sim.GracePeriod)
+		case upkeep.LastRunBlockHeight >= sim.TurnBlockNumber:
+			sim.EligibilityReason = fmt.Sprintf("the upkeep was already performed in this turn, at block %d", upkeep.LastRunBlockHeight)
+		default:
+			sim.Eligible = true
+			sim.EligibilityReason = "it is this keeper's turn"
+		}
+		return nil
+	}
+
+	// the turn is computed from the hash of a block before the start of the turn
+	sim.TurnLookBack = s.config.KeeperTurnLookBack()
+	hash, turnBinary, err := turnBlockHashBinary(ctx, s.ethClient, registry, sim.BlockNumber, sim.TurnLookBack)
+	if err != nil {
+		return errors.Wrap(err, "unable to get turn block hash")
+	}
+	sim.TurnBlockHash = &hash
+	turnIndex, err := turnKeeperIndex(upkeep.UpkeepID.ToInt(), turnBinary, registry.NumKeepers)
+	if err != nil {
+		return err
+	}
+	sim.TurnKeeperIndex = null.Int64From(turnIndex)
+
+	keeperIndex := int64(registry.KeeperIndex)
+	buddyIndex := (keeperIndex + 1) % int64(registry.NumKeepers)
+	lastKeeperIndex := upkeep.LastKeeperIndex
+	switch {
+	case turnIndex == keeperIndex && (!lastKeeperIndex.Valid || lastKeeperIndex.Int64 != keeperIndex):
+		sim.Eligible = true
+		sim.EligibilityReason = "it is this keeper's turn, and this keeper did not perform the upkeep last"
+	case turnIndex == keeperIndex && upkeep.LastRunBlockHeight+sim.GracePeriod < sim.BlockNumber:
+		sim.Eligible = true
+		sim.EligibilityReason = fmt.Sprintf("it is this keeper's turn, and this keeper performed the upkeep last at block %d, before the grace period of %d blocks", upkeep.LastRunBlockHeight, sim.GracePeriod)
+	case turnIndex == keeperIndex:
+		sim.EligibilityReason = fmt.Sprintf("this keeper performed the upkeep last at block %d, within the grace period of %d blocks", upkeep.LastRunBlockHeight, sim.GracePeriod)
+	case turnIndex == buddyIndex && lastKeeperIndex.Valid && lastKeeperIndex.Int64 == buddyIndex:
+		sim.Eligible = true
+		sim.EligibilityReason = fmt.Sprintf("it is the turn of keeper %d, which cannot perform the upkeep since it performed it last", turnIndex)
+	default:
+		sim.EligibilityReason = fmt.Sprintf("it is the turn of keeper %d", turnIndex)
+	}
+	return nil
+}
+
+// turnKeeperIndex returns the index of the keeper whose turn it is to perform
+// the upkeep with ID upkeepID, the way NewEligibleUpkeepsForRegistry computes
+// it in SQL: the low 32 bits of the upkeep ID are XORed with the first 32 bits
+// of turnBinary, padded with zeros, modulo the number of keepers.
+func turnKeeperIndex(upkeepID *big.Int, turnBinary string, numKeepers int32) (int64, error) {
+	for len(turnBinary) < 32 {
+		turnBinary += "0"
+	}
+	turnBits, err := strconv.ParseUint(turnBinary[:32], 2, 32)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid turn block hash binary")
+	}
+	upkeepBits := new(big.Int).Mod(upkeepID, big.NewInt(1<<32)).Uint64()
+	return int64((upkeepBits ^ turnBits) % uint64(numKeepers)), nil
+}
+
+// simulateSendingKey sets whether the from address of the registry is a
+// sending key of the chain of the job, which the perform transaction is sent
+// from, and its balance
+func (s *UpkeepSimulator) simulateSendingKey(ctx context.Context, sim *UpkeepSimulation) {
+	chainID := s.job.KeeperSpec.EVMChainID.ToInt()
+	keys, err := s.keyStore.SendingKeys(chainID)
+	if err != nil {
+		sim.FromAddressError = err.Error()
+		return
+	}
+	found := false
+	for _, key := range keys {
+		if key.Address == sim.FromAddress {
+			found = true
+			break
+		}
+	}
+	if !found {
+		sim.FromAddressError = fmt.Sprintf("%s is not a sending key of chain %s", sim.FromAddress, chainID)
+		return
+	}
+	balance, err := s.ethClient.BalanceAt(ctx, sim.FromAddress.Address(), nil)
+	if err != nil {
+		sim.FromAddressError = errors.Wrap(err, "unable to get balance").Error()
+		return
+	}
+	sim.FromAddressBalance = (*assets.Eth)(balance)
+}
+
+// simulateCheckUpkeep calls checkUpkeep the way the check_upkeep_tx task of
+// the pipeline does, at the block of sim
+func (s *UpkeepSimulator) simulateCheckUpkeep(ctx context.Context, sim *UpkeepSimulation, registry Registry) {
+	data, err := checkUpkeepCallData(sim.UpkeepID.ToInt(), registry.FromAddress.Address())
+	if err != nil {
+		sim.CheckUpkeepError = errors.Wrap(err, "unable to construct checkUpkeep data").Error()
+		return
+	}
+	to := registry.ContractAddress.Address()
+	b, err := s.ethClient.CallContract(ctx, ethereum.CallMsg{
+		To:        &to,
+		Data:      data,
+		Gas:       sim.CheckUpkeepGasLimit,
+		GasPrice:  sim.GasPrice,
+		GasTipCap: sim.GasTipCap,
+		GasFeeCap: sim.GasFeeCap,
+	}, big.NewInt(sim.BlockNumber))
+	if err != nil {
+		sim.CheckUpkeepError = err.Error()
+		if reason, rerr := evmclient.ExtractRevertReasonFromRPCError(err); rerr == nil {
+			sim.CheckUpkeepRevertReason = reason
+		}
+		return
+	}
+	out, err := RegistryABI.Unpack("checkUpkeep", b)
+	if err != nil {
+		sim.CheckUpkeepError = errors.Wrap(err, "unable to unpack checkUpkeep result").Error()
+		return
+	}
+	sim.CheckUpkeepSuccess = true
+	sim.PerformData = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	sim.MaxLinkPayment = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+}
+
+// simulatePerformUpkeep estimates the gas used by the performUpkeep
+// transaction, sent from the from address of the registry
+func (s *UpkeepSimulator) simulatePerformUpkeep(ctx context.Context, sim *UpkeepSimulation, registry Registry) {
+	data, err := RegistryABI.Pack("performUpkeep", sim.UpkeepID.ToInt(), sim.PerformData)
+	if err != nil {
+		sim.PerformUpkeepGasEstimateError = errors.Wrap(err, "unable to construct performUpkeep data").Error()
+		return
+	}
+	to := registry.ContractAddress.Address()
+	estimate, err := s.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: registry.FromAddress.Address(),
+		To:   &to,
+		Data: data,
+		Gas:  sim.PerformUpkeepGasLimit,
+	})
+	if err != nil {
+		sim.PerformUpkeepGasEstimateError = err.Error()
+		return
+	}
+	sim.PerformUpkeepGasEstimate = &estimate
+}
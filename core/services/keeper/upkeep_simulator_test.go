@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"database/sql"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+)
+
+func Test_TurnKeeperIndex(t *testing.T) {
+	t.Parallel()
+
+	firstBit := "1" + strings.Repeat("0", 255)
+
+	tests := []struct {
+		name       string
+		upkeepID   *big.Int
+		turnBinary string
+		numKeepers int32
+		want       int64
+	}{
+		{"first 32 bits of the hash", big.NewInt(5), firstBit, 3, 1},
+		{"short hash is padded", big.NewInt(5), "11", 2, 1},
+		{"short hash is padded", big.NewInt(5), "11", 3, 2},
+		{"low 32 bits of the upkeep ID", new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 200), big.NewInt(5)), firstBit, 3, 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			index, err := keeper.ExportedTurnKeeperIndex(tt.upkeepID, tt.turnBinary, tt.numKeepers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, index)
+		})
+	}
+}
+
+func Test_UpkeepSimulator_Simulate(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.KeeperMaximumGracePeriod = null.IntFrom(0)
+	cfg.Overrides.KeeperTurnLookBack = null.IntFrom(0)
+	cfg.Overrides.KeeperTurnFlagEnabled = null.BoolFrom(true)
+	cfg.Overrides.KeeperCheckUpkeepGasPriceFeatureEnabled = null.BoolFrom(false)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	config := evmtest.NewChainScopedConfig(t, cfg)
+	orm := keeper.NewORM(db, logger.TestLogger(t), config, txmgr.SendEveryStrategy{})
+	registry, jb := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth(), 0, 2, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(25), nil)
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(20)})
+	ethClient.On("BlockByNumber", mock.Anything, big.NewInt(20)).Return(block, nil)
+	ethClient.On("BalanceAt", mock.Anything, registry.FromAddress.Address(), (*big.Int)(nil)).Return(big.NewInt(1e18), nil)
+	simulator := keeper.NewUpkeepSimulator(jb, orm, ethClient, nil, keyStore.Eth(), config)
+
+	turnIndex, err := keeper.ExportedTurnKeeperIndex(upkeep.UpkeepID.ToInt(), block.Hash().Big().Text(2), 2)
+	require.NoError(t, err)
+
+	t.Run("performs upkeep", func(t *testing.T) {
+		registryMock := cltest.NewContractMockReceiver(t, ethClient, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse).Once()
+		ethClient.On("EstimateGas", mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+			return call.From == registry.FromAddress.Address()
+		})).Return(uint64(100_000), nil).Once()
+
+		sim, err := simulator.Simulate(testutils.Context(t), upkeep.UpkeepID.ToInt(), nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(25), sim.BlockNumber)
+		assert.Equal(t, int64(20), sim.TurnBlockNumber)
+		require.NotNil(t, sim.TurnBlockHash)
+		assert.Equal(t, block.Hash(), *sim.TurnBlockHash)
+		assert.Equal(t, clnull.Int64From(turnIndex), sim.TurnKeeperIndex)
+		// keeper 0 is eligible on its own turn, or on the turn of keeper 1
+		// which cannot perform the upkeep twice in a row
+		assert.Equal(t, turnIndex == 0, sim.Eligible)
+		assert.NotEmpty(t, sim.EligibilityReason)
+
+		assert.True(t, sim.CheckUpkeepSuccess)
+		assert.Equal(t, common.Hex2Bytes("1234"), sim.PerformData)
+		require.NotNil(t, sim.PerformUpkeepGasEstimate)
+		assert.Equal(t, uint64(100_000), *sim.PerformUpkeepGasEstimate)
+
+		assert.Equal(t, registry.FromAddress, sim.FromAddress)
+		assert.Empty(t, sim.FromAddressError)
+		assert.Equal(t, "1000000000000000000", sim.FromAddressBalance.ToInt().String())
+	})
+
+	t.Run("checkUpkeep reverts", func(t *testing.T) {
+		registryMock := cltest.NewContractMockReceiver(t, ethClient, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockRevertResponse("checkUpkeep").Once()
+
+		sim, err := simulator.Simulate(testutils.Context(t), upkeep.UpkeepID.ToInt(), nil)
+		require.NoError(t, err)
+
+		assert.False(t, sim.CheckUpkeepSuccess)
+		assert.NotEmpty(t, sim.CheckUpkeepError)
+		assert.Nil(t, sim.PerformUpkeepGasEstimate)
+	})
+
+	t.Run("upkeep not found", func(t *testing.T) {
+		_, err := simulator.Simulate(testutils.Context(t), big.NewInt(1000), nil)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
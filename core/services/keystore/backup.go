@@ -0,0 +1,264 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// hdWalletField and hdWalletID report the HD wallet in a RestoreResult
+	hdWalletField = "EthHD"
+	hdWalletID    = "mnemonic"
+)
+
+const (
+	// BackupFormat identifies keyring backup files
+	BackupFormat = "chainlink-keyring-backup"
+	// BackupVersion is the version of the backup file layout written by Backup
+	BackupVersion = 1
+)
+
+// backupFile is the layout of a keyring backup. The header is in plain text,
+// and the keys are encrypted with the backup password.
+type backupFile struct {
+	Format    string                  `json:"format"`
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	SHA256    string                  `json:"sha256"` // of the decrypted payload
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+// backupPayload is the decrypted content of a backup
+type backupPayload struct {
+	Keys      rawKeyRing
+	EthStates []ethBackupState
+}
+
+// ethBackupState is the part of an ethkey.State which is restored with its key
+type ethBackupState struct {
+	Address    ethkey.EIP55Address
+	EVMChainID utils.Big
+	NextNonce  int64
+	IsFunding  bool
+	IsRemote   bool
+}
+
+// RestoreResult holds the IDs of the keys of each type which were restored
+// from a backup, and of those which were skipped because the keystore already
+// has them.
+type RestoreResult struct {
+	Restored map[string][]string
+	Skipped  map[string][]string
+}
+
+// ChangePassword re-encrypts the keyring with newPassword. The node must be
+// unlocked with newPassword from then on.
+func (km *keyManager) ChangePassword(oldPassword, newPassword string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return errors.New("old password does not match the keystore password")
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	ekr, err := km.keyRing.Encrypt(newPassword, km.scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	if err = km.orm.saveEncryptedKeyRing(&ekr); err != nil {
+		return errors.Wrap(err, "unable to save keyRing")
+	}
+	km.password = newPassword
+	km.logger.Info("Keystore password changed")
+	return nil
+}
+
+// Backup returns every key of the keyring, and the states of the eth keys,
+// encrypted with password.
+func (km *keyManager) Backup(password string) ([]byte, error) {
+	if password == "" {
+		return nil, errors.New("backup password must not be empty")
+	}
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	payload := backupPayload{Keys: km.keyRing.raw()}
+	for _, state := range km.keyStates.Eth {
+		payload.EthStates = append(payload.EthStates, ethBackupState{
+			Address:    state.Address,
+			EVMChainID: state.EVMChainID,
+			NextNonce:  state.NextNonce,
+			IsFunding:  state.IsFunding,
+			IsRemote:   state.IsRemote,
+		})
+	}
+	sort.Slice(payload.EthStates, func(i, j int) bool {
+		return payload.EthStates[i].Address.Hex() < payload.EthStates[j].Address.Hex()
+	})
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode keyring")
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(b, []byte(adulteratedPassword(password)), km.scryptParams.N, km.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt keyring")
+	}
+	sum := sha256.Sum256(b)
+	return json.Marshal(backupFile{
+		Format:    BackupFormat,
+		Version:   BackupVersion,
+		CreatedAt: time.Now(),
+		SHA256:    hex.EncodeToString(sum[:]),
+		Crypto:    cryptoJSON,
+	})
+}
+
+// Restore adds the keys of a backup made by Backup which the keystore does
+// not have yet, along with the states of eth keys. The keys are restored in
+// one transaction, so either all of them or none are.
+func (km *keyManager) Restore(backup []byte, password string) (result RestoreResult, err error) {
+	payload, err := decryptBackup(backup, password)
+	if err != nil {
+		return result, err
+	}
+	restored, err := payload.Keys.keys()
+	if err != nil {
+		return result, errors.Wrap(err, "invalid keys in backup")
+	}
+	states := make(map[string]ethBackupState, len(payload.EthStates))
+	for _, s := range payload.EthStates {
+		states[s.Address.Hex()] = s
+		if s.IsRemote {
+			restored.Eth[s.Address.Hex()] = ethkey.NewRemoteV2(s.Address.Address())
+		}
+	}
+	for id := range restored.Eth {
+		if _, ok := states[id]; !ok {
+			return result, errors.Errorf("backup is missing the state of eth key %s", id)
+		}
+	}
+
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return result, ErrLocked
+	}
+	result.Restored, result.Skipped = km.keyRing.merge(restored)
+	// the HD wallet of the backup is only restored if the keystore has none,
+	// the keys it derived are restored with the other eth keys
+	restoredHDWallet := restored.EthHD != nil && km.keyRing.EthHD == nil
+	if restoredHDWallet {
+		km.keyRing.EthHD = restored.EthHD
+		result.Restored[hdWalletField] = []string{hdWalletID}
+	} else if restored.EthHD != nil {
+		result.Skipped[hdWalletField] = []string{hdWalletID}
+	}
+
+	var addedStates []*ethkey.State
+	err = km.save(func(tx pg.Queryer) error {
+		for _, id := range result.Restored["Eth"] {
+			s := states[id]
+			state := ethkey.State{Address: s.Address, EVMChainID: s.EVMChainID, NextNonce: s.NextNonce, IsFunding: s.IsFunding, IsRemote: s.IsRemote}
+			if err2 := insertEthKeyState(tx, &state); err2 != nil {
+				return err2
+			}
+			addedStates = append(addedStates, &state)
+		}
+		return nil
+	})
+	if err != nil {
+		km.keyRing.remove(result.Restored)
+		if restoredHDWallet {
+			km.keyRing.EthHD = nil
+		}
+		return RestoreResult{}, errors.Wrap(err, "unable to restore keys")
+	}
+	for _, state := range addedStates {
+		km.keyStates.Eth[state.KeyID()] = state
+	}
+	return result, nil
+}
+
+func decryptBackup(backup []byte, password string) (payload backupPayload, err error) {
+	var f backupFile
+	if err = json.Unmarshal(backup, &f); err != nil {
+		return payload, errors.Wrap(err, "invalid backup file")
+	}
+	if f.Format != BackupFormat {
+		return payload, errors.Errorf("not a keyring backup: format is %q, expected %q", f.Format, BackupFormat)
+	}
+	if f.Version != BackupVersion {
+		return payload, errors.Errorf("unsupported backup version %d, expected %d", f.Version, BackupVersion)
+	}
+	b, err := gethkeystore.DecryptDataV3(f.Crypto, adulteratedPassword(password))
+	if err != nil {
+		return payload, errors.Wrap(err, "unable to decrypt backup")
+	}
+	sum := sha256.Sum256(b)
+	if hex.EncodeToString(sum[:]) != f.SHA256 {
+		return payload, errors.New("backup integrity check failed: checksum does not match")
+	}
+	if err = json.Unmarshal(b, &payload); err != nil {
+		return payload, errors.Wrap(err, "invalid backup payload")
+	}
+	return payload, nil
+}
+
+// merge adds the keys of other which kr does not have, and returns the IDs
+// of the added and skipped keys by type.
+func (kr keyRing) merge(other keyRing) (added, skipped map[string][]string) {
+	added, skipped = make(map[string][]string), make(map[string][]string)
+	dst, src := reflect.ValueOf(kr), reflect.ValueOf(other)
+	for i := 0; i < src.NumField(); i++ {
+		if src.Field(i).Kind() != reflect.Map {
+			continue
+		}
+		name := src.Type().Field(i).Name
+		dstMap := dst.Field(i)
+		iter := src.Field(i).MapRange()
+		for iter.Next() {
+			id := iter.Key().String()
+			if dstMap.MapIndex(iter.Key()).IsValid() {
+				skipped[name] = append(skipped[name], id)
+				continue
+			}
+			dstMap.SetMapIndex(iter.Key(), iter.Value())
+			added[name] = append(added[name], id)
+		}
+		sort.Strings(added[name])
+		sort.Strings(skipped[name])
+	}
+	return
+}
+
+// remove deletes the keys with the given IDs by type
+func (kr keyRing) remove(ids map[string][]string) {
+	v := reflect.ValueOf(kr)
+	for name, keyIDs := range ids {
+		keyMap := v.FieldByName(name)
+		if keyMap.Kind() != reflect.Map {
+			continue
+		}
+		for _, id := range keyIDs {
+			keyMap.SetMapIndex(reflect.ValueOf(id), reflect.Value{})
+		}
+	}
+}
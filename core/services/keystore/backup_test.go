@@ -0,0 +1,127 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestMaster_ChangePassword(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	csaKey, err := keyStore.CSA().Create()
+	require.NoError(t, err)
+
+	require.EqualError(t, keyStore.ChangePassword("wrong", "new password"), "old password does not match the keystore password")
+	require.NoError(t, keyStore.ChangePassword(cltest.Password, "new password"))
+
+	// the keyring is now only decrypted by the new password
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(cltest.Password))
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock("new password"))
+	_, err = keyStore.CSA().Get(csaKey.ID())
+	require.NoError(t, err)
+}
+
+func TestMaster_BackupRestore(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	csaKey, err := keyStore.CSA().Create()
+	require.NoError(t, err)
+	ethKey, err := keyStore.Eth().Create(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create()
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create()
+	require.NoError(t, err)
+	_, err = keyStore.Eth().CreateHDWallet("")
+	require.NoError(t, err)
+
+	backup, err := keyStore.Backup("backup password")
+	require.NoError(t, err)
+
+	var header map[string]interface{}
+	require.NoError(t, json.Unmarshal(backup, &header))
+	assert.Equal(t, keystore.BackupFormat, header["format"])
+	assert.Equal(t, float64(keystore.BackupVersion), header["version"])
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		_, err := keyStore.Restore(backup, "wrong")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to decrypt backup")
+	})
+
+	t.Run("rejects a modified backup", func(t *testing.T) {
+		var f map[string]interface{}
+		require.NoError(t, json.Unmarshal(backup, &f))
+		f["sha256"] = "00"
+		modified, err := json.Marshal(f)
+		require.NoError(t, err)
+		_, err = keyStore.Restore(modified, "backup password")
+		require.EqualError(t, err, "backup integrity check failed: checksum does not match")
+	})
+
+	t.Run("rejects other versions", func(t *testing.T) {
+		var f map[string]interface{}
+		require.NoError(t, json.Unmarshal(backup, &f))
+		f["version"] = 2
+		modified, err := json.Marshal(f)
+		require.NoError(t, err)
+		_, err = keyStore.Restore(modified, "backup password")
+		require.EqualError(t, err, "unsupported backup version 2, expected 1")
+	})
+
+	t.Run("skips existing keys", func(t *testing.T) {
+		result, err := keyStore.Restore(backup, "backup password")
+		require.NoError(t, err)
+		assert.Empty(t, result.Restored)
+		assert.Equal(t, []string{csaKey.ID()}, result.Skipped["CSA"])
+		assert.Equal(t, []string{ethKey.ID()}, result.Skipped["Eth"])
+		assert.Equal(t, []string{"mnemonic"}, result.Skipped["EthHD"])
+	})
+
+	t.Run("restores every key into an empty keystore", func(t *testing.T) {
+		db2 := pgtest.NewSqlxDB(t)
+		keyStore2 := keystore.ExposedNewMaster(t, db2, cfg)
+		require.NoError(t, keyStore2.Unlock("other password"))
+
+		result, err := keyStore2.Restore(backup, "backup password")
+		require.NoError(t, err)
+		assert.Empty(t, result.Skipped)
+		assert.Equal(t, []string{csaKey.ID()}, result.Restored["CSA"])
+		assert.Equal(t, []string{ethKey.ID()}, result.Restored["Eth"])
+		assert.Equal(t, []string{p2pKey.ID()}, result.Restored["P2P"])
+		assert.Equal(t, []string{vrfKey.ID()}, result.Restored["VRF"])
+		assert.Equal(t, []string{"mnemonic"}, result.Restored["EthHD"])
+
+		state, err := keyStore2.Eth().GetState(ethKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, *utils.NewBig(&cltest.FixtureChainID), state.EVMChainID)
+
+		// the restored keys are saved with the password of the keystore
+		keyStore2.ResetXXXTestOnly()
+		require.NoError(t, keyStore2.Unlock("other password"))
+		_, err = keyStore2.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore2.Eth().Get(ethKey.ID())
+		require.NoError(t, err)
+		_, enabled, err := keyStore2.Eth().HDWalletNextIndex()
+		require.NoError(t, err)
+		assert.True(t, enabled)
+	})
+}
@@ -22,6 +22,9 @@ type Eth interface {
 	Get(id string) (ethkey.KeyV2, error)
 	GetAll() ([]ethkey.KeyV2, error)
 	Create(chainID *big.Int) (ethkey.KeyV2, error)
+	CreateHDWallet(mnemonic string) (string, error)
+	HDWalletNextIndex() (nextIndex uint32, enabled bool, err error)
+	RecoverHDKeys(count uint32, chainID *big.Int) ([]ethkey.KeyV2, error)
 	Add(key ethkey.KeyV2, chainID *big.Int) error
 	Delete(id string) (ethkey.KeyV2, error)
 	Import(keyJSON []byte, password string, chainID *big.Int) (ethkey.KeyV2, error)
@@ -32,6 +35,9 @@ type Eth interface {
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 
+	AddRemote(address common.Address, chainID *big.Int) (ethkey.KeyV2, error)
+	SetRemoteSigner(signer RemoteSigner)
+
 	SendingKeys(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	FundingKeys() (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -44,10 +50,18 @@ type Eth interface {
 	GetV1KeysAsV2(f DefaultEVMChainIDFunc) ([]ethkey.KeyV2, []ethkey.State, error)
 }
 
+// RemoteSigner signs transactions for remote eth keys, whose private keys are
+// held by an external signer instead of the keystore
+type RemoteSigner interface {
+	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	Accounts() ([]common.Address, error)
+}
+
 type eth struct {
 	*keyManager
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	remoteSigner  RemoteSigner
 }
 
 var _ Eth = &eth{}
@@ -82,24 +96,127 @@ func (ks *eth) GetAll() (keys []ethkey.KeyV2, _ error) {
 	return keys, nil
 }
 
+// Create adds a new key, which is derived from the HD wallet if it is set up
 func (ks *eth) Create(chainID *big.Int) (ethkey.KeyV2, error) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
 	if ks.isLocked() {
 		return ethkey.KeyV2{}, ErrLocked
 	}
-	key, err := ethkey.NewV2()
+	key, undo, err := ks.newKey()
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
 	err = ks.add(key, chainID)
 	if err != nil {
+		undo()
 		return ethkey.KeyV2{}, err
 	}
 	ks.notify()
 	return key, nil
 }
 
+// CreateHDWallet sets up the HD wallet from which all new keys are derived,
+// with a new mnemonic if none is given, and returns its mnemonic. Keys which
+// were created or imported before are kept as they are.
+func (ks *eth) CreateHDWallet(mnemonic string) (string, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return "", ErrLocked
+	}
+	if ks.keyRing.EthHD != nil {
+		return "", errors.New("HD wallet already exists")
+	}
+	var hdWallet ethkey.HDWallet
+	var err error
+	if mnemonic == "" {
+		hdWallet, err = ethkey.NewHDWallet()
+	} else {
+		hdWallet, err = ethkey.HDWalletFromMnemonic(mnemonic)
+	}
+	if err != nil {
+		return "", err
+	}
+	ks.keyRing.EthHD = &hdWallet
+	if err = ks.save(); err != nil {
+		ks.keyRing.EthHD = nil
+		return "", errors.Wrap(err, "unable to save HD wallet")
+	}
+	return hdWallet.Mnemonic, nil
+}
+
+// HDWalletNextIndex returns the index of the next key derived from the HD
+// wallet, and whether the HD wallet is set up
+func (ks *eth) HDWalletNextIndex() (uint32, bool, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return 0, false, ErrLocked
+	}
+	if ks.keyRing.EthHD == nil {
+		return 0, false, nil
+	}
+	return ks.keyRing.EthHD.NextIndex, true, nil
+}
+
+// RecoverHDKeys derives the first count keys of the HD wallet, and adds the
+// ones which the keystore does not have for the given chain. It returns the
+// added keys.
+func (ks *eth) RecoverHDKeys(count uint32, chainID *big.Int) (keys []ethkey.KeyV2, err error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	hdWallet := ks.keyRing.EthHD
+	if hdWallet == nil {
+		return nil, errors.New("no HD wallet is set up")
+	}
+	for i := uint32(0); i < count; i++ {
+		key, err2 := hdWallet.Derive(i)
+		if err2 != nil {
+			return nil, err2
+		}
+		if _, exists := ks.keyRing.Eth[key.ID()]; !exists {
+			keys = append(keys, key)
+		}
+	}
+
+	nextIndex := hdWallet.NextIndex
+	if count > nextIndex {
+		hdWallet.NextIndex = count
+	}
+	for _, key := range keys {
+		ks.keyRing.Eth[key.ID()] = key
+	}
+	var states []*ethkey.State
+	err = ks.save(func(tx pg.Queryer) error {
+		for _, key := range keys {
+			state := ethkey.State{Address: key.Address, EVMChainID: *utils.NewBig(chainID)}
+			if err2 := insertEthKeyState(tx, &state); err2 != nil {
+				return err2
+			}
+			states = append(states, &state)
+		}
+		return nil
+	})
+	if err != nil {
+		hdWallet.NextIndex = nextIndex
+		for _, key := range keys {
+			delete(ks.keyRing.Eth, key.ID())
+		}
+		return nil, errors.Wrap(err, "unable to recover HD wallet keys")
+	}
+	for _, state := range states {
+		ks.keyStates.Eth[state.KeyID()] = state
+	}
+	if len(keys) > 0 {
+		ks.notify()
+	}
+	return keys, nil
+}
+
 func (ks *eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -117,6 +234,57 @@ func (ks *eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	return nil
 }
 
+// AddRemote adds a sending key for an address held by the remote signer
+func (ks *eth) AddRemote(address common.Address, chainID *big.Int) (ethkey.KeyV2, error) {
+	remoteSigner := ks.getRemoteSigner()
+	if remoteSigner == nil {
+		return ethkey.KeyV2{}, errors.New("cannot add remote eth key: no remote signer is configured, set ETH_REMOTE_SIGNER_URL")
+	}
+	accounts, err := remoteSigner.Accounts()
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "failed to list remote signer accounts")
+	}
+	var found bool
+	for _, a := range accounts {
+		if a == address {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ethkey.KeyV2{}, errors.Errorf("remote signer does not hold a key for address %s", address.Hex())
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key := ethkey.NewRemoteV2(address)
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	err = ks.addEthKeyWithState(key, ethkey.State{EVMChainID: *utils.NewBig(chainID), IsRemote: true})
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add remote eth key")
+	}
+	ks.notify()
+	return key, nil
+}
+
+// SetRemoteSigner sets the signer of remote keys
+func (ks *eth) SetRemoteSigner(signer RemoteSigner) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	ks.remoteSigner = signer
+}
+
+func (ks *eth) getRemoteSigner() RemoteSigner {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	return ks.remoteSigner
+}
+
 // EnsureKeys verifies whether the ETH keys have been seeded, if not, it creates them.
 func (ks *eth) EnsureKeys(chainID *big.Int) (err error) {
 	ks.lock.Lock()
@@ -136,12 +304,14 @@ func (ks *eth) EnsureKeys(chainID *big.Int) (err error) {
 		sendingKey = sendingKeys[0]
 		sendDidExist = true
 	} else {
-		sendingKey, err = ethkey.NewV2()
+		var undo func()
+		sendingKey, undo, err = ks.newKey()
 		if err != nil {
 			return err
 		}
 		err = ks.addEthKeyWithState(sendingKey, ethkey.State{EVMChainID: *utils.NewBig(chainID), IsFunding: false})
 		if err != nil {
+			undo()
 			return err
 		}
 		ks.logger.Infow("New sending address created", "address", sendingKey.Address.Hex(), "evmChainID", chainID)
@@ -153,12 +323,14 @@ func (ks *eth) EnsureKeys(chainID *big.Int) (err error) {
 		fundingKey = fundingKeys[0]
 		fundDidExist = true
 	} else {
-		fundingKey, err = ethkey.NewV2()
+		var undo func()
+		fundingKey, undo, err = ks.newKey()
 		if err != nil {
 			return err
 		}
 		err = ks.addEthKeyWithState(fundingKey, ethkey.State{EVMChainID: *utils.NewBig(chainID), IsFunding: true})
 		if err != nil {
+			undo()
 			return err
 		}
 		ks.logger.Infow("New funding address created", "address", fundingKey.Address.Hex(), "evmChainID", chainID)
@@ -203,6 +375,9 @@ func (ks *eth) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if key.IsRemote() {
+		return nil, errors.Errorf("eth key %s is held by the remote signer and cannot be exported", id)
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -244,18 +419,36 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 	}
 }
 
+// SignTx signs tx with the key for address, which is sent to the remote signer
+// if it is a remote key
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, remoteSigner, err := ks.getSigningKey(address)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRemote() {
+		if remoteSigner == nil {
+			return nil, errors.Errorf("eth key %s is remote, but no remote signer is configured", address.Hex())
+		}
+		// the remote signer is called without holding the lock, since it may
+		// take up to the signer timeout for each retry
+		return remoteSigner.SignTx(address, tx, chainID)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+}
+
+func (ks *eth) getSigningKey(address common.Address) (ethkey.KeyV2, RemoteSigner, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
-		return nil, ErrLocked
+		return ethkey.KeyV2{}, nil, ErrLocked
 	}
 	key, err := ks.getByID(address.Hex())
 	if err != nil {
-		return nil, err
+		return ethkey.KeyV2{}, nil, err
 	}
-	signer := types.LatestSignerForChainID(chainID)
-	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	return key, ks.remoteSigner, nil
 }
 
 // SendingKeys returns all sending keys for the given chain
@@ -449,6 +642,31 @@ func (ks *eth) sendingKeys(chainID *big.Int) (sendingKeys []ethkey.KeyV2) {
 	return sendingKeys
 }
 
+// caller must hold lock!
+// newKey derives the next key of the HD wallet if it is set up, skipping keys
+// which were already added, or generates a random key otherwise. undo resets
+// the HD wallet in case the key cannot be added.
+func (ks *eth) newKey() (key ethkey.KeyV2, undo func(), err error) {
+	hdWallet := ks.keyRing.EthHD
+	if hdWallet == nil {
+		key, err = ethkey.NewV2()
+		return key, func() {}, err
+	}
+	nextIndex := hdWallet.NextIndex
+	undo = func() { hdWallet.NextIndex = nextIndex }
+	for {
+		key, err = hdWallet.Derive(hdWallet.NextIndex)
+		if err != nil {
+			undo()
+			return ethkey.KeyV2{}, nil, err
+		}
+		hdWallet.NextIndex++
+		if _, exists := ks.keyRing.Eth[key.ID()]; !exists {
+			return key, undo, nil
+		}
+	}
+}
+
 // caller must hold lock!
 func (ks *eth) add(key ethkey.KeyV2, chainID *big.Int) error {
 	return ks.addEthKeyWithState(key, ethkey.State{EVMChainID: *utils.NewBig(chainID)})
@@ -458,8 +676,8 @@ func (ks *eth) add(key ethkey.KeyV2, chainID *big.Int) error {
 func (ks *eth) addEthKeyWithState(key ethkey.KeyV2, state ethkey.State) error {
 	state.Address = key.Address
 	return ks.safeAddKey(key, func(tx pg.Queryer) error {
-		sql := `INSERT INTO eth_key_states (address, next_nonce, is_funding, evm_chain_id, created_at, updated_at)
-VALUES (:address, :next_nonce, :is_funding, :evm_chain_id, NOW(), NOW())
+		sql := `INSERT INTO eth_key_states (address, next_nonce, is_funding, is_remote, evm_chain_id, created_at, updated_at)
+VALUES (:address, :next_nonce, :is_funding, :is_remote, :evm_chain_id, NOW(), NOW())
 RETURNING *;`
 		if err := ks.orm.q.GetNamed(sql, &state, state); err != nil {
 			return errors.Wrap(err, "failed to insert eth_key_state")
@@ -469,6 +687,21 @@ RETURNING *;`
 	})
 }
 
+// insertEthKeyState inserts state in a transaction, and sets its columns
+func insertEthKeyState(tx pg.Queryer, state *ethkey.State) error {
+	sql := `INSERT INTO eth_key_states (address, next_nonce, is_funding, is_remote, evm_chain_id, created_at, updated_at)
+VALUES (:address, :next_nonce, :is_funding, :is_remote, :evm_chain_id, NOW(), NOW())
+RETURNING *;`
+	stmt, args, err := tx.BindNamed(sql, state)
+	if err != nil {
+		return err
+	}
+	if err = tx.Get(state, stmt, args...); err != nil {
+		return errors.Wrapf(err, "failed to insert state of eth key %s", state.Address.Hex())
+	}
+	return nil
+}
+
 // notify notifies subscribers that eth keys have changed
 func (ks *eth) notify() {
 	ks.subscribersMu.RLock()
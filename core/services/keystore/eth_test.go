@@ -268,6 +268,175 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+// fakeRemoteSigner signs with a key held in memory, standing in for a remote signer
+type fakeRemoteSigner struct {
+	key ethkey.KeyV2
+}
+
+func (s *fakeRemoteSigner) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if fromAddress != s.key.Address.Address() {
+		return nil, fmt.Errorf("unknown account %s", fromAddress.Hex())
+	}
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key.ToEcdsaPrivKey())
+}
+
+func (s *fakeRemoteSigner) Accounts() ([]common.Address, error) {
+	return []common.Address{s.key.Address.Address()}, nil
+}
+
+func Test_EthKeyStore_RemoteKeys(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ks := keyStore.Eth()
+
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	address := remoteKey.Address.Address()
+	chainID := &cltest.FixtureChainID
+
+	_, err = ks.AddRemote(address, chainID)
+	require.EqualError(t, err, "cannot add remote eth key: no remote signer is configured, set ETH_REMOTE_SIGNER_URL")
+
+	ks.SetRemoteSigner(&fakeRemoteSigner{key: remoteKey})
+
+	_, err = ks.AddRemote(testutils.NewAddress(), chainID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "remote signer does not hold a key for address")
+
+	key, err := ks.AddRemote(address, chainID)
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+	state, err := ks.GetState(key.ID())
+	require.NoError(t, err)
+	assert.True(t, state.IsRemote)
+
+	_, err = ks.AddRemote(address, chainID)
+	require.Error(t, err)
+
+	tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+	signed, err := ks.SignTx(address, tx, chainID)
+	require.NoError(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	assert.Equal(t, address, sender)
+
+	_, err = ks.Export(key.ID(), cltest.Password)
+	require.Error(t, err)
+
+	// remote keys are restored from their state on unlock
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	sendingKeys, err := ks.SendingKeys(chainID)
+	require.NoError(t, err)
+	require.Len(t, sendingKeys, 1)
+	assert.True(t, sendingKeys[0].IsRemote())
+	assert.Equal(t, address, sendingKeys[0].Address.Address())
+
+	ks.SetRemoteSigner(nil)
+	_, err = ks.SignTx(address, tx, chainID)
+	require.EqualError(t, err, fmt.Sprintf("eth key %s is remote, but no remote signer is configured", address.Hex()))
+
+	_, err = ks.Delete(key.ID())
+	require.NoError(t, err)
+	keys, err := ks.GetAll()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func Test_EthKeyStore_HDWallet(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ks := keyStore.Eth()
+	chainID := &cltest.FixtureChainID
+
+	// keys created before the HD wallet are kept
+	randomKey, err := ks.Create(chainID)
+	require.NoError(t, err)
+
+	_, enabled, err := ks.HDWalletNextIndex()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	_, err = ks.RecoverHDKeys(1, chainID)
+	require.EqualError(t, err, "no HD wallet is set up")
+
+	_, err = ks.CreateHDWallet("not a mnemonic")
+	require.EqualError(t, err, "invalid mnemonic")
+	mnemonic, err := ks.CreateHDWallet("")
+	require.NoError(t, err)
+	_, err = ks.CreateHDWallet("")
+	require.EqualError(t, err, "HD wallet already exists")
+
+	hdWallet, err := ethkey.HDWalletFromMnemonic(mnemonic)
+	require.NoError(t, err)
+	for i := uint32(0); i < 2; i++ {
+		key, err2 := ks.Create(chainID)
+		require.NoError(t, err2)
+		derived, err2 := hdWallet.Derive(i)
+		require.NoError(t, err2)
+		assert.Equal(t, derived.Address, key.Address)
+	}
+	nextIndex, enabled, err := ks.HDWalletNextIndex()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, uint32(2), nextIndex)
+
+	// the HD wallet is saved with the keyring
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	nextIndex, _, err = ks.HDWalletNextIndex()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), nextIndex)
+
+	t.Run("recovers the derived keys in another keystore", func(t *testing.T) {
+		db2 := pgtest.NewSqlxDB(t)
+		keyStore2 := keystore.ExposedNewMaster(t, db2, cfg)
+		require.NoError(t, keyStore2.Unlock(cltest.Password))
+		ks2 := keyStore2.Eth()
+
+		_, err = ks2.CreateHDWallet(mnemonic)
+		require.NoError(t, err)
+		derived, err := hdWallet.Derive(0)
+		require.NoError(t, err)
+		_, err = ks2.Import(exportKey(t, derived), cltest.Password, chainID)
+		require.NoError(t, err)
+
+		keys, err := ks2.RecoverHDKeys(3, chainID)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		for i, key := range keys {
+			derived, err := hdWallet.Derive(uint32(i + 1))
+			require.NoError(t, err)
+			assert.Equal(t, derived.Address, key.Address)
+			state, err := ks2.GetState(key.ID())
+			require.NoError(t, err)
+			assert.Equal(t, *utils.NewBig(chainID), state.EVMChainID)
+		}
+		nextIndex, _, err := ks2.HDWalletNextIndex()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), nextIndex)
+
+		keys, err = ks2.RecoverHDKeys(3, chainID)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	_, err = ks.Get(randomKey.ID())
+	require.NoError(t, err)
+}
+
+func exportKey(t *testing.T, key ethkey.KeyV2) []byte {
+	t.Helper()
+	keyJSON, err := key.ToEncryptedJSON(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	return keyJSON
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewTestGeneralConfig(t)
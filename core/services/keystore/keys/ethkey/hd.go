@@ -0,0 +1,75 @@
+package ethkey
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// mnemonicEntropyBits is the entropy of generated mnemonics, which have 24 words
+const mnemonicEntropyBits = 256
+
+// DerivationPath returns the BIP-44 path of the Ethereum account at index,
+// which is the path used by most wallets, e.g. m/44'/60'/0'/0/0
+func DerivationPath(index uint32) string {
+	return fmt.Sprintf("m/44'/60'/0'/0/%d", index)
+}
+
+// HDWallet deterministically derives eth keys from a BIP-39 mnemonic
+type HDWallet struct {
+	Mnemonic string
+	// NextIndex is the index of the next key to be derived
+	NextIndex uint32
+}
+
+// NewHDWallet creates an HDWallet with a new random mnemonic
+func NewHDWallet() (HDWallet, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return HDWallet{}, err
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return HDWallet{}, err
+	}
+	return HDWallet{Mnemonic: mnemonic}, nil
+}
+
+// HDWalletFromMnemonic creates an HDWallet for an existing mnemonic
+func HDWalletFromMnemonic(mnemonic string) (HDWallet, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return HDWallet{}, errors.New("invalid mnemonic")
+	}
+	return HDWallet{Mnemonic: mnemonic}, nil
+}
+
+// Derive returns the key at index, regardless of NextIndex
+func (w HDWallet) Derive(index uint32) (KeyV2, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(w.Mnemonic, "")
+	if err != nil {
+		return KeyV2{}, errors.Wrap(err, "invalid mnemonic")
+	}
+	master, chainCode := hd.ComputeMastersFromSeed(seed)
+	b, err := hd.DerivePrivateKeyForPath(master, chainCode, DerivationPath(index))
+	if err != nil {
+		return KeyV2{}, errors.Wrapf(err, "failed to derive eth key %d", index)
+	}
+	privateKey, err := crypto.ToECDSA(b)
+	if err != nil {
+		return KeyV2{}, errors.Wrapf(err, "failed to derive eth key %d", index)
+	}
+	return FromPrivateKey(privateKey), nil
+}
+
+func (w HDWallet) String() string {
+	return fmt.Sprintf("HDWallet{Mnemonic: <redacted>, NextIndex: %d}", w.NextIndex)
+}
+
+func (w HDWallet) GoString() string {
+	return w.String()
+}
@@ -0,0 +1,47 @@
+package ethkey_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func TestHDWallet_Derive(t *testing.T) {
+	t.Parallel()
+
+	// the well known development mnemonic of hardhat and foundry
+	w, err := ethkey.HDWalletFromMnemonic("test test test test test test test test test test test junk")
+	require.NoError(t, err)
+
+	key, err := w.Derive(0)
+	require.NoError(t, err)
+	assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", key.Address.Hex())
+
+	key, err = w.Derive(1)
+	require.NoError(t, err)
+	assert.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", key.Address.Hex())
+}
+
+func TestHDWallet_New(t *testing.T) {
+	t.Parallel()
+
+	w, err := ethkey.NewHDWallet()
+	require.NoError(t, err)
+
+	restored, err := ethkey.HDWalletFromMnemonic(w.Mnemonic)
+	require.NoError(t, err)
+	key, err := w.Derive(3)
+	require.NoError(t, err)
+	restoredKey, err := restored.Derive(3)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, restoredKey.Address)
+
+	assert.NotContains(t, fmt.Sprintf("%v %#v", w, w), w.Mnemonic)
+
+	_, err = ethkey.HDWalletFromMnemonic("test test test")
+	require.EqualError(t, err, "invalid mnemonic")
+}
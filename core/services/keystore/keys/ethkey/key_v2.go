@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -58,6 +59,17 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// NewRemoteV2 returns a key for an address whose private key is held by a
+// remote signer rather than the keystore
+func NewRemoteV2(address common.Address) KeyV2 {
+	return KeyV2{Address: EIP55AddressFromAddress(address)}
+}
+
+// IsRemote returns true if the private key is held by a remote signer
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -71,6 +83,9 @@ func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 }
 
 func (key KeyV2) String() string {
+	if key.IsRemote() {
+		return fmt.Sprintf("EthKeyV2{PrivateKey: <remote>, Address: %s}", key.Address)
+	}
 	return fmt.Sprintf("EthKeyV2{PrivateKey: <redacted>, Address: %s}", key.Address)
 }
 
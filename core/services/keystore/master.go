@@ -43,6 +43,9 @@ type Master interface {
 	Unlock(password string) error
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
+	ChangePassword(oldPassword, newPassword string) error
+	Backup(password string) ([]byte, error)
+	Restore(backup []byte, password string) (RestoreResult, error)
 }
 
 type master struct {
@@ -231,6 +234,12 @@ func (km *keyManager) Unlock(password string) error {
 		return errors.Wrap(err, "unable to load key states")
 	}
 
+	for id, state := range ks.Eth {
+		if state.IsRemote {
+			kr.Eth[id] = ethkey.NewRemoteV2(state.Address.Address())
+		}
+	}
+
 	if err = ks.validate(kr); err != nil {
 		return err
 	}
@@ -34,6 +34,27 @@ func (_m *Eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	return r0
 }
 
+// AddRemote provides a mock function with given fields: address, chainID
+func (_m *Eth) AddRemote(address common.Address, chainID *big.Int) (ethkey.KeyV2, error) {
+	ret := _m.Called(address, chainID)
+
+	var r0 ethkey.KeyV2
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) ethkey.KeyV2); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int) error); ok {
+		r1 = rf(address, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: chainID
 func (_m *Eth) Create(chainID *big.Int) (ethkey.KeyV2, error) {
 	ret := _m.Called(chainID)
@@ -55,6 +76,27 @@ func (_m *Eth) Create(chainID *big.Int) (ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// CreateHDWallet provides a mock function with given fields: mnemonic
+func (_m *Eth) CreateHDWallet(mnemonic string) (string, error) {
+	ret := _m.Called(mnemonic)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(mnemonic)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(mnemonic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: id
 func (_m *Eth) Delete(id string) (ethkey.KeyV2, error) {
 	ret := _m.Called(id)
@@ -309,6 +351,34 @@ func (_m *Eth) GetV1KeysAsV2(f keystore.DefaultEVMChainIDFunc) ([]ethkey.KeyV2,
 	return r0, r1, r2
 }
 
+// HDWalletNextIndex provides a mock function with given fields:
+func (_m *Eth) HDWalletNextIndex() (uint32, bool, error) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Import provides a mock function with given fields: keyJSON, password, chainID
 func (_m *Eth) Import(keyJSON []byte, password string, chainID *big.Int) (ethkey.KeyV2, error) {
 	ret := _m.Called(keyJSON, password, chainID)
@@ -330,6 +400,29 @@ func (_m *Eth) Import(keyJSON []byte, password string, chainID *big.Int) (ethkey
 	return r0, r1
 }
 
+// RecoverHDKeys provides a mock function with given fields: count, chainID
+func (_m *Eth) RecoverHDKeys(count uint32, chainID *big.Int) ([]ethkey.KeyV2, error) {
+	ret := _m.Called(count, chainID)
+
+	var r0 []ethkey.KeyV2
+	if rf, ok := ret.Get(0).(func(uint32, *big.Int) []ethkey.KeyV2); ok {
+		r0 = rf(count, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ethkey.KeyV2)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint32, *big.Int) error); ok {
+		r1 = rf(count, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendingKeys provides a mock function with given fields: chainID
 func (_m *Eth) SendingKeys(chainID *big.Int) ([]ethkey.KeyV2, error) {
 	ret := _m.Called(chainID)
@@ -353,6 +446,11 @@ func (_m *Eth) SendingKeys(chainID *big.Int) ([]ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// SetRemoteSigner provides a mock function with given fields: signer
+func (_m *Eth) SetRemoteSigner(signer keystore.RemoteSigner) {
+	_m.Called(signer)
+}
+
 // SetState provides a mock function with given fields: _a0
 func (_m *Eth) SetState(_a0 ethkey.State) error {
 	ret := _m.Called(_a0)
@@ -12,6 +12,43 @@ type Master struct {
 	mock.Mock
 }
 
+// Backup provides a mock function with given fields: password
+func (_m *Master) Backup(password string) ([]byte, error) {
+	ret := _m.Called(password)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangePassword provides a mock function with given fields: oldPassword, newPassword
+func (_m *Master) ChangePassword(oldPassword string, newPassword string) error {
+	ret := _m.Called(oldPassword, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CSA provides a mock function with given fields:
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -127,6 +164,27 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// Restore provides a mock function with given fields: backup, password
+func (_m *Master) Restore(backup []byte, password string) (keystore.RestoreResult, error) {
+	ret := _m.Called(backup, password)
+
+	var r0 keystore.RestoreResult
+	if rf, ok := ret.Get(0).(func([]byte, string) keystore.RestoreResult); ok {
+		r0 = rf(backup, password)
+	} else {
+		r0 = ret.Get(0).(keystore.RestoreResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, string) error); ok {
+		r1 = rf(backup, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -81,6 +81,8 @@ type keyRing struct {
 	Solana map[string]solkey.Key
 	Terra  map[string]terrakey.Key
 	VRF    map[string]vrfkey.KeyV2
+	// EthHD derives new eth keys when set
+	EthHD *ethkey.HDWallet
 }
 
 func newKeyRing() keyRing {
@@ -124,6 +126,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		// remote keys are restored from their eth_key_states
+		if ethKey.IsRemote() {
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
@@ -144,6 +150,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, vrfKey := range kr.VRF {
 		rawKeys.VRF = append(rawKeys.VRF, vrfKey.Raw())
 	}
+	if kr.EthHD != nil {
+		hdWallet := *kr.EthHD
+		rawKeys.EthHD = &hdWallet
+	}
 	return rawKeys
 }
 
@@ -219,6 +229,7 @@ type rawKeyRing struct {
 	Solana []solkey.Raw
 	Terra  []terrakey.Raw
 	VRF    []vrfkey.Raw
+	EthHD  *ethkey.HDWallet `json:",omitempty"`
 }
 
 func (rawKeys rawKeyRing) keys() (keyRing, error) {
@@ -255,6 +266,10 @@ func (rawKeys rawKeyRing) keys() (keyRing, error) {
 		vrfKey := rawVRFKey.Key()
 		keyRing.VRF[vrfKey.ID()] = vrfKey
 	}
+	if rawKeys.EthHD != nil {
+		hdWallet := *rawKeys.EthHD
+		keyRing.EthHD = &hdWallet
+	}
 	return keyRing, nil
 }
 
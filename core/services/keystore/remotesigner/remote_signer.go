@@ -0,0 +1,232 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	// healthCheckInterval is how often the signer is pinged while idle
+	healthCheckInterval = time.Minute
+	// retryBackoff is multiplied by the attempt number to get the delay before a retry
+	retryBackoff = 500 * time.Millisecond
+)
+
+// Signer is a client of a Clef compatible JSON-RPC signer, which holds the
+// private keys of remote eth keys.
+//
+// Requests that time out or fail to reach the signer are retried. Errors
+// returned by the signer itself, such as a rejected request, are not.
+type Signer struct {
+	utils.StartStopOnce
+	client  *rpc.Client
+	timeout time.Duration
+	retries uint
+	lggr    logger.Logger
+
+	healthMu  sync.RWMutex
+	healthErr error
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+var _ keystore.RemoteSigner = &Signer{}
+
+// New returns a Signer for the JSON-RPC endpoint at u. Each request is
+// limited to timeout, and retried up to retries times.
+func New(u *url.URL, timeout time.Duration, retries uint, lggr logger.Logger) (*Signer, error) {
+	client, err := rpc.DialHTTP(u.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create remote signer client for %s", u.Redacted())
+	}
+	return &Signer{
+		client:  client,
+		timeout: timeout,
+		retries: retries,
+		lggr:    lggr.Named("RemoteSigner"),
+		chStop:  make(chan struct{}),
+	}, nil
+}
+
+// Start pings the signer periodically, so that its health is reported
+// between signing requests.
+func (s *Signer) Start(context.Context) error {
+	return s.StartOnce("RemoteSigner", func() error {
+		s.wg.Add(1)
+		go s.run()
+		return nil
+	})
+}
+
+// Close stops the health checks and closes the client.
+func (s *Signer) Close() error {
+	return s.StopOnce("RemoteSigner", func() error {
+		close(s.chStop)
+		s.wg.Wait()
+		s.client.Close()
+		return nil
+	})
+}
+
+// Healthy returns the error of the last request if the signer could not be
+// reached.
+func (s *Signer) Healthy() error {
+	if err := s.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	s.healthMu.RLock()
+	defer s.healthMu.RUnlock()
+	return s.healthErr
+}
+
+func (s *Signer) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Version(); err != nil {
+			s.lggr.Errorw("Remote signer health check failed", "err", err)
+		}
+		select {
+		case <-s.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Version returns the version of the signer's external API.
+func (s *Signer) Version() (version string, err error) {
+	err = s.call(&version, "account_version")
+	return
+}
+
+// Accounts returns the addresses of the keys held by the signer.
+func (s *Signer) Accounts() (accounts []common.Address, err error) {
+	err = s.call(&accounts, "account_list")
+	return
+}
+
+type signTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// SignTx sends tx to the signer to be signed by fromAddress. The signed
+// transaction is checked to be the same transaction, from fromAddress.
+func (s *Signer) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args, err := newSendTxArgs(fromAddress, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	var res signTransactionResult
+	if err = s.call(&res, "account_signTransaction", args); err != nil {
+		return nil, errors.Wrapf(err, "remote signer failed to sign transaction from %s", fromAddress.Hex())
+	}
+	signedTx := res.Tx
+	if len(res.Raw) > 0 {
+		signedTx = new(types.Transaction)
+		if err = signedTx.UnmarshalBinary(res.Raw); err != nil {
+			return nil, errors.Wrap(err, "remote signer returned an invalid transaction")
+		}
+	}
+	if signedTx == nil {
+		return nil, errors.New("remote signer returned no transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signedTx) != signer.Hash(tx) {
+		return nil, errors.Errorf("remote signer modified transaction from %s", fromAddress.Hex())
+	}
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	if sender != fromAddress {
+		return nil, errors.Errorf("remote signer signed transaction from %s with the key for %s", fromAddress.Hex(), sender.Hex())
+	}
+	return signedTx, nil
+}
+
+func newSendTxArgs(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*apitypes.SendTxArgs, error) {
+	data := hexutil.Bytes(tx.Data())
+	var to *common.MixedcaseAddress
+	if tx.To() != nil {
+		t := common.NewMixedcaseAddress(*tx.To())
+		to = &t
+	}
+	args := &apitypes.SendTxArgs{
+		Data:    &data,
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Value:   hexutil.Big(*tx.Value()),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		To:      to,
+		From:    common.NewMixedcaseAddress(fromAddress),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, errors.Errorf("remote signer does not support transaction type %d", tx.Type())
+	}
+	return args, nil
+}
+
+// call makes a request to the signer, retrying it if the signer could not be
+// reached, and records the health of the signer.
+func (s *Signer) call(result interface{}, method string, args ...interface{}) (err error) {
+	for attempt := uint(0); attempt <= s.retries; attempt++ {
+		if attempt > 0 {
+			s.lggr.Warnw("Retrying remote signer request", "method", method, "attempt", attempt, "err", err)
+			select {
+			case <-s.chStop:
+				return errors.Wrap(err, "remote signer stopped")
+			case <-time.After(time.Duration(attempt) * retryBackoff):
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		err = s.client.CallContext(ctx, result, method, args...)
+		cancel()
+		if !isUnreachable(err) {
+			break
+		}
+	}
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+	if isUnreachable(err) {
+		s.healthErr = errors.Wrap(err, "remote signer is unreachable")
+	} else {
+		s.healthErr = nil
+	}
+	return err
+}
+
+// isUnreachable returns true if err did not come from the signer itself.
+func isUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
@@ -0,0 +1,227 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// stubSigner implements the account_ namespace of a Clef compatible signer
+// with a single in-memory key
+type stubSigner struct {
+	key    *ecdsa.PrivateKey
+	modify bool
+}
+
+func (s *stubSigner) Version() string {
+	return "6.0.0"
+}
+
+func (s *stubSigner) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(s.key.PublicKey)}
+}
+
+func (s *stubSigner) SignTransaction(args apitypes.SendTxArgs, methodSelector *string) (*signTransactionResult, error) {
+	if s.modify {
+		args.Nonce++
+	}
+	tx := args.ToTransaction()
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID((*big.Int)(args.ChainID)), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTransactionResult{Raw: raw, Tx: signed}, nil
+}
+
+func newStubServer(t *testing.T, stub *stubSigner, wrap func(http.Handler) http.Handler) *url.URL {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("account", stub))
+	var h http.Handler = srv
+	if wrap != nil {
+		h = wrap(h)
+	}
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	t.Cleanup(srv.Stop)
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func newStubSigner(t *testing.T) *stubSigner {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &stubSigner{key: key}
+}
+
+func TestSigner_SignTx(t *testing.T) {
+	stub := newStubSigner(t)
+	from := crypto.PubkeyToAddress(stub.key.PublicKey)
+	chainID := big.NewInt(1337)
+	to := testutils.NewAddress()
+
+	s, err := New(newStubServer(t, stub, nil), time.Second, 0, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	t.Run("legacy", func(t *testing.T) {
+		tx := types.NewTx(&types.LegacyTx{Nonce: 3, To: &to, Value: big.NewInt(5), Gas: 21000, GasPrice: big.NewInt(10), Data: []byte{1}})
+		signed, err := s.SignTx(from, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, from, sender)
+		assert.Equal(t, tx.Nonce(), signed.Nonce())
+	})
+
+	t.Run("dynamic fee", func(t *testing.T) {
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 4, To: &to, Value: big.NewInt(5), Gas: 21000, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10)})
+		signed, err := s.SignTx(from, tx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.DynamicFeeTxType), signed.Type())
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, from, sender)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		tx := types.NewTx(&types.LegacyTx{Nonce: 3, To: &to, Gas: 21000, GasPrice: big.NewInt(10)})
+		_, err := s.SignTx(testutils.NewAddress(), tx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "with the key for "+from.Hex())
+	})
+
+	t.Run("modified transaction", func(t *testing.T) {
+		modifying, err := New(newStubServer(t, &stubSigner{key: stub.key, modify: true}, nil), time.Second, 0, logger.TestLogger(t))
+		require.NoError(t, err)
+		tx := types.NewTx(&types.LegacyTx{Nonce: 3, To: &to, Gas: 21000, GasPrice: big.NewInt(10)})
+		_, err = modifying.SignTx(from, tx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote signer modified transaction")
+	})
+}
+
+func TestSigner_Accounts(t *testing.T) {
+	stub := newStubSigner(t)
+	s, err := New(newStubServer(t, stub, nil), time.Second, 0, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	accounts, err := s.Accounts()
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{crypto.PubkeyToAddress(stub.key.PublicKey)}, accounts)
+}
+
+func TestSigner_Retries(t *testing.T) {
+	retryBackoff = time.Millisecond
+	stub := newStubSigner(t)
+
+	var requests int32
+	failFirst := func(n int32) func(http.Handler) http.Handler {
+		return func(h http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= n {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				h.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	t.Run("succeeds after retrying", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		s, err := New(newStubServer(t, stub, failFirst(2)), time.Second, 2, logger.TestLogger(t))
+		require.NoError(t, err)
+
+		v, err := s.Version()
+		require.NoError(t, err)
+		assert.Equal(t, "6.0.0", v)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("fails after the last retry", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		s, err := New(newStubServer(t, stub, failFirst(3)), time.Second, 2, logger.TestLogger(t))
+		require.NoError(t, err)
+
+		_, err = s.Version()
+		require.Error(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("does not retry errors from the signer", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		s, err := New(newStubServer(t, stub, failFirst(0)), time.Second, 2, logger.TestLogger(t))
+		require.NoError(t, err)
+
+		err = s.call(nil, "account_unknown")
+		require.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		slow := func(h http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+				h.ServeHTTP(w, r)
+			})
+		}
+		s, err := New(newStubServer(t, stub, slow), 10*time.Millisecond, 0, logger.TestLogger(t))
+		require.NoError(t, err)
+
+		_, err = s.Version()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+}
+
+func TestSigner_Healthy(t *testing.T) {
+	stub := newStubSigner(t)
+	var down int32
+	u := newStubServer(t, stub, func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&down) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	})
+	s, err := New(u, time.Second, 0, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, s.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+
+	_, err = s.Version()
+	require.NoError(t, err)
+	assert.NoError(t, s.Healthy())
+
+	atomic.StoreInt32(&down, 1)
+	_, err = s.Version()
+	require.Error(t, err)
+	assert.Error(t, s.Healthy())
+
+	atomic.StoreInt32(&down, 0)
+	_, err = s.Accounts()
+	require.NoError(t, err)
+	assert.NoError(t, s.Healthy())
+}
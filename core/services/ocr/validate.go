@@ -1,6 +1,7 @@
 package ocr
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/multiformats/go-multiaddr"
@@ -34,6 +35,19 @@ type ValidationConfig interface {
 
 // ValidatedOracleSpecToml validates an oracle spec that came from TOML
 func ValidatedOracleSpecToml(chainSet evm.ChainSet, tomlString string) (job.Job, error) {
+	return ValidatedOracleSpecTomlWithConfig(func(id *big.Int) (ValidationConfig, error) {
+		chain, err := chainSet.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		return chain.Config(), nil
+	}, tomlString)
+}
+
+// ValidatedOracleSpecTomlWithConfig validates an oracle spec that came from
+// TOML against the config returned by chainConfig for the chain of the spec,
+// so that specs can be validated without a running chain.
+func ValidatedOracleSpecTomlWithConfig(chainConfig func(id *big.Int) (ValidationConfig, error), tomlString string) (job.Job, error) {
 	var jb = job.Job{}
 	var spec job.OCROracleSpec
 	tree, err := toml.Load(tomlString)
@@ -64,7 +78,7 @@ func ValidatedOracleSpecToml(chainSet evm.ChainSet, tomlString string) (job.Job,
 		}
 	}
 
-	chain, err := chainSet.Get(jb.OCROracleSpec.EVMChainID.ToInt())
+	cfg, err := chainConfig(jb.OCROracleSpec.EVMChainID.ToInt())
 	if err != nil {
 		return jb, err
 	}
@@ -73,10 +87,10 @@ func ValidatedOracleSpecToml(chainSet evm.ChainSet, tomlString string) (job.Job,
 		if err := validateBootstrapSpec(tree, jb); err != nil {
 			return jb, err
 		}
-	} else if err := validateNonBootstrapSpec(tree, chain.Config(), jb); err != nil {
+	} else if err := validateNonBootstrapSpec(tree, cfg, jb); err != nil {
 		return jb, err
 	}
-	if err := validateTimingParameters(chain.Config(), spec); err != nil {
+	if err := validateTimingParameters(cfg, spec); err != nil {
 		return jb, err
 	}
 	return jb, nil
@@ -4,4 +4,7 @@ package pg
 const (
 	ChannelInsertOnEthTx    = "insert_on_eth_txes"
 	ChannelInsertOnTerraMsg = "insert_on_terra_msg"
+	// ChannelNodeEvents carries JSON arrays of run, task run, job error and
+	// eth_tx state change events. See core/services/events.
+	ChannelNodeEvents = "node_events"
 )
@@ -280,3 +280,15 @@ lease_lock.expires_at < NOW()
 func (l *leaseLock) ClientID() uuid.UUID {
 	return l.id
 }
+
+// LeaseHolder returns the client ID of the node that last took the lease lock,
+// and when its lease expires. It returns sql.ErrNoRows if no node ever took
+// the lease.
+func LeaseHolder(ctx context.Context, db *sqlx.DB) (clientID uuid.UUID, expiresAt time.Time, err error) {
+	var lease struct {
+		ClientID  uuid.UUID `db:"client_id"`
+		ExpiresAt time.Time `db:"expires_at"`
+	}
+	err = db.GetContext(ctx, &lease, `SELECT client_id, expires_at FROM lease_lock LIMIT 1`)
+	return lease.ClientID, lease.ExpiresAt, errors.Wrap(err, "failed to load lease lock")
+}
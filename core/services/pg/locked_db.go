@@ -126,6 +126,13 @@ func openDB(cfg config.GeneralConfig, lggr logger.Logger) (db *sqlx.DB, err erro
 	uri := cfg.DatabaseURL()
 	appid := cfg.AppID()
 	static.SetConsumerName(&uri, "App", &appid)
+	if cfg.EventsEthTxEnabled() {
+		// Read by the notify_eth_tx_state trigger, which is a no-op for
+		// sessions without it
+		q := uri.Query()
+		q.Set("chainlink.eth_tx_events", "on")
+		uri.RawQuery = q.Encode()
+	}
 	dialect := cfg.GetDatabaseDialectConfiguredOrDefault()
 	db, err = NewConnection(uri.String(), string(dialect), Config{
 		Logger:       lggr,
@@ -299,6 +299,16 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeLowercase        TaskType = "lowercase"
 	TaskTypeUppercase        TaskType = "uppercase"
+	TaskTypeCSVParse         TaskType = "csvparse"
+	TaskTypeXMLParse         TaskType = "xmlparse"
+	TaskTypeRegexExtract     TaskType = "regexextract"
+	TaskTypeHash             TaskType = "hash"
+	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeHexDecode        TaskType = "hexdecode"
+	TaskTypeBase64Encode     TaskType = "base64encode"
+	TaskTypeBase64Decode     TaskType = "base64decode"
+	TaskTypeTimestamp        TaskType = "timestamp"
+	TaskTypeStringFormat     TaskType = "stringformat"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -379,6 +389,26 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeUppercase:
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCSVParse:
+		task = &CSVParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeXMLParse:
+		task = &XMLParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeRegexExtract:
+		task = &RegexExtractTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeHash:
+		task = &HashTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeHexEncode:
+		task = &HexEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeHexDecode:
+		task = &HexDecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeBase64Encode:
+		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeBase64Decode:
+		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTimestamp:
+		task = &TimestampTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeStringFormat:
+		task = &StringFormatTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
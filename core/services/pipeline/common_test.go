@@ -162,6 +162,16 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeMerge, &pipeline.MergeTask{}},
 		{pipeline.TaskTypeLowercase, &pipeline.LowercaseTask{}},
 		{pipeline.TaskTypeUppercase, &pipeline.UppercaseTask{}},
+		{pipeline.TaskTypeCSVParse, &pipeline.CSVParseTask{}},
+		{pipeline.TaskTypeXMLParse, &pipeline.XMLParseTask{}},
+		{pipeline.TaskTypeRegexExtract, &pipeline.RegexExtractTask{}},
+		{pipeline.TaskTypeHash, &pipeline.HashTask{}},
+		{pipeline.TaskTypeHexEncode, &pipeline.HexEncodeTask{}},
+		{pipeline.TaskTypeHexDecode, &pipeline.HexDecodeTask{}},
+		{pipeline.TaskTypeBase64Encode, &pipeline.Base64EncodeTask{}},
+		{pipeline.TaskTypeBase64Decode, &pipeline.Base64DecodeTask{}},
+		{pipeline.TaskTypeTimestamp, &pipeline.TimestampTask{}},
+		{pipeline.TaskTypeStringFormat, &pipeline.StringFormatTask{}},
 	}
 
 	for _, test := range tests {
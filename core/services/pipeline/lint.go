@@ -0,0 +1,281 @@
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/graph"
+)
+
+// maxFormattedLineLength is the length past which Format puts each attribute
+// of a task on its own line.
+const maxFormattedLineLength = 100
+
+var (
+	dotIDRegexp      = regexp.MustCompile(`\A[a-zA-Z_][a-zA-Z0-9_]*\z`)
+	dotNumeralRegexp = regexp.MustCompile(`\A-?(\.[0-9]+|[0-9]+(\.[0-9]*)?)\z`)
+)
+
+// Lint reports mistakes in a pipeline which parse, but which break or are
+// ignored at run time: attributes a task does not have, variables other than
+// vars or the results of tasks, and tasks which are not connected to the rest
+// of the pipeline. vars are the names of the variables the job type passes to
+// its runs, such as "jobSpec" and "jobRun".
+func Lint(source string, vars ...string) ([]string, error) {
+	if _, err := Parse(source); err != nil {
+		return nil, err
+	}
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(source)); err != nil {
+		return nil, err
+	}
+	nodes := sortedNodes(g)
+
+	known := map[string]bool{}
+	for _, v := range vars {
+		known[v] = true
+	}
+	for _, n := range nodes {
+		known[n.DOTID()] = true
+	}
+
+	var problems []string
+	for _, n := range nodes {
+		taskType := TaskType(strings.ToLower(n.attrs["type"]))
+		attrs := taskAttributes(taskType)
+		for _, attr := range n.Attributes() {
+			if !attrs[strings.ToLower(attr.Key)] {
+				problems = append(problems, fmt.Sprintf("task %s: %s tasks have no attribute %q, so it is ignored", n.DOTID(), taskType, attr.Key))
+			}
+			for _, m := range variableRegexp.FindAllStringSubmatch(attr.Value, -1) {
+				if root := strings.Split(m[1], ".")[0]; !known[root] {
+					problems = append(problems, fmt.Sprintf("task %s: %s refers to %q, which is neither a task nor a variable of the job", n.DOTID(), m[0], root))
+				}
+			}
+		}
+	}
+	for _, n := range unconnectedNodes(g, nodes) {
+		problems = append(problems, fmt.Sprintf("task %s is not connected to the rest of the pipeline", n.DOTID()))
+	}
+	return problems, nil
+}
+
+// taskAttributes returns the lower case names of the attributes of tasks of
+// taskType, as matched when the task is decoded.
+func taskAttributes(taskType TaskType) map[string]bool {
+	attrs := map[string]bool{"type": true}
+	task, err := UnmarshalTaskFromMap(taskType, map[string]string{}, 0, "")
+	if err != nil {
+		return attrs
+	}
+	var add func(t reflect.Type)
+	add = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := strings.Split(f.Tag.Get("mapstructure"), ",")
+			if len(tag) > 1 && tag[1] == "squash" {
+				add(f.Type)
+				continue
+			}
+			if f.PkgPath != "" {
+				continue
+			}
+			name := f.Name
+			if tag[0] != "" {
+				name = tag[0]
+			}
+			attrs[strings.ToLower(name)] = true
+		}
+	}
+	add(reflect.TypeOf(task).Elem())
+	return attrs
+}
+
+// sortedNodes returns the nodes of g in the order they first appear in the
+// source.
+func sortedNodes(g *Graph) []*GraphNode {
+	var nodes []*GraphNode
+	for it := g.Nodes(); it.Next(); {
+		nodes = append(nodes, it.Node().(*GraphNode))
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+	return nodes
+}
+
+// unconnectedNodes returns the nodes outside the largest connected part of g,
+// ignoring the direction of edges. Of parts of the same size, the one declared
+// first is taken as the pipeline.
+func unconnectedNodes(g *Graph, nodes []*GraphNode) []*GraphNode {
+	part := map[int64]int{}
+	var sizes []int
+	for _, n := range nodes {
+		if _, seen := part[n.ID()]; seen {
+			continue
+		}
+		p := len(sizes)
+		sizes = append(sizes, 0)
+		stack := []int64{n.ID()}
+		part[n.ID()] = p
+		for len(stack) > 0 {
+			id := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			sizes[p]++
+			for _, it := range []graph.Nodes{g.From(id), g.To(id)} {
+				for it.Next() {
+					next := it.Node().ID()
+					if _, seen := part[next]; !seen {
+						part[next] = p
+						stack = append(stack, next)
+					}
+				}
+			}
+		}
+	}
+	largest := 0
+	for p, size := range sizes {
+		if size > sizes[largest] {
+			largest = p
+		}
+	}
+	var unconnected []*GraphNode
+	for _, n := range nodes {
+		if part[n.ID()] != largest {
+			unconnected = append(unconnected, n)
+		}
+	}
+	return unconnected
+}
+
+// Format returns source laid out canonically: one task per line with its
+// type first and the other attributes in alphabetical order, aligned, then
+// the edges as chains. Comments and implicit edges, which come from the
+// variables in attributes, are left out.
+func Format(source string) (string, error) {
+	if _, err := Parse(source); err != nil {
+		return "", err
+	}
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(source)); err != nil {
+		return "", err
+	}
+	nodes := sortedNodes(g)
+
+	width := 0
+	for _, n := range nodes {
+		if l := len(formatID(n.DOTID())); l > width {
+			width = l
+		}
+	}
+	var b strings.Builder
+	for _, n := range nodes {
+		attrs := []string{"type=" + formatValue(n.attrs["type"])}
+		for _, attr := range n.Attributes() {
+			if attr.Key != "type" {
+				attrs = append(attrs, formatID(attr.Key)+"="+formatValue(attr.Value))
+			}
+		}
+		prefix := fmt.Sprintf("%-*s [", width, formatID(n.DOTID()))
+		line := prefix + strings.Join(attrs, " ") + "];"
+		if len(line) > maxFormattedLineLength && len(attrs) > 1 {
+			line = prefix + strings.Join(attrs, "\n"+strings.Repeat(" ", len(prefix))) + "];"
+		}
+		b.WriteString(line + "\n")
+	}
+
+	// Chain the explicit edges, following the first remaining edge out of
+	// each task in turn.
+	remaining := map[[2]int64]bool{}
+	for _, n := range nodes {
+		for it := g.From(n.ID()); it.Next(); {
+			if !g.IsImplicitEdge(n.ID(), it.Node().ID()) {
+				remaining[[2]int64{n.ID(), it.Node().ID()}] = true
+			}
+		}
+	}
+	next := func(n *GraphNode) *GraphNode {
+		for _, to := range nodes {
+			if remaining[[2]int64{n.ID(), to.ID()}] {
+				delete(remaining, [2]int64{n.ID(), to.ID()})
+				return to
+			}
+		}
+		return nil
+	}
+	if len(remaining) > 0 {
+		b.WriteString("\n")
+	}
+	for _, n := range nodes {
+		for to := next(n); to != nil; to = next(n) {
+			chain := []string{formatID(n.DOTID())}
+			for ; to != nil; to = next(to) {
+				chain = append(chain, formatID(to.DOTID()))
+			}
+			b.WriteString(strings.Join(chain, " -> ") + ";\n")
+		}
+	}
+
+	formatted := b.String()
+	if err := sameGraph(source, formatted); err != nil {
+		return "", errors.Wrap(err, "formatting changed the pipeline")
+	}
+	return formatted, nil
+}
+
+func formatID(id string) string {
+	if dotIDRegexp.MatchString(id) {
+		return id
+	}
+	return strconv.Quote(id)
+}
+
+// formatValue quotes an attribute value so that it decodes to the same value.
+func formatValue(v string) string {
+	if dotIDRegexp.MatchString(v) || dotNumeralRegexp.MatchString(v) {
+		return v
+	}
+	if len(v) >= 2 && strings.HasPrefix(v, `"`) && strings.HasSuffix(v, `"`) {
+		if _, err := strconv.Unquote(v); err != nil {
+			// a quoted string which is not valid Go syntax is kept as written
+			return v
+		}
+	}
+	if strings.Contains(v, `"`) && !strings.ContainsAny(v, "<>\n") {
+		// JSON reads better in angle brackets than with escaped quotes
+		return "<" + v + ">"
+	}
+	return strconv.Quote(v)
+}
+
+// sameGraph returns an error unless a and b have the same tasks, attributes
+// and edges.
+func sameGraph(a, b string) error {
+	ga, gb := NewGraph(), NewGraph()
+	if err := ga.UnmarshalText([]byte(a)); err != nil {
+		return err
+	}
+	if err := gb.UnmarshalText([]byte(b)); err != nil {
+		return err
+	}
+	describe := func(g *Graph) []string {
+		var lines []string
+		for _, n := range sortedNodes(g) {
+			lines = append(lines, fmt.Sprintf("%s %v", n.DOTID(), n.Attributes()))
+			for it := g.From(n.ID()); it.Next(); {
+				to := it.Node().(*GraphNode)
+				lines = append(lines, fmt.Sprintf("%s -> %s %t", n.DOTID(), to.DOTID(), g.IsImplicitEdge(n.ID(), to.ID())))
+			}
+		}
+		sort.Strings(lines)
+		return lines
+	}
+	da, db := describe(ga), describe(gb)
+	if !reflect.DeepEqual(da, db) {
+		return errors.Errorf("expected %v, got %v", da, db)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{"valid", `
+ds        [type=http method=GET url="https://chain.link/voter_turnout/USA-2020" requestData=<{"hi": "hello"}> timeout="10s"];
+ds_parse  [type=jsonparse path="data,result" data="$(ds)"];
+ds_submit [type=bridge name=submit requestData=<{"id": $(jobSpec.externalJobID), "value": $(ds_parse)}>];
+ds -> ds_parse -> ds_submit;
+`, nil},
+		{"unknown attribute", `
+ds [type=http method=GET url="https://chain.link" retries=3 ur="https://chain.link"];
+`, []string{`task ds: http tasks have no attribute "ur", so it is ignored`}},
+		{"unknown variable", `
+ds       [type=http method=GET url="https://chain.link"];
+ds_parse [type=jsonparse path="data" data="$(ds)" lax="$( jobSpc.name )"];
+ds -> ds_parse;
+`, []string{`task ds_parse: $( jobSpc.name ) refers to "jobSpc", which is neither a task nor a variable of the job`}},
+		{"unconnected", `
+ds1       [type=http method=GET url="https://chain.link/1"];
+ds1_parse [type=jsonparse path="data"];
+ds2       [type=http method=GET url="https://chain.link/2"];
+ds1 -> ds1_parse;
+`, []string{"task ds2 is not connected to the rest of the pipeline"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := pipeline.Lint(tt.source, "jobSpec", "jobRun")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, problems)
+		})
+	}
+
+	_, err := pipeline.Lint(`ds [type=nope];`)
+	assert.Error(t, err)
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	source := `
+// fetch
+ds1 [type=bridge name="voter_turnout" timeout="10s"]; ds1_parse [path="one,two" type=jsonparse];
+  ds1_multiply [type=multiply times=1.23 input="$(ds1_parse)"]
+ds2 [type="http" method=GET url="https://chain.link/voter_turnout/USA-2020" requestData="{\"hi\": \"hello\"}"];
+ds2_parse [type=jsonparse path="three,four"]
+ds1->ds1_parse ->ds1_multiply->answer1
+ds2 -> ds2_parse
+ds2_parse -> answer1
+"answer-1" [type=median];
+answer1 [type=median index=0 allowedFaults=1];
+`
+	want := `ds1          [type=bridge name=voter_turnout timeout="10s"];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply input="$(ds1_parse)" times=1.23];
+ds2          [type=http
+              method=GET
+              requestData=<{"hi": "hello"}>
+              url="https://chain.link/voter_turnout/USA-2020"];
+ds2_parse    [type=jsonparse path="three,four"];
+answer1      [type=median allowedFaults=1 index=0];
+"answer-1"   [type=median];
+
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+ds2 -> ds2_parse -> answer1;
+`
+	got, err := pipeline.Format(source)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	again, err := pipeline.Format(got)
+	require.NoError(t, err)
+	assert.Equal(t, got, again)
+
+	long := `submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode)" gasLimit=500000 minConfirmations=2];
+encode [type=ethabiencode abi="fulfill(bytes32 requestId, uint256 data)" data=<{"requestId": $(jobRun.meta), "data": 1}>];
+encode -> submit;`
+	got, err = pipeline.Format(long)
+	require.NoError(t, err)
+	assert.Equal(t, `submit [type=ethtx
+        data="$(encode)"
+        gasLimit=500000
+        minConfirmations=2
+        to="0x613a38AC1659769640aaE063C651F48E0250454C"];
+encode [type=ethabiencode
+        abi="fulfill(bytes32 requestId, uint256 data)"
+        data=<{"requestId": $(jobRun.meta), "data": 1}>];
+
+encode -> submit;
+`, got)
+
+	_, err = pipeline.Format(`ds1 -> ds2`)
+	assert.Error(t, err)
+}
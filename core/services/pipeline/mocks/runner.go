@@ -148,9 +148,20 @@ func (_m *Runner) InsertFinishedRuns(runs []*pipeline.Run, saveSuccessfulTaskRun
 	return r0
 }
 
-// OnRunFinished provides a mock function with given fields: _a0
-func (_m *Runner) OnRunFinished(_a0 func(*pipeline.Run)) {
-	_m.Called(_a0)
+// NotifyRunsFinished provides a mock function with given fields: runs
+func (_m *Runner) NotifyRunsFinished(runs ...*pipeline.Run) {
+	_va := make([]interface{}, len(runs))
+	for _i := range runs {
+		_va[_i] = runs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// OnRunFinished provides a mock function with given fields: fn
+func (_m *Runner) OnRunFinished(fn func(*pipeline.Run)) {
+	_m.Called(fn)
 }
 
 // Ready provides a mock function with given fields:
@@ -167,6 +178,27 @@ func (_m *Runner) Ready() error {
 	return r0
 }
 
+// ReplayRun provides a mock function with given fields: ctx, run, spec, l
+func (_m *Runner) ReplayRun(ctx context.Context, run pipeline.Run, spec *pipeline.Spec, l logger.Logger) (pipeline.ReplayResult, error) {
+	ret := _m.Called(ctx, run, spec, l)
+
+	var r0 pipeline.ReplayResult
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, *pipeline.Spec, logger.Logger) pipeline.ReplayResult); ok {
+		r0 = rf(ctx, run, spec, l)
+	} else {
+		r0 = ret.Get(0).(pipeline.ReplayResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run, *pipeline.Spec, logger.Logger) error); ok {
+		r1 = rf(ctx, run, spec, l)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeRun provides a mock function with given fields: taskID, value, err
 func (_m *Runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(taskID, value, err)
@@ -0,0 +1,197 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ErrNoRecordedResult is returned by a replayed task whose result was not
+// stored in the original run.
+var ErrNoRecordedResult = errors.New("no recorded result for task")
+
+// recordedTaskTypes are the task types that talk to external systems. When a
+// run is replayed, these return the results recorded in the original run
+// instead of executing again, which makes the replay deterministic and
+// guarantees that no transaction is sent twice.
+var recordedTaskTypes = map[TaskType]bool{
+	TaskTypeHTTP:             true,
+	TaskTypeBridge:           true,
+	TaskTypeETHCall:          true,
+	TaskTypeEstimateGasLimit: true,
+	TaskTypeETHTx:            true,
+	TaskTypeTimestamp:        true,
+}
+
+// recordedTask wraps a task and returns the result of a previous run of it.
+type recordedTask struct {
+	Task
+	taskRun *TaskRun
+}
+
+func (t *recordedTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	if t.taskRun == nil {
+		return Result{Error: errors.Wrapf(ErrNoRecordedResult, "%s", t.DotID())}, RunInfo{}
+	}
+	if t.taskRun.Error.Valid {
+		return Result{Error: errors.New(t.taskRun.Error.String)}, RunInfo{}
+	}
+	return Result{Value: t.taskRun.Output.Val}, RunInfo{}
+}
+
+// TaskRetries is always zero: a recorded result never changes.
+func (t *recordedTask) TaskRetries() uint32 {
+	return 0
+}
+
+// TaskRunDiff compares the result of a task in the original run with its
+// result in the replay.
+type TaskRunDiff struct {
+	DotID string   `json:"dotId"`
+	Type  TaskType `json:"type"`
+	// Recorded is true if the replay used the original run's result for this
+	// task rather than executing it.
+	Recorded bool     `json:"recorded"`
+	Original *TaskRun `json:"original"`
+	Replayed *TaskRun `json:"replayed"`
+	Changed  bool     `json:"changed"`
+}
+
+// ReplayResult is the outcome of re-executing a stored run.
+type ReplayResult struct {
+	Original Run           `json:"original"`
+	Replayed Run           `json:"replayed"`
+	Diffs    []TaskRunDiff `json:"diffs"`
+}
+
+// Changed returns true if any task's output or error differs from the original run.
+func (rr ReplayResult) Changed() bool {
+	for _, diff := range rr.Diffs {
+		if diff.Changed {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplayRun re-executes the given run in memory with its original inputs. Tasks
+// of the types in recordedTaskTypes return their recorded results instead of
+// calling out. If spec is nil, the run's own pipeline spec is used; otherwise
+// the run is replayed against the given spec, which may be a newer version of
+// the job's pipeline. Nothing is written to the database, nor reported to
+// prometheus.
+func (r *runner) ReplayRun(ctx context.Context, original Run, spec *Spec, l logger.Logger) (ReplayResult, error) {
+	if len(original.PipelineTaskRuns) == 0 {
+		return ReplayResult{}, errors.Errorf("run %d has no recorded task runs: successful runs are only recorded if the job saves its task runs", original.ID)
+	}
+
+	replaySpec := original.PipelineSpec
+	if spec != nil {
+		replaySpec.DotDagSource = spec.DotDagSource
+		if spec.ID != 0 {
+			replaySpec.ID = spec.ID
+		}
+	}
+
+	inputs, _ := original.Inputs.Val.(map[string]interface{})
+	run := NewRun(replaySpec, NewVarsFrom(inputs))
+
+	p, err := r.initializePipeline(&run)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+
+	recorded := make(map[string]*TaskRun, len(original.PipelineTaskRuns))
+	for i := range original.PipelineTaskRuns {
+		recorded[original.PipelineTaskRuns[i].DotID] = &original.PipelineTaskRuns[i]
+	}
+	for _, task := range p.Tasks {
+		if recordedTaskTypes[task.Type()] {
+			p.replaceTask(task, &recordedTask{Task: task, taskRun: recorded[task.DotID()]})
+		}
+	}
+
+	if _, err = r.run(ctx, p, &run, NewVarsFrom(inputs), l, false); err != nil {
+		return ReplayResult{}, err
+	}
+
+	return ReplayResult{
+		Original: original,
+		Replayed: run,
+		Diffs:    diffTaskRuns(original.PipelineTaskRuns, run.PipelineTaskRuns, p),
+	}, nil
+}
+
+// replaceTask swaps old for replacement everywhere in the graph.
+func (p *Pipeline) replaceTask(old, replacement Task) {
+	for i, task := range p.Tasks {
+		if task == old {
+			p.Tasks[i] = replacement
+		}
+		base := task.Base()
+		for j := range base.outputs {
+			if base.outputs[j] == old {
+				base.outputs[j] = replacement
+			}
+		}
+		for j := range base.inputs {
+			if base.inputs[j].InputTask == old {
+				base.inputs[j].InputTask = replacement
+			}
+		}
+	}
+}
+
+func diffTaskRuns(original, replayed []TaskRun, p *Pipeline) []TaskRunDiff {
+	var diffs []TaskRunDiff
+	seen := make(map[string]bool)
+	replayedByDotID := make(map[string]*TaskRun, len(replayed))
+	for i := range replayed {
+		replayedByDotID[replayed[i].DotID] = &replayed[i]
+	}
+
+	for i := range original {
+		o := &original[i]
+		seen[o.DotID] = true
+		diff := TaskRunDiff{DotID: o.DotID, Type: o.Type, Original: o}
+		if rtr, exists := replayedByDotID[o.DotID]; exists {
+			diff.Replayed = rtr
+			diff.Changed = !sameTaskRunResult(*o, *rtr)
+		} else {
+			diff.Changed = true
+		}
+		if task := p.ByDotID(o.DotID); task != nil {
+			_, diff.Recorded = task.(*recordedTask)
+		}
+		diffs = append(diffs, diff)
+	}
+
+	// Tasks that only exist in the replayed spec, or that did not run originally
+	for i := range replayed {
+		rtr := &replayed[i]
+		if seen[rtr.DotID] {
+			continue
+		}
+		_, isRecorded := p.ByDotID(rtr.DotID).(*recordedTask)
+		diffs = append(diffs, TaskRunDiff{DotID: rtr.DotID, Type: rtr.Type, Recorded: isRecorded, Replayed: rtr, Changed: true})
+	}
+	return diffs
+}
+
+func sameTaskRunResult(a, b TaskRun) bool {
+	if a.Error != b.Error {
+		return false
+	}
+	if a.Output.Valid != b.Output.Valid {
+		return false
+	}
+	if !a.Output.Valid {
+		return true
+	}
+	aJSON, errA := a.Output.MarshalJSON()
+	bJSON, errB := b.Output.MarshalJSON()
+	return errA == nil && errB == nil && bytes.Equal(aJSON, bJSON)
+}
@@ -0,0 +1,129 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := new(mocks.ORM)
+	orm.On("GetQ").Return(pg.Q{})
+	r := pipeline.NewRunner(orm, cfg, nil, nil, nil, logger.TestLogger(t))
+	lggr := logger.TestLogger(t)
+
+	// The http task points at an unroutable URL: a replay must never call it.
+	const dag = `
+ds      [type=http method=GET url="http://192.0.2.1/price"]
+parse   [type=jsonparse path="data,result"]
+answer  [type=multiply times=100]
+ds -> parse -> answer
+`
+	original := pipeline.Run{
+		ID:           42,
+		PipelineSpec: pipeline.Spec{ID: 1, DotDagSource: dag},
+		Inputs:       pipeline.JSONSerializable{Val: map[string]interface{}{"jobRun": map[string]interface{}{}}, Valid: true},
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds", Type: pipeline.TaskTypeHTTP, Output: pipeline.JSONSerializable{Val: `{"data":{"result":1.5}}`, Valid: true}},
+			{DotID: "parse", Type: pipeline.TaskTypeJSONParse, Output: pipeline.JSONSerializable{Val: 1.5, Valid: true}},
+			{DotID: "answer", Type: pipeline.TaskTypeMultiply, Output: pipeline.JSONSerializable{Val: "150", Valid: true}},
+		},
+	}
+
+	t.Run("same spec reproduces the original run", func(t *testing.T) {
+		result, err := r.ReplayRun(context.Background(), original, nil, lggr)
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RunStatusCompleted, result.Replayed.State)
+		assert.False(t, result.Changed())
+		require.Len(t, result.Diffs, 3)
+		for _, diff := range result.Diffs {
+			assert.Equal(t, diff.DotID == "ds", diff.Recorded, diff.DotID)
+			assert.False(t, diff.Changed, diff.DotID)
+		}
+	})
+
+	t.Run("newer spec is diffed against the original run", func(t *testing.T) {
+		spec := &pipeline.Spec{DotDagSource: `
+ds      [type=http method=GET url="http://192.0.2.1/price"]
+parse   [type=jsonparse path="data,result"]
+answer  [type=multiply times=1000]
+ds -> parse -> answer
+`}
+		result, err := r.ReplayRun(context.Background(), original, spec, lggr)
+		require.NoError(t, err)
+
+		assert.True(t, result.Changed())
+		changed := map[string]bool{}
+		for _, diff := range result.Diffs {
+			changed[diff.DotID] = diff.Changed
+		}
+		assert.Equal(t, map[string]bool{"ds": false, "parse": false, "answer": true}, changed)
+		assert.Equal(t, "1500", taskRunByDotID(result.Replayed, "answer").Output.Val.(decimal.Decimal).String())
+	})
+
+	t.Run("recorded errors are replayed", func(t *testing.T) {
+		errored := original
+		errored.PipelineTaskRuns = []pipeline.TaskRun{
+			{DotID: "ds", Type: pipeline.TaskTypeHTTP, Error: null.StringFrom("429 Too Many Requests")},
+			{DotID: "parse", Type: pipeline.TaskTypeJSONParse, Error: null.StringFrom("task inputs: too many errors")},
+			{DotID: "answer", Type: pipeline.TaskTypeMultiply, Error: null.StringFrom("task inputs: too many errors")},
+		}
+		result, err := r.ReplayRun(context.Background(), errored, nil, lggr)
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RunStatusErrored, result.Replayed.State)
+		assert.Equal(t, null.StringFrom("429 Too Many Requests"), taskRunByDotID(result.Replayed, "ds").Error)
+	})
+
+	t.Run("tasks missing from the recording fail", func(t *testing.T) {
+		partial := original
+		partial.PipelineTaskRuns = original.PipelineTaskRuns[1:]
+		result, err := r.ReplayRun(context.Background(), partial, nil, lggr)
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RunStatusErrored, result.Replayed.State)
+		assert.Contains(t, taskRunByDotID(result.Replayed, "ds").Error.String, pipeline.ErrNoRecordedResult.Error())
+	})
+
+	t.Run("replays are not reported to prometheus", func(t *testing.T) {
+		replayed := original
+		replayed.PipelineSpec.JobID = 4242
+		replayed.PipelineSpec.JobName = "replayed"
+		_, err := r.ReplayRun(context.Background(), replayed, nil, lggr)
+		require.NoError(t, err)
+
+		finished := pipeline.PromPipelineTasksTotalFinished.WithLabelValues("4242", "replayed", "answer", string(pipeline.TaskTypeMultiply), "completed")
+		assert.Equal(t, float64(0), testutil.ToFloat64(finished))
+	})
+
+	t.Run("runs without task runs cannot be replayed", func(t *testing.T) {
+		empty := original
+		empty.PipelineTaskRuns = nil
+		_, err := r.ReplayRun(context.Background(), empty, nil, lggr)
+		require.Error(t, err)
+	})
+}
+
+func taskRunByDotID(run pipeline.Run, dotID string) pipeline.TaskRun {
+	for _, tr := range run.PipelineTaskRuns {
+		if tr.DotID == dotID {
+			return tr
+		}
+	}
+	return pipeline.TaskRun{}
+}
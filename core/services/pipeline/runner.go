@@ -37,6 +37,9 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
+	// If qopts carry the caller's own queryer, the OnRunFinished callbacks are
+	// not called, as the transaction may yet roll back: the caller calls
+	// NotifyRunsFinished once it has committed.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
 	InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
 
@@ -45,7 +48,17 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
-	OnRunFinished(func(*Run))
+	// ReplayRun re-executes a stored run in memory, using the recorded results
+	// of tasks that call out to external systems, and diffs every task's result
+	// against the original. If spec is non-nil the run is replayed against it.
+	ReplayRun(ctx context.Context, run Run, spec *Spec, l logger.Logger) (ReplayResult, error)
+
+	// OnRunFinished registers fn to be called whenever a run is stored in a
+	// finished or suspended state.
+	OnRunFinished(fn func(*Run))
+	// NotifyRunsFinished calls the OnRunFinished callbacks for runs inserted
+	// within the caller's transaction, after it has committed.
+	NotifyRunsFinished(runs ...*Run)
 }
 
 type runner struct {
@@ -57,8 +70,8 @@ type runner struct {
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
 
-	// test helper
-	runFinished func(*Run)
+	runFinishedMu sync.RWMutex
+	runFinished   []func(*Run)
 
 	utils.StartStopOnce
 	chStop chan struct{}
@@ -104,7 +117,6 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 		vrfKeyStore: vrfks,
 		chStop:      make(chan struct{}),
 		wgDone:      sync.WaitGroup{},
-		runFinished: func(*Run) {},
 		lggr:        lggr.Named("PipelineRunner"),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
@@ -186,7 +198,19 @@ func NewRun(spec Spec, vars Vars) Run {
 }
 
 func (r *runner) OnRunFinished(fn func(*Run)) {
-	r.runFinished = fn
+	r.runFinishedMu.Lock()
+	defer r.runFinishedMu.Unlock()
+	r.runFinished = append(r.runFinished, fn)
+}
+
+func (r *runner) NotifyRunsFinished(runs ...*Run) {
+	r.runFinishedMu.RLock()
+	defer r.runFinishedMu.RUnlock()
+	for _, fn := range r.runFinished {
+		for _, run := range runs {
+			fn(run)
+		}
+	}
 }
 
 // Be careful with the ctx passed in here: it applies to requests in individual
@@ -205,7 +229,7 @@ func (r *runner) ExecuteRun(
 		return run, nil, err
 	}
 
-	taskRunResults, err := r.run(ctx, pipeline, &run, vars, l)
+	taskRunResults, err := r.run(ctx, pipeline, &run, vars, l, true)
 	if err != nil {
 		return run, nil, err
 	}
@@ -258,12 +282,15 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 	return pipeline, nil
 }
 
+// run executes the pipeline of run. Unless recordMetrics is false, as for
+// replays, the run and its tasks are reported to prometheus.
 func (r *runner) run(
 	ctx context.Context,
 	pipeline *Pipeline,
 	run *Run,
 	vars Vars,
 	l logger.Logger,
+	recordMetrics bool,
 ) (TaskRunResults, error) {
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
@@ -287,7 +314,9 @@ func (r *runner) run(
 		go recovery.WrapRecoverHandle(l, func() {
 			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if recordMetrics {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -313,7 +342,9 @@ func (r *runner) run(
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime := run.FinishedAt.Time.Sub(run.CreatedAt)
 		l.Debugw("Finished all tasks for pipeline run", "specID", run.PipelineSpecID, "runTime", runTime)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if recordMetrics {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -360,7 +391,9 @@ func (r *runner) run(
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			if recordMetrics {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -461,6 +494,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err = r.orm.InsertFinishedRun(&run, saveSuccessfulTaskRuns); err != nil {
 		return 0, finalResult, errors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
+	r.NotifyRunsFinished(&run)
 	return run.ID, finalResult, nil
 
 }
@@ -508,7 +542,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	}
 
 	for {
-		if _, err = r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l); err != nil {
+		if _, err = r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l, true); err != nil {
 			return false, errors.Wrapf(err, "failed to run for spec ID %v", run.PipelineSpec.ID)
 		}
 
@@ -546,7 +580,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 			}
 		}
 
-		r.runFinished(run)
+		r.NotifyRunsFinished(run)
 
 		return run.Pending, err
 	}
@@ -576,11 +610,33 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 }
 
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	if !hasQueryer(qopts) {
+		r.NotifyRunsFinished(run)
+	}
+	return nil
 }
 
 func (r *runner) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	if !hasQueryer(qopts) {
+		r.NotifyRunsFinished(runs...)
+	}
+	return nil
+}
+
+// hasQueryer returns true if qopts set the queryer, typically the caller's
+// transaction.
+func hasQueryer(qopts []pg.QOpt) bool {
+	q := pg.Q{ParentCtx: context.Background()}
+	for _, opt := range qopts {
+		opt(&q)
+	}
+	return q.Queryer != nil
 }
 
 func (r *runner) runReaper() {
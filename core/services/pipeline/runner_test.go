@@ -811,3 +811,28 @@ a [type=uppercase input="$(first)"]
 	require.NoError(t, err)
 	assert.Equal(t, "SOMERANDOMTEST", result.Value.(string))
 }
+
+func Test_PipelineRunner_InsertFinishedRun_NotifiesRunFinished(t *testing.T) {
+	orm := new(mocks.ORM)
+	orm.Test(t)
+	orm.On("InsertFinishedRun", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	orm.On("InsertFinishedRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	r := pipeline.NewRunner(orm, cltest.NewTestGeneralConfig(t), nil, nil, nil, logger.TestLogger(t))
+
+	var notified []*pipeline.Run
+	r.OnRunFinished(func(run *pipeline.Run) {
+		notified = append(notified, run)
+	})
+
+	run := &pipeline.Run{ID: 1}
+	require.NoError(t, r.InsertFinishedRun(run, false, pg.WithParentCtx(context.Background())))
+	assert.Equal(t, []*pipeline.Run{run}, notified)
+
+	// the caller's transaction may still roll back, so it notifies once committed
+	notified = nil
+	inTx := &pipeline.Run{ID: 2}
+	require.NoError(t, r.InsertFinishedRun(inTx, false, pg.WithQueryer(&sqlx.Tx{}), pg.WithParentCtx(context.Background())))
+	assert.Empty(t, notified)
+	r.NotifyRunsFinished(inTx)
+	assert.Equal(t, []*pipeline.Run{inTx}, notified)
+}
@@ -0,0 +1,55 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     []byte
+//
+// Both the standard and the URL-safe alphabets are accepted, with or without padding.
+//
+type Base64DecodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*Base64DecodeTask)(nil)
+
+func (t *Base64DecodeTask) Type() TaskType {
+	return TaskTypeBase64Decode
+}
+
+func (t *Base64DecodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input StringParam
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	s := strings.TrimRight(strings.TrimSpace(string(input)), "=")
+	encoding := base64.RawStdEncoding
+	if strings.ContainsAny(s, "-_") {
+		encoding = base64.RawURLEncoding
+	}
+	bs, err := encoding.DecodeString(s)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "Base64Decode: input: %v", err)}, runInfo
+	}
+	return Result{Value: bs}, runInfo
+}
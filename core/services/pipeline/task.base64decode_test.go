@@ -0,0 +1,46 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestBase64DecodeTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          string
+		want           []byte
+		wantErrorCause error
+	}{
+		{"padded", "aGVsbG8=", []byte("hello"), nil},
+		{"unpadded", "aGVsbG8", []byte("hello"), nil},
+		{"standard alphabet", "+/8=", []byte{0xfb, 0xff}, nil},
+		{"url safe alphabet", "-_8", []byte{0xfb, 0xff}, nil},
+		{"invalid", "a*b", nil, pipeline.ErrBadInput},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.Base64DecodeTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string
+//
+type Base64EncodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+	URLSafe  string `json:"urlSafe"`
+}
+
+var _ Task = (*Base64EncodeTask)(nil)
+
+func (t *Base64EncodeTask) Type() TaskType {
+	return TaskTypeBase64Encode
+}
+
+func (t *Base64EncodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		input   BytesParam
+		urlSafe BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&urlSafe, From(NonemptyString(t.URLSafe), false)), "urlSafe"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if bool(urlSafe) {
+		return Result{Value: base64.URLEncoding.EncodeToString(input)}, runInfo
+	}
+	return Result{Value: base64.StdEncoding.EncodeToString(input)}, runInfo
+}
@@ -0,0 +1,41 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestBase64EncodeTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		urlSafe string
+		want    string
+	}{
+		{"string", "hello", "", "aGVsbG8="},
+		{"hex string", "0xfbff", "", "+/8="},
+		{"bytes", []byte{0xfb, 0xff}, "", "+/8="},
+		{"url safe", []byte{0xfb, 0xff}, "true", "-_8="},
+		{"empty", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.Base64EncodeTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), URLSafe: test.urlSafe}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
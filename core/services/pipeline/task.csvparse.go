@@ -0,0 +1,212 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string                  (column and row both set)
+//     []interface{}           (only column set: every value of that column)
+//     map[string]interface{}  (only row set, with header: the row keyed by column name)
+//     []interface{}           (only row set, without header: the row's values)
+//
+// Row selector:
+//     "2"          the third data row (header excluded)
+//     "-1"         the last data row
+//     "symbol=ETH" the first data row whose "symbol" column equals "ETH"
+//
+// The delimiter defaults to "," and may be given as `\t` for tab-separated data.
+//
+type CSVParseTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Data      string `json:"data"`
+	Column    string `json:"column"`
+	Row       string `json:"row"`
+	Delimiter string `json:"delimiter"`
+	Header    string `json:"header"`
+	// Lax when disabled will return an error if the row or column does not exist
+	// Lax when enabled will return nil with no error if the row or column does not exist
+	Lax string
+}
+
+var _ Task = (*CSVParseTask)(nil)
+
+func (t *CSVParseTask) Type() TaskType {
+	return TaskTypeCSVParse
+}
+
+func (t *CSVParseTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		data      BytesParam
+		column    StringParam
+		row       StringParam
+		delimiter StringParam
+		header    BoolParam
+		lax       BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&column, From(VarExpr(t.Column, vars), NonemptyString(t.Column), "")), "column"),
+		errors.Wrap(ResolveParam(&row, From(VarExpr(t.Row, vars), NonemptyString(t.Row), "")), "row"),
+		errors.Wrap(ResolveParam(&delimiter, From(NonemptyString(t.Delimiter), ",")), "delimiter"),
+		errors.Wrap(ResolveParam(&header, From(NonemptyString(t.Header), true)), "header"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if column == "" && row == "" {
+		return Result{Error: errors.Wrap(ErrParameterEmpty, "at least one of column or row must be set")}, runInfo
+	}
+	if delimiter == `\t` {
+		delimiter = "\t"
+	}
+	comma, size := utf8.DecodeRuneInString(string(delimiter))
+	if size != len(delimiter) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "delimiter must be a single character, got %q", string(delimiter))}, runInfo
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "CSVParse: data: %v", err)}, runInfo
+	}
+
+	var headers []string
+	if bool(header) {
+		if len(records) == 0 {
+			return Result{Error: errors.Wrap(ErrBadInput, "CSVParse: data: missing header row")}, runInfo
+		}
+		headers, records = records[0], records[1:]
+	}
+
+	notFound := func() (Result, RunInfo) {
+		if bool(lax) {
+			return Result{Value: nil}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve column "%s" row "%s" in %s`, column, row, data)}, runInfo
+	}
+
+	colIndex := -1
+	if column != "" {
+		colIndex = csvColumnIndex(headers, string(column))
+		if colIndex < 0 {
+			return notFound()
+		}
+	}
+
+	if row == "" {
+		values := make([]interface{}, 0, len(records))
+		for _, record := range records {
+			if colIndex >= len(record) {
+				if bool(lax) {
+					values = append(values, nil)
+					continue
+				}
+				return notFound()
+			}
+			values = append(values, record[colIndex])
+		}
+		return Result{Value: values}, runInfo
+	}
+
+	record, err := csvSelectRow(headers, records, string(row))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if record == nil {
+		return notFound()
+	}
+
+	if colIndex >= 0 {
+		if colIndex >= len(record) {
+			return notFound()
+		}
+		return Result{Value: record[colIndex]}, runInfo
+	}
+
+	if headers == nil {
+		values := make([]interface{}, len(record))
+		for i, v := range record {
+			values[i] = v
+		}
+		return Result{Value: values}, runInfo
+	}
+	m := make(map[string]interface{}, len(headers))
+	for i, name := range headers {
+		if i < len(record) {
+			m[name] = record[i]
+		} else {
+			m[name] = nil
+		}
+	}
+	return Result{Value: m}, runInfo
+}
+
+// csvColumnIndex resolves a column by header name first, falling back to a
+// zero-based numeric index. It returns -1 when the column cannot be resolved.
+func csvColumnIndex(headers []string, column string) int {
+	for i, name := range headers {
+		if strings.TrimSpace(name) == column {
+			return i
+		}
+	}
+	i, err := strconv.Atoi(column)
+	if err != nil || i < 0 {
+		return -1
+	}
+	if headers != nil && i >= len(headers) {
+		return -1
+	}
+	return i
+}
+
+// csvSelectRow returns the record matching the given row selector, or nil if
+// there is no such row.
+func csvSelectRow(headers []string, records [][]string, selector string) ([]string, error) {
+	if key, value, isMatch := strings.Cut(selector, "="); isMatch {
+		if headers == nil {
+			return nil, errors.Wrapf(ErrBadInput, `row selector "%s" requires a header row`, selector)
+		}
+		colIndex := csvColumnIndex(headers, strings.TrimSpace(key))
+		if colIndex < 0 {
+			return nil, nil
+		}
+		for _, record := range records {
+			if colIndex < len(record) && record[colIndex] == value {
+				return record, nil
+			}
+		}
+		return nil, nil
+	}
+
+	index, err := strconv.Atoi(selector)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, `row selector "%s" must be an index or column=value`, selector)
+	}
+	if index < 0 {
+		index = len(records) + index
+	}
+	if index < 0 || index >= len(records) {
+		return nil, nil
+	}
+	return records[index], nil
+}
@@ -0,0 +1,91 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestCSVParseTask(t *testing.T) {
+	t.Parallel()
+
+	const prices = "symbol,price,volume\nBTC,41000.5,120\nETH,3000.25,900\nLINK,14.1,5000\n"
+
+	tests := []struct {
+		name              string
+		data              string
+		column            string
+		row               string
+		delimiter         string
+		header            string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"column name and row index", "", "price", "1", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, "3000.25", nil, ""},
+		{"column index and negative row index", "", "2", "-1", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, "5000", nil, ""},
+		{"row matched by column value", "", "price", "symbol=LINK", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, "14.1", nil, ""},
+		{"whole column", "", "symbol", "", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, []interface{}{"BTC", "ETH", "LINK"}, nil, ""},
+		{"whole row with header", "", "", "0", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}},
+			map[string]interface{}{"symbol": "BTC", "price": "41000.5", "volume": "120"}, nil, ""},
+		{"whole row without header", "", "", "0", "", "false", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}},
+			[]interface{}{"symbol", "price", "volume"}, nil, ""},
+		{"custom delimiter", "", "b", "0", ";", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "a;b\n1;2\n"}}, "2", nil, ""},
+		{"tab delimiter", "", "b", "0", `\t`, "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "a\tb\n1\t2\n"}}, "2", nil, ""},
+		{"quoted fields", "", "name", "0", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "name,value\n\"a, b\",1\n"}}, "a, b", nil, ""},
+		{"data and selectors from vars", "$(foo.bar)", "$(col)", "$(row)", "", "", "",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": map[string]interface{}{"bar": prices},
+				"col": "volume",
+				"row": "symbol=BTC",
+			}),
+			[]pipeline.Result{}, "120", nil, ""},
+		{"missing column", "", "marketcap", "0", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, pipeline.ErrKeypathNotFound, "marketcap"},
+		{"missing column (lax)", "", "marketcap", "0", "", "", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, nil, ""},
+		{"row out of range", "", "price", "3", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, pipeline.ErrKeypathNotFound, "row"},
+		{"row out of range (lax)", "", "price", "3", "", "", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, nil, ""},
+		{"no matching row (lax)", "", "price", "symbol=DOGE", "", "", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, nil, ""},
+		{"malformed row selector", "", "price", "first", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, pipeline.ErrBadInput, "row selector"},
+		{"malformed csv", "", "a", "0", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "a,b\n\"1,2\n"}}, nil, pipeline.ErrBadInput, "CSVParse"},
+		{"neither column nor row", "", "", "", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, pipeline.ErrParameterEmpty, "column or row"},
+		{"malformed 'lax' param", "", "price", "0", "", "", "sergey", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: prices}}, nil, pipeline.ErrBadInput, "lax"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.CSVParseTask{
+				BaseTask:  pipeline.NewBaseTask(0, "csv", nil, nil, 0),
+				Data:      test.data,
+				Column:    test.column,
+				Row:       test.row,
+				Delimiter: test.delimiter,
+				Header:    test.header,
+				Lax:       test.lax,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 //
@@ -157,6 +158,10 @@ func decodeMeta(metaMap MapParam) (*txmgr.EthTxMeta, error) {
 					return int32(i), err2
 				case reflect.TypeOf(common.Hash{}):
 					return common.HexToHash(data.(string)), nil
+				case reflect.TypeOf(utils.Big{}):
+					var b utils.Big
+					err2 := b.UnmarshalText([]byte(data.(string)))
+					return b, err2
 				}
 			}
 			return data, nil
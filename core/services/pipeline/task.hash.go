@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string (0x-prefixed hex, usable as a bytes32 argument to ethabiencode)
+//
+// Hex-encoded inputs (such as the output of ethabiencode) are decoded before
+// hashing, so the hash is computed over the raw bytes.
+//
+type HashTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Algorithm string `json:"algorithm"`
+}
+
+var _ Task = (*HashTask)(nil)
+
+func (t *HashTask) Type() TaskType {
+	return TaskTypeHash
+}
+
+func (t *HashTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		input     BytesParam
+		algorithm StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&algorithm, From(NonemptyString(t.Algorithm), "keccak256")), "algorithm"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	switch algorithm {
+	case "keccak256":
+		return Result{Value: hexutil.Encode(crypto.Keccak256(input))}, runInfo
+	case "sha256":
+		sum := sha256.Sum256(input)
+		return Result{Value: hexutil.Encode(sum[:])}, runInfo
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "unsupported algorithm: %s", algorithm)}, runInfo
+	}
+}
@@ -0,0 +1,65 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestHashTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          interface{}
+		algorithm      string
+		want           string
+		wantErrorCause error
+	}{
+		{"keccak256 by default", "hello", "", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", nil},
+		{"keccak256 of hex input", "0x68656c6c6f", "keccak256", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", nil},
+		{"keccak256 of bytes", []byte("hello"), "keccak256", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", nil},
+		{"sha256", "hello", "sha256", "0x2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", nil},
+		{"unsupported algorithm", "hello", "md5", "", pipeline.ErrBadInput},
+		{"bad input", 42, "", "", pipeline.ErrBadInput},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.HashTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Algorithm: test.algorithm}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+
+	t.Run("as a bytes32 argument to ethabiencode", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": "hello"})
+		hashTask := pipeline.HashTask{BaseTask: pipeline.NewBaseTask(0, "hash", nil, nil, 0), Input: "$(foo)"}
+		hashed, _ := hashTask.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, hashed.Error)
+
+		vars = pipeline.NewVarsFrom(map[string]interface{}{"hash": hashed.Value})
+		encodeTask := pipeline.ETHABIEncodeTask{
+			BaseTask: pipeline.NewBaseTask(1, "encode", nil, nil, 0),
+			ABI:      "(bytes32 h)",
+			Data:     `{ "h": $(hash) }`,
+		}
+		encoded, _ := encodeTask.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, encoded.Error)
+		require.Equal(t, hashed.Value, encoded.Value)
+	})
+}
@@ -0,0 +1,53 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//
+// Return types:
+//     []byte
+//
+type HexDecodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*HexDecodeTask)(nil)
+
+func (t *HexDecodeTask) Type() TaskType {
+	return TaskTypeHexDecode
+}
+
+func (t *HexDecodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input StringParam
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	s := utils.RemoveHexPrefix(strings.TrimSpace(string(input)))
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	bs, err := hex.DecodeString(s)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "HexDecode: input: %v", err)}, runInfo
+	}
+	return Result{Value: bs}, runInfo
+}
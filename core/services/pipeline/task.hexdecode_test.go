@@ -0,0 +1,48 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestHexDecodeTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		want           []byte
+		wantErrorCause error
+	}{
+		{"with prefix", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "0x68656c6c6f"}}, []byte("hello"), nil},
+		{"without prefix", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "dead"}}, []byte{0xde, 0xad}, nil},
+		{"odd length", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "0xfff"}}, []byte{0x0f, 0xff}, nil},
+		{"from vars", "$(foo)", pipeline.NewVarsFrom(map[string]interface{}{"foo": "0x01"}), nil, []byte{0x01}, nil},
+		{"not hex", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "0xzz"}}, nil, pipeline.ErrBadInput},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.HexDecodeTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: test.input}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string (0x-prefixed hex)
+//
+// Strings and byte arrays are encoded byte for byte; numbers must be
+// non-negative integers and are encoded as a quantity (e.g. 255 => 0xff).
+//
+type HexEncodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*HexEncodeTask)(nil)
+
+func (t *HexEncodeTask) Type() TaskType {
+	return TaskTypeHexEncode
+}
+
+func (t *HexEncodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		input  BytesParam
+		number DecimalParam
+	)
+	getters := From(VarExpr(t.Input, vars), Input(inputs, 0))
+	err = ResolveParam(&input, getters)
+	if errors.Is(errors.Cause(err), ErrBadInput) {
+		// Not a string or byte array, so try it as a number
+		if err = ResolveParam(&number, getters); err == nil {
+			d := number.Decimal()
+			if !d.Equal(d.Truncate(0)) || d.IsNegative() {
+				return Result{Error: errors.Wrapf(ErrBadInput, "input: expected a non-negative integer, got %v", d)}, runInfo
+			}
+			return Result{Value: hexutil.EncodeBig(d.BigInt())}, runInfo
+		}
+	}
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "input")}, runInfo
+	}
+
+	return Result{Value: hexutil.Encode(input)}, runInfo
+}
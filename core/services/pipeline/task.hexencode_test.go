@@ -0,0 +1,54 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestHexEncodeTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          interface{}
+		want           string
+		wantErrorCause error
+	}{
+		{"string", "hello", "0x68656c6c6f", nil},
+		{"bytes", []byte{0xde, 0xad}, "0xdead", nil},
+		{"empty string", "", "0x", nil},
+		{"integer", 255, "0xff", nil},
+		{"float integer", float64(4096), "0x1000", nil},
+		{"big.Int", big.NewInt(1e18), "0xde0b6b3a7640000", nil},
+		{"decimal", decimal.NewFromInt(16), "0x10", nil},
+		{"zero", 0, "0x0", nil},
+		{"negative", -1, "", pipeline.ErrBadInput},
+		{"fractional", 1.5, "", pipeline.ErrBadInput},
+		{"map", map[string]interface{}{}, "", pipeline.ErrBadInput},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.HexEncodeTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
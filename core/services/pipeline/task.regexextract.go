@@ -0,0 +1,123 @@
+package pipeline
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string
+//     []interface{}  (when all = true)
+//     nil
+//
+// Group selects the capture group to return, by index or by name. It defaults
+// to the first capture group if the pattern has any, or the whole match otherwise.
+//
+type RegexExtractTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+	Pattern  string `json:"pattern"`
+	Group    string `json:"group"`
+	All      string `json:"all"`
+	// Lax when disabled will return an error if the pattern does not match
+	// Lax when enabled will return nil with no error if the pattern does not match
+	Lax string
+}
+
+var _ Task = (*RegexExtractTask)(nil)
+
+func (t *RegexExtractTask) Type() TaskType {
+	return TaskTypeRegexExtract
+}
+
+func (t *RegexExtractTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		input   StringParam
+		pattern StringParam
+		group   StringParam
+		all     BoolParam
+		lax     BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&pattern, From(VarExpr(t.Pattern, vars), NonemptyString(t.Pattern))), "pattern"),
+		errors.Wrap(ResolveParam(&group, From(NonemptyString(t.Group), "")), "group"),
+		errors.Wrap(ResolveParam(&all, From(NonemptyString(t.All), false)), "all"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	re, err := regexp.Compile(string(pattern))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "RegexExtract: pattern: %v", err)}, runInfo
+	}
+
+	groupIndex, err := regexGroupIndex(re, string(group))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if bool(all) {
+		matches := re.FindAllStringSubmatchIndex(string(input), -1)
+		if len(matches) == 0 && !bool(lax) {
+			return Result{Error: errors.Wrapf(ErrKeypathNotFound, `pattern "%s" did not match %s`, pattern, input)}, runInfo
+		}
+		values := make([]interface{}, 0, len(matches))
+		for _, loc := range matches {
+			values = append(values, regexGroupValue(string(input), loc, groupIndex))
+		}
+		return Result{Value: values}, runInfo
+	}
+
+	loc := re.FindStringSubmatchIndex(string(input))
+	if loc == nil {
+		if bool(lax) {
+			return Result{Value: nil}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, `pattern "%s" did not match %s`, pattern, input)}, runInfo
+	}
+	return Result{Value: regexGroupValue(string(input), loc, groupIndex)}, runInfo
+}
+
+func regexGroupIndex(re *regexp.Regexp, group string) (int, error) {
+	if group == "" {
+		if re.NumSubexp() > 0 {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	if i, err := strconv.Atoi(group); err == nil {
+		if i < 0 || i > re.NumSubexp() {
+			return 0, errors.Wrapf(ErrBadInput, "group %d out of range, pattern has %d groups", i, re.NumSubexp())
+		}
+		return i, nil
+	}
+	if i := re.SubexpIndex(group); i >= 0 {
+		return i, nil
+	}
+	return 0, errors.Wrapf(ErrBadInput, `pattern has no group named "%s"`, group)
+}
+
+// regexGroupValue returns the text of the given group, or nil if the group did
+// not participate in the match.
+func regexGroupValue(input string, loc []int, group int) interface{} {
+	start, end := loc[2*group], loc[2*group+1]
+	if start < 0 {
+		return nil
+	}
+	return input[start:end]
+}
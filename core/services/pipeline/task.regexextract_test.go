@@ -0,0 +1,81 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestRegexExtractTask(t *testing.T) {
+	t.Parallel()
+
+	const page = "ETH/USD price: 3000.25 (updated 12:00)\nBTC/USD price: 41000.5 (updated 12:01)"
+
+	tests := []struct {
+		name              string
+		input             string
+		pattern           string
+		group             string
+		all               string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"first capture group by default", "", `price: ([0-9.]+)`, "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, "3000.25", nil, ""},
+		{"whole match without groups", "", `[0-9]+:[0-9]+`, "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, "12:00", nil, ""},
+		{"group by index", "", `(\w+)/USD price: ([0-9.]+)`, "2", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, "3000.25", nil, ""},
+		{"group by name", "", `BTC/USD price: (?P<price>[0-9.]+)`, "price", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, "41000.5", nil, ""},
+		{"all matches", "", `(\w+)/USD`, "", "true", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, []interface{}{"ETH", "BTC"}, nil, ""},
+		{"optional group not participating", "", `ETH(/EUR)?`, "1", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, nil, ""},
+		{"input and pattern from vars", "$(foo.bar)", "$(re)", "", "", "",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": map[string]interface{}{"bar": "id=42"},
+				"re":  `id=(\d+)`,
+			}),
+			[]pipeline.Result{}, "42", nil, ""},
+		{"no match", "", `LINK/USD price: ([0-9.]+)`, "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, pipeline.ErrKeypathNotFound, "did not match"},
+		{"no match (lax)", "", `LINK/USD price: ([0-9.]+)`, "", "", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, nil, ""},
+		{"no match with all (lax)", "", `LINK`, "", "true", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, []interface{}{}, nil, ""},
+		{"invalid pattern", "", `price: ([0-9.]+`, "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, pipeline.ErrBadInput, "RegexExtract: pattern"},
+		{"group out of range", "", `price: ([0-9.]+)`, "2", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, pipeline.ErrBadInput, "out of range"},
+		{"unknown group name", "", `price: ([0-9.]+)`, "price", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, pipeline.ErrBadInput, "no group named"},
+		{"missing pattern", "", "", "", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: page}}, nil, pipeline.ErrParameterEmpty, "pattern"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.RegexExtractTask{
+				BaseTask: pipeline.NewBaseTask(0, "regex", nil, nil, 0),
+				Input:    test.input,
+				Pattern:  test.pattern,
+				Group:    test.group,
+				All:      test.all,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
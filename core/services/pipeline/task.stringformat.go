@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string
+//
+// Formats values with Go's fmt verbs, e.g.
+//
+//     format="%s/%s?ts=%d" values=<[$(base), $(quote), $(ts)]>
+//
+type StringFormatTask struct {
+	BaseTask `mapstructure:",squash"`
+	Format   string `json:"format"`
+	Values   string `json:"values"`
+}
+
+var _ Task = (*StringFormatTask)(nil)
+
+func (t *StringFormatTask) Type() TaskType {
+	return TaskTypeStringFormat
+}
+
+func (t *StringFormatTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		format StringParam
+		values SliceParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&format, From(VarExpr(t.Format, vars), NonemptyString(t.Format))), "format"),
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	for i, v := range values {
+		// JSON numbers are decoded as float64; format integral ones without an exponent
+		if f, is := v.(float64); is && math.Abs(f) < 1<<53 && f == math.Trunc(f) {
+			values[i] = int64(f)
+		}
+	}
+
+	// The format is checked against zero values of the same types, so that
+	// values which themselves contain "%!" are not mistaken for errors
+	placeholders := make([]interface{}, len(values))
+	for i, v := range values {
+		if v != nil {
+			placeholders[i] = reflect.Zero(reflect.TypeOf(v)).Interface()
+		}
+	}
+	checked := fmt.Sprintf(string(format), placeholders...)
+	if idx := indexOfBadVerb(checked); idx >= 0 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "StringFormat: %s", checked[idx:])}, runInfo
+	}
+	return Result{Value: fmt.Sprintf(string(format), values...)}, runInfo
+}
+
+// indexOfBadVerb returns the index of the first formatting error emitted by
+// fmt (such as %!d(string=) or %!(EXTRA ...)), or -1 if there is none.
+func indexOfBadVerb(s string) int {
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] == '%' && s[i+1] == '!' {
+			return i
+		}
+	}
+	return -1
+}
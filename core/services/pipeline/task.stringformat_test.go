@@ -0,0 +1,54 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestStringFormatTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		format         string
+		values         string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		want           string
+		wantErrorCause error
+	}{
+		{"values from vars", "%s/%s?ts=%d", `[ $(base), $(quote), $(ts) ]`,
+			pipeline.NewVarsFrom(map[string]interface{}{"base": "ETH", "quote": "USD", "ts": int64(1650000000)}),
+			nil, "ETH/USD?ts=1650000000", nil},
+		{"JSON literals", "%v-%d-%.2f", `[ true, 42, 1.5 ]`, pipeline.NewVarsFrom(nil), nil, "true-42-1.50", nil},
+		{"values from inputs", "%v,%v", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "a"}, {Value: "b"}}, "a,b", nil},
+		{"value containing %!", "%s", `[ "100%!" ]`, pipeline.NewVarsFrom(nil), nil, "100%!", nil},
+		{"missing value", "%s-%s", `[ "foo" ]`, pipeline.NewVarsFrom(nil), nil, "", pipeline.ErrBadInput},
+		{"wrong verb", "%d", `[ "foo" ]`, pipeline.NewVarsFrom(nil), nil, "", pipeline.ErrBadInput},
+		{"too many values", "%s", `[ "foo", "bar" ]`, pipeline.NewVarsFrom(nil), nil, "", pipeline.ErrBadInput},
+		{"missing format", "", `[ "foo" ]`, pipeline.NewVarsFrom(nil), nil, "", pipeline.ErrParameterEmpty},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.StringFormatTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Format: test.format, Values: test.values}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     int64
+//
+// Returns the current Unix time, rounded down to a multiple of the interval
+// if one is given (e.g. interval="1h" returns the start of the current hour).
+// Unit is one of "s" (default) or "ms".
+//
+type TimestampTask struct {
+	BaseTask `mapstructure:",squash"`
+	Interval string `json:"interval"`
+	Unit     string `json:"unit"`
+}
+
+var _ Task = (*TimestampTask)(nil)
+
+func (t *TimestampTask) Type() TaskType {
+	return TaskTypeTimestamp
+}
+
+func (t *TimestampTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		interval StringParam
+		unit     StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&interval, From(VarExpr(t.Interval, vars), NonemptyString(t.Interval), "")), "interval"),
+		errors.Wrap(ResolveParam(&unit, From(NonemptyString(t.Unit), "s")), "unit"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	now := time.Now()
+	if interval != "" {
+		d, err := time.ParseDuration(string(interval))
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "interval: %v", err)}, runInfo
+		} else if d <= 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "interval: must be positive, got %v", d)}, runInfo
+		}
+		now = time.Unix(0, now.UnixNano()-now.UnixNano()%int64(d))
+	}
+
+	switch unit {
+	case "s":
+		return Result{Value: now.Unix()}, runInfo
+	case "ms":
+		return Result{Value: now.UnixMilli()}, runInfo
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "unsupported unit: %s", unit)}, runInfo
+	}
+}
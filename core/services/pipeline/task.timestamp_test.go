@@ -0,0 +1,62 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestTimestampTask(t *testing.T) {
+	t.Parallel()
+
+	run := func(t *testing.T, interval, unit string) pipeline.Result {
+		task := pipeline.TimestampTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Interval: interval, Unit: unit}
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		return result
+	}
+
+	t.Run("seconds", func(t *testing.T) {
+		before := time.Now().Unix()
+		result := run(t, "", "")
+		require.NoError(t, result.Error)
+		require.GreaterOrEqual(t, result.Value.(int64), before)
+		require.LessOrEqual(t, result.Value.(int64), time.Now().Unix())
+	})
+
+	t.Run("milliseconds", func(t *testing.T) {
+		before := time.Now().UnixMilli()
+		result := run(t, "", "ms")
+		require.NoError(t, result.Error)
+		require.GreaterOrEqual(t, result.Value.(int64), before)
+		require.LessOrEqual(t, result.Value.(int64), time.Now().UnixMilli())
+	})
+
+	t.Run("rounded to interval", func(t *testing.T) {
+		result := run(t, "1h", "")
+		require.NoError(t, result.Error)
+		ts := result.Value.(int64)
+		require.Zero(t, ts%3600)
+		require.LessOrEqual(t, time.Now().Unix()-ts, int64(3600))
+	})
+
+	t.Run("bad interval", func(t *testing.T) {
+		result := run(t, "hourly", "")
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		result = run(t, "-1m", "")
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+
+	t.Run("bad unit", func(t *testing.T) {
+		result := run(t, "", "ns")
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
@@ -0,0 +1,438 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string
+//     nil
+//
+// Supported XPath subset:
+//     /a/b, a/b        child steps from the document root
+//     //b, /a//b       descendant steps
+//     *, ., ..         wildcard, self and parent steps
+//     @attr, text()    attribute value or direct text of the last matched element
+//     [n], [last()]    1-based position predicates
+//     [@attr], [@attr='v'], [name='v'], [text()='v']
+//
+// Namespace prefixes in both the document and the path are ignored, so
+// "/soap:Envelope/soap:Body" and "/Envelope/Body" are equivalent.
+// The string value of an element is its trimmed, concatenated text content.
+//
+type XMLParseTask struct {
+	BaseTask `mapstructure:",squash"`
+	Path     string `json:"path"`
+	Data     string `json:"data"`
+	// Lax when disabled will return an error if the path does not exist
+	// Lax when enabled will return nil with no error if the path does not exist
+	Lax string
+}
+
+var _ Task = (*XMLParseTask)(nil)
+
+func (t *XMLParseTask) Type() TaskType {
+	return TaskTypeXMLParse
+}
+
+func (t *XMLParseTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		path StringParam
+		data BytesParam
+		lax  BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), NonemptyString(t.Path))), "path"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	steps, err := parseXPath(string(path))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "XMLParse: path: %v", err)}, runInfo
+	}
+
+	doc, err := parseXMLDocument(data)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "XMLParse: data: %v", err)}, runInfo
+	}
+
+	values := evalXPath(doc, steps)
+	if len(values) == 0 {
+		if bool(lax) {
+			return Result{Value: nil}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path "%s" in %s`, path, data)}, runInfo
+	}
+	return Result{Value: values[0]}, runInfo
+}
+
+type xmlNode struct {
+	name     string
+	attrs    map[string]string
+	text     strings.Builder
+	children []*xmlNode
+	parent   *xmlNode
+}
+
+// stringValue returns the trimmed concatenation of all descendant text.
+func (n *xmlNode) stringValue() string {
+	var sb strings.Builder
+	var walk func(*xmlNode)
+	walk = func(n *xmlNode) {
+		sb.WriteString(n.text.String())
+		for _, c := range n.children {
+			walk(c)
+		}
+	}
+	walk(n)
+	return strings.TrimSpace(sb.String())
+}
+
+// parseXMLDocument returns a synthetic document node whose only child is the
+// root element.
+func parseXMLDocument(data []byte) (*xmlNode, error) {
+	doc := &xmlNode{}
+	current := doc
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: tok.Name.Local, attrs: make(map[string]string), parent: current}
+			for _, attr := range tok.Attr {
+				node.attrs[attr.Name.Local] = attr.Value
+			}
+			current.children = append(current.children, node)
+			current = node
+		case xml.EndElement:
+			if current.parent == nil {
+				return nil, errors.Errorf("unexpected closing tag </%s>", tok.Name.Local)
+			}
+			current = current.parent
+		case xml.CharData:
+			current.text.Write(tok)
+		}
+	}
+	if current != doc {
+		return nil, errors.Errorf("unclosed tag <%s>", current.name)
+	}
+	if len(doc.children) == 0 {
+		return nil, errors.New("no root element")
+	}
+	return doc, nil
+}
+
+type xpathStepKind int
+
+const (
+	xpathElement xpathStepKind = iota
+	xpathSelf
+	xpathParent
+	xpathAttr
+	xpathText
+)
+
+type xpathPredicate struct {
+	position int  // 1-based; 0 when unset
+	last     bool // [last()]
+	attr     string
+	child    string
+	text     bool
+	value    *string
+}
+
+type xpathStep struct {
+	kind       xpathStepKind
+	name       string // element or attribute name, "*" for any
+	descendant bool
+	predicates []xpathPredicate
+}
+
+func parseXPath(path string) ([]xpathStep, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, errors.New("empty path")
+	}
+	var steps []xpathStep
+	for i := 0; i < len(path); {
+		var step xpathStep
+		if strings.HasPrefix(path[i:], "//") {
+			step.descendant = true
+			i += 2
+		} else if path[i] == '/' {
+			i++
+		}
+		if i >= len(path) {
+			return nil, errors.Errorf("unexpected end of path %q", path)
+		}
+
+		end := i
+		for end < len(path) && path[end] != '/' && path[end] != '[' {
+			end++
+		}
+		token := path[i:end]
+		switch {
+		case token == ".":
+			step.kind = xpathSelf
+		case token == "..":
+			step.kind = xpathParent
+		case token == "text()":
+			step.kind = xpathText
+		case strings.HasPrefix(token, "@"):
+			step.kind = xpathAttr
+			step.name = stripXMLPrefix(token[1:])
+		case token == "":
+			return nil, errors.Errorf("empty step at offset %d in %q", i, path)
+		default:
+			step.kind = xpathElement
+			step.name = stripXMLPrefix(token)
+		}
+		i = end
+
+		for i < len(path) && path[i] == '[' {
+			closing := predicateEnd(path[i:])
+			if closing < 0 {
+				return nil, errors.Errorf("unterminated predicate in %q", path)
+			}
+			pred, err := parseXPathPredicate(path[i+1 : i+closing])
+			if err != nil {
+				return nil, err
+			}
+			step.predicates = append(step.predicates, pred)
+			i += closing + 1
+		}
+
+		if (step.kind == xpathAttr || step.kind == xpathText) && i < len(path) {
+			return nil, errors.Errorf("%s must be the last step in %q", token, path)
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// predicateEnd returns the index of the ']' which closes the predicate at the
+// start of s, skipping those within quoted values, or -1 if there is none.
+func predicateEnd(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '\'' || s[i] == '"':
+			quote = s[i]
+		case s[i] == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseXPathPredicate(expr string) (pred xpathPredicate, err error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "last()" {
+		pred.last = true
+		return pred, nil
+	}
+	if n, err2 := strconv.Atoi(expr); err2 == nil {
+		if n < 1 {
+			return pred, errors.Errorf("position predicate must be >= 1, got %d", n)
+		}
+		pred.position = n
+		return pred, nil
+	}
+
+	lhs, rhs, hasValue := strings.Cut(expr, "=")
+	lhs = strings.TrimSpace(lhs)
+	if hasValue {
+		rhs = strings.TrimSpace(rhs)
+		if len(rhs) < 2 || (rhs[0] != '\'' && rhs[0] != '"') || rhs[len(rhs)-1] != rhs[0] {
+			return pred, errors.Errorf("predicate value must be a quoted string, got %s", rhs)
+		}
+		value := rhs[1 : len(rhs)-1]
+		pred.value = &value
+	}
+	switch {
+	case lhs == "text()":
+		if !hasValue {
+			return pred, errors.New("text() predicate requires a value")
+		}
+		pred.text = true
+	case strings.HasPrefix(lhs, "@") && len(lhs) > 1:
+		pred.attr = stripXMLPrefix(lhs[1:])
+	case lhs != "" && !strings.ContainsAny(lhs, "()@/ "):
+		pred.child = stripXMLPrefix(lhs)
+	default:
+		return pred, errors.Errorf("unsupported predicate [%s]", expr)
+	}
+	return pred, nil
+}
+
+func stripXMLPrefix(name string) string {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func evalXPath(doc *xmlNode, steps []xpathStep) []string {
+	nodes := []*xmlNode{doc}
+	for _, step := range steps {
+		switch step.kind {
+		case xpathAttr, xpathText:
+			var values []string
+			for _, n := range xpathAxis(nodes, step.descendant) {
+				if step.kind == xpathText {
+					if text := strings.TrimSpace(n.text.String()); text != "" {
+						values = append(values, text)
+					}
+				} else if v, exists := n.attrs[step.name]; exists {
+					values = append(values, v)
+				}
+			}
+			return values
+		case xpathSelf:
+			nodes = xpathAxis(nodes, step.descendant)
+		case xpathParent:
+			var parents nodeSet
+			for _, n := range xpathAxis(nodes, step.descendant) {
+				if n.parent != nil {
+					parents.add(n.parent)
+				}
+			}
+			nodes = parents.nodes
+		case xpathElement:
+			var next nodeSet
+			for _, n := range xpathAxis(nodes, step.descendant) {
+				var matched []*xmlNode
+				for _, c := range n.children {
+					if step.name == "*" || c.name == step.name {
+						matched = append(matched, c)
+					}
+				}
+				for _, pred := range step.predicates {
+					matched = applyXPathPredicate(matched, pred)
+				}
+				for _, m := range matched {
+					next.add(m)
+				}
+			}
+			nodes = next.nodes
+			continue
+		}
+		for _, pred := range step.predicates {
+			nodes = applyXPathPredicate(nodes, pred)
+		}
+	}
+
+	values := make([]string, len(nodes))
+	for i, n := range nodes {
+		if n.parent == nil {
+			// The synthetic document node
+			values[i] = n.children[0].stringValue()
+		} else {
+			values[i] = n.stringValue()
+		}
+	}
+	return values
+}
+
+// xpathAxis returns the nodes themselves, plus all of their descendants when
+// descendant is set (the descendant-or-self axis).
+func xpathAxis(nodes []*xmlNode, descendant bool) []*xmlNode {
+	if !descendant {
+		return nodes
+	}
+	var out nodeSet
+	var walk func(*xmlNode)
+	walk = func(n *xmlNode) {
+		out.add(n)
+		for _, c := range n.children {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	return out.nodes
+}
+
+func applyXPathPredicate(nodes []*xmlNode, pred xpathPredicate) []*xmlNode {
+	switch {
+	case pred.last:
+		if len(nodes) == 0 {
+			return nil
+		}
+		return nodes[len(nodes)-1:]
+	case pred.position > 0:
+		if pred.position > len(nodes) {
+			return nil
+		}
+		return nodes[pred.position-1 : pred.position]
+	}
+
+	var out []*xmlNode
+	for _, n := range nodes {
+		switch {
+		case pred.text:
+			if strings.TrimSpace(n.text.String()) == *pred.value {
+				out = append(out, n)
+			}
+		case pred.attr != "":
+			if v, exists := n.attrs[pred.attr]; exists && (pred.value == nil || v == *pred.value) {
+				out = append(out, n)
+			}
+		case pred.child != "":
+			for _, c := range n.children {
+				if c.name == pred.child && (pred.value == nil || c.stringValue() == *pred.value) {
+					out = append(out, n)
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+// nodeSet is an insertion-ordered set of nodes.
+type nodeSet struct {
+	nodes []*xmlNode
+	seen  map[*xmlNode]struct{}
+}
+
+func (s *nodeSet) add(n *xmlNode) {
+	if s.seen == nil {
+		s.seen = make(map[*xmlNode]struct{})
+	}
+	if _, exists := s.seen[n]; exists {
+		return
+	}
+	s.seen[n] = struct{}{}
+	s.nodes = append(s.nodes, n)
+}
@@ -0,0 +1,94 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestXMLParseTask(t *testing.T) {
+	t.Parallel()
+
+	const soap = `<?xml version="1.0" encoding="UTF-8"?>
+<soap:Envelope xmlns:soap="http://www.w3.org/2003/05/soap-envelope">
+  <soap:Body>
+    <GetRatesResponse>
+      <Rate currency="EUR" source="ecb">1.0841</Rate>
+      <Rate currency="GBP" source="boe">1.2712</Rate>
+      <Rate currency="JPY" source="boj">
+        <Value>0.0068</Value>
+      </Rate>
+      <Updated>2022-04-20T12:00:00Z</Updated>
+    </GetRatesResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+	tests := []struct {
+		name              string
+		data              string
+		path              string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"absolute path with prefixes", "", "/soap:Envelope/soap:Body/GetRatesResponse/Updated", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "2022-04-20T12:00:00Z", nil, ""},
+		{"absolute path without prefixes", "", "/Envelope/Body/GetRatesResponse/Updated", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "2022-04-20T12:00:00Z", nil, ""},
+		{"descendant with attribute predicate", "", "//Rate[@currency='GBP']", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "1.2712", nil, ""},
+		{"position predicate", "", "//GetRatesResponse/Rate[1]", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "1.0841", nil, ""},
+		{"last() predicate and attribute", "", "//Rate[last()]/@source", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "boj", nil, ""},
+		{"child value predicate and parent step", "", "//Rate[Value='0.0068']/../Updated", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "2022-04-20T12:00:00Z", nil, ""},
+		{"nested string value", "", "//Rate[@currency='JPY']", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "0.0068", nil, ""},
+		{"text() step", "", "//Rate[2]/text()", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "1.2712", nil, ""},
+		{"wildcard", "", "/*/*/*/Updated", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, "2022-04-20T12:00:00Z", nil, ""},
+		{"data and path from vars", "$(foo.bar)", "$(path)", "",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo":  map[string]interface{}{"bar": `<a><b id="1">x</b></a>`},
+				"path": "/a/b/@id",
+			}),
+			[]pipeline.Result{}, "1", nil, ""},
+		{"quoted predicate value with ]", "", "/a/b[@name='x]y']/@id", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: `<a><b name="x">1</b><b name="x]y" id="2">3</b></a>`}}, "2", nil, ""},
+		{"unterminated predicate", "", "/a/b[@name='x]", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, pipeline.ErrBadInput, "unterminated predicate"},
+		{"missing element", "", "//Rate[@currency='CHF']", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, pipeline.ErrKeypathNotFound, "could not resolve path"},
+		{"missing element (lax)", "", "//Rate[@currency='CHF']", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, nil, ""},
+		{"missing attribute (lax)", "", "//Updated/@tz", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, nil, ""},
+		{"malformed xml", "", "/a", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "<a><b></a>"}}, nil, pipeline.ErrBadInput, "XMLParse: data"},
+		{"unsupported predicate", "", "//Rate[position() > 1]", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, pipeline.ErrBadInput, "XMLParse: path"},
+		{"attribute not last", "", "/a/@b/c", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, pipeline.ErrBadInput, "last step"},
+		{"malformed 'lax' param", "", "/a", "sergey", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: soap}}, nil, pipeline.ErrBadInput, "lax"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.XMLParseTask{
+				BaseTask: pipeline.NewBaseTask(0, "xml", nil, nil, 0),
+				Path:     test.path,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 type Delegate struct {
 	q    pg.Q
+	orm  ORM
 	pr   pipeline.Runner
 	porm pipeline.ORM
 	ks   keystore.Master
@@ -58,6 +59,7 @@ func NewDelegate(
 	cfg pg.LogConfig) *Delegate {
 	return &Delegate{
 		q:    pg.NewQ(db, lggr, cfg),
+		orm:  NewORM(db, lggr, cfg),
 		ks:   ks,
 		pr:   pr,
 		porm: porm,
@@ -131,6 +133,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				chain.ID(),
 				chain.LogBroadcaster(),
 				d.q,
+				d.orm,
 				coordinatorV2,
 				batchCoordinatorV2,
 				aggregator,
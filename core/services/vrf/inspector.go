@@ -0,0 +1,536 @@
+package vrf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/aggregator_v3_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Statuses of v1 requests, which are derived from their pipeline run and
+// fulfillment transaction. The status of a v2 request is its RequestState.
+const (
+	RequestStatusProcessing         = "processing"
+	RequestStatusErrored            = "errored"
+	RequestStatusFulfillmentPending = "fulfillment_pending"
+	RequestStatusFulfillmentFailed  = "fulfillment_failed"
+	RequestStatusFulfilled          = "fulfilled"
+	// RequestStatusUnknown is the status of a request whose run finished
+	// without a fulfillment transaction left, e.g. because it was reaped, so
+	// it is not known whether the request was fulfilled
+	RequestStatusUnknown = "unknown"
+)
+
+// Fulfillment is a transaction sent by the node to fulfill VRF requests,
+// along with the fee it paid once it has a receipt.
+type Fulfillment struct {
+	EthTxID     int64          `db:"eth_tx_id"`
+	State       string         `db:"state"`
+	FromAddress common.Address `db:"from_address"`
+	// Hash is the hash of the attempt with a receipt, or of the latest
+	// attempt, and is nil until the transaction is broadcast
+	Hash        *common.Hash `db:"hash"`
+	Error       *string      `db:"error"`
+	BroadcastAt *time.Time   `db:"broadcast_at"`
+	// MaxLink is the LINK reserved for the fulfillment of v2 requests
+	MaxLink *utils.Big `db:"max_link"`
+	// BlockNumber, GasUsed, EffectiveGasPrice and Fee are nil until the
+	// transaction has a receipt
+	BlockNumber       *int64      `db:"block_number"`
+	GasUsed           *uint64     `db:"-"`
+	EffectiveGasPrice *utils.Big  `db:"-"`
+	Fee               *assets.Eth `db:"-"`
+}
+
+// fulfillmentRow is a helper type for reading a Fulfillment along with its
+// receipt
+type fulfillmentRow struct {
+	Fulfillment
+	GasPrice  *utils.Big `db:"gas_price"`
+	GasFeeCap *utils.Big `db:"gas_fee_cap"`
+	Receipt   []byte     `db:"receipt"`
+}
+
+func (r fulfillmentRow) toFulfillment() (Fulfillment, error) {
+	f := r.Fulfillment
+	if len(r.Receipt) == 0 {
+		return f, nil
+	}
+	var receipt evmtypes.Receipt
+	if err := json.Unmarshal(r.Receipt, &receipt); err != nil {
+		return f, errors.Wrapf(err, "failed to decode receipt of eth tx %d", r.EthTxID)
+	}
+	price := receipt.EffectiveGasPrice
+	if price == nil && r.GasPrice != nil {
+		price = r.GasPrice.ToInt()
+	} else if price == nil && r.GasFeeCap != nil {
+		price = r.GasFeeCap.ToInt()
+	}
+	f.GasUsed = &receipt.GasUsed
+	if price != nil {
+		f.EffectiveGasPrice = utils.NewBig(price)
+		f.Fee = (*assets.Eth)(new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), price))
+	}
+	return f, nil
+}
+
+// SubscriptionBalance is the check of the balance of a subscription which the
+// VRF v2 listener makes before fulfilling its requests, made on demand.
+type SubscriptionBalance struct {
+	EVMChainID utils.Big
+	SubID      uint64
+	// Balance is the balance of the subscription on chain
+	Balance *big.Int
+	// ReservedLink is the LINK reserved for the fulfillments of the
+	// subscription which are not confirmed yet
+	ReservedLink *big.Int
+	// AvailableLink is the balance less the reserved LINK
+	AvailableLink *big.Int
+	// EstimatedFeeJuels is the fee estimated for fulfilling a request at the
+	// max gas price of the job, and Sufficient whether the available LINK
+	// covers it. They are only set when checking the balance for a request.
+	EstimatedFeeJuels *big.Int
+	Sufficient        *bool
+	// Error is why the balance could not be fully checked
+	Error *string
+}
+
+func (b *SubscriptionBalance) setError(err error) {
+	msg := err.Error()
+	b.Error = &msg
+}
+
+// RequestLookup is what the node knows of a VRF v1 or v2 request, to explain
+// whether it was fulfilled, and if not why.
+type RequestLookup struct {
+	// Version is 1 or 2, the version of the VRF coordinator of the request
+	Version int
+	JobID   int32
+	JobName null.String
+	// RequestID is a decimal number for v2 requests, and a hex hash for v1
+	// requests. It is empty for a v1 request which was not fulfilled.
+	RequestID     string
+	RequestTxHash common.Hash
+	Status        string
+	// Error is why the last attempt at fulfilling a v2 request failed, such as
+	// the revert reason of its simulation, or the errors of the pipeline run
+	// of a v1 request
+	Error *string
+	// V2 is the saved request, for v2 requests
+	V2 *RequestV2
+	// LatestBlockNumber is the latest block of the chain of a v2 request, to
+	// count its confirmations, and nil if it could not be fetched
+	LatestBlockNumber *int64
+	// Balance is the check of the subscription balance of a v2 request which
+	// is still pending
+	Balance *SubscriptionBalance
+	// PipelineRunID is the pipeline run of a v1 request
+	PipelineRunID *int64
+	Fulfillments  []Fulfillment
+}
+
+// SubscriptionInsight is the state of a VRF v2 subscription, as seen by the
+// node: its balance, the LINK reserved for its fulfillments, and its requests
+// still pending.
+type SubscriptionInsight struct {
+	SubscriptionBalance
+	// CoordinatorAddress is the coordinator of the job which last received a
+	// request of the subscription, and Owner the owner of the subscription.
+	// They are nil if no job received a request of the subscription.
+	CoordinatorAddress *common.Address
+	Owner              *common.Address
+	PendingRequests    []RequestV2
+	// PendingFulfillments are the fulfillments which reserve LINK
+	PendingFulfillments []Fulfillment
+}
+
+// Inspector looks up VRF requests and subscriptions, to explain why requests
+// were or were not fulfilled. It reads the database, and the chain for the
+// balance of subscriptions.
+type Inspector struct {
+	q        pg.Q
+	orm      ORM
+	chainSet evm.ChainSet
+}
+
+func NewInspector(db *sqlx.DB, chainSet evm.ChainSet, lggr logger.Logger, cfg pg.LogConfig) *Inspector {
+	namedLogger := lggr.Named("VRFInspector")
+	return &Inspector{
+		q:        pg.NewQ(db, namedLogger, cfg),
+		orm:      NewORM(db, lggr, cfg),
+		chainSet: chainSet,
+	}
+}
+
+// ParseRequestID parses a request ID, which is either a decimal number, or a
+// 0x prefixed hex number.
+func ParseRequestID(s string) (*big.Int, error) {
+	var (
+		reqID *big.Int
+		ok    bool
+	)
+	if strings.HasPrefix(s, "0x") {
+		reqID, ok = new(big.Int).SetString(s[2:], 16)
+	} else {
+		reqID, ok = new(big.Int).SetString(s, 10)
+	}
+	if !ok || reqID.Sign() < 0 {
+		return nil, errors.Errorf("invalid request ID: %q", s)
+	}
+	return reqID, nil
+}
+
+// LookupRequests returns the v1 and v2 requests with the given request ID, or
+// sent in the transaction with the given hash. Either may be nil.
+func (i *Inspector) LookupRequests(ctx context.Context, reqID *big.Int, txHash *common.Hash) ([]RequestLookup, error) {
+	if reqID == nil && txHash == nil {
+		return nil, errors.New("a request ID or a transaction hash is required")
+	}
+
+	v2, err := i.lookupRequestsV2(ctx, reqID, txHash)
+	if err != nil {
+		return nil, err
+	}
+	v1, err := i.lookupRequestsV1(reqID, txHash)
+	if err != nil {
+		return nil, err
+	}
+	return append(v2, v1...), nil
+}
+
+func (i *Inspector) lookupRequestsV2(ctx context.Context, reqID *big.Int, txHash *common.Hash) ([]RequestLookup, error) {
+	reqs, err := i.orm.FindRequestsV2(reqID, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var lookups []RequestLookup
+	for idx := range reqs {
+		req := reqs[idx]
+		lookup := RequestLookup{
+			Version:       2,
+			JobID:         req.JobID,
+			RequestID:     req.RequestID.String(),
+			RequestTxHash: req.RequestTxHash,
+			Status:        string(req.State),
+			Error:         req.Error,
+			V2:            &req,
+		}
+
+		spec, err := i.vrfSpec(req.JobID)
+		if err != nil {
+			return nil, err
+		}
+		lookup.JobName = spec.JobName
+
+		// A v2 fulfillment is saved on the request, or, for a request already
+		// fulfilled on chain, may be found by the request ID in its meta
+		metaID := common.BytesToHash(req.RequestID.ToInt().Bytes()).Hex()
+		lookup.Fulfillments, err = i.fulfillments(`eth_txes.evm_chain_id = $1 AND (eth_txes.id = $2
+	OR lower(eth_txes.meta->>'RequestID') = $3 OR eth_txes.meta->'RequestIDs' @> jsonb_build_array($3::text))`,
+			req.EVMChainID, req.EthTxID, metaID)
+		if err != nil {
+			return nil, err
+		}
+
+		chain, err := i.chainSet.Get(req.EVMChainID.ToInt())
+		if err != nil {
+			lookups = append(lookups, lookup)
+			continue
+		}
+		if head, err := chain.Client().HeadByNumber(ctx, nil); err == nil && head != nil {
+			lookup.LatestBlockNumber = &head.Number
+		}
+		if !req.State.IsFinal() {
+			balance, _ := i.checkBalance(ctx, chain, spec, req.SubID, &req)
+			lookup.Balance = &balance
+		}
+		lookups = append(lookups, lookup)
+	}
+	return lookups, nil
+}
+
+// v1RunRow is a pipeline run of a VRF v1 job
+type v1RunRow struct {
+	PipelineRunID int64              `db:"pipeline_run_id"`
+	State         pipeline.RunStatus `db:"state"`
+	AllErrors     pipeline.RunErrors `db:"all_errors"`
+	FatalErrors   pipeline.RunErrors `db:"fatal_errors"`
+	RequestTxHash *common.Hash       `db:"request_tx_hash"`
+	JobID         int32              `db:"job_id"`
+	JobName       null.String        `db:"job_name"`
+}
+
+func (i *Inspector) lookupRequestsV1(reqID *big.Int, txHash *common.Hash) ([]RequestLookup, error) {
+	var hash, metaID string
+	if txHash != nil {
+		hash = strings.ToLower(txHash.Hex())
+	}
+	if reqID != nil {
+		metaID = common.BigToHash(reqID).Hex()
+	}
+
+	// The runs of v1 jobs are the ones with a vrf task, while the runs of v2
+	// jobs have a vrfv2 task.
+	var runs []v1RunRow
+	err := i.q.Select(&runs, `
+SELECT pipeline_runs.id AS pipeline_run_id, pipeline_runs.state, pipeline_runs.all_errors, pipeline_runs.fatal_errors,
+	decode(substr(pipeline_runs.inputs->'jobRun'->>'logTxHash', 3), 'hex') AS request_tx_hash,
+	jobs.id AS job_id, jobs.name AS job_name
+FROM pipeline_runs
+JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE jobs.type = 'vrf'
+AND EXISTS (
+	SELECT 1 FROM pipeline_task_runs
+	WHERE pipeline_task_runs.pipeline_run_id = pipeline_runs.id AND pipeline_task_runs.type = $1
+)
+AND (
+	lower(pipeline_runs.inputs->'jobRun'->>'logTxHash') = $2
+	OR EXISTS (
+		SELECT 1 FROM pipeline_task_runs
+		JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
+		WHERE pipeline_task_runs.pipeline_run_id = pipeline_runs.id AND lower(eth_txes.meta->>'RequestID') = $3
+	)
+)
+ORDER BY pipeline_runs.id`, pipeline.TaskTypeVRF, hash, metaID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load v1 pipeline runs")
+	}
+
+	var lookups []RequestLookup
+	for _, run := range runs {
+		runID := run.PipelineRunID
+		lookup := RequestLookup{
+			Version:       1,
+			JobID:         run.JobID,
+			JobName:       run.JobName,
+			PipelineRunID: &runID,
+		}
+		if run.RequestTxHash != nil {
+			lookup.RequestTxHash = *run.RequestTxHash
+		}
+		if run.AllErrors.HasError() {
+			msg := run.AllErrors.ToError().Error()
+			lookup.Error = &msg
+		}
+		lookup.Fulfillments, err = i.fulfillments(`eth_txes.pipeline_task_run_id IN (
+	SELECT id FROM pipeline_task_runs WHERE pipeline_run_id = $1
+)`, run.PipelineRunID)
+		if err != nil {
+			return nil, err
+		}
+		var metaIDs []string
+		if err = i.q.Select(&metaIDs, `SELECT eth_txes.meta->>'RequestID' FROM eth_txes
+JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+WHERE pipeline_task_runs.pipeline_run_id = $1 AND eth_txes.meta->>'RequestID' IS NOT NULL
+LIMIT 1`, run.PipelineRunID); err != nil {
+			return nil, errors.Wrap(err, "failed to load v1 request ID")
+		}
+		if len(metaIDs) > 0 {
+			lookup.RequestID = metaIDs[0]
+		}
+		lookup.Status = v1Status(run, lookup.Fulfillments)
+		lookups = append(lookups, lookup)
+	}
+	return lookups, nil
+}
+
+// v1Status derives the status of a v1 request from its pipeline run, and its
+// fulfillment transactions if any. A request is only fulfilled once one of
+// them is confirmed.
+func v1Status(run v1RunRow, fulfillments []Fulfillment) string {
+	for _, f := range fulfillments {
+		if txmgr.EthTxState(f.State) == txmgr.EthTxConfirmed {
+			return RequestStatusFulfilled
+		}
+	}
+	if len(fulfillments) > 0 {
+		if txmgr.EthTxState(fulfillments[len(fulfillments)-1].State) == txmgr.EthTxFatalError {
+			return RequestStatusFulfillmentFailed
+		}
+		return RequestStatusFulfillmentPending
+	}
+	if run.State == pipeline.RunStatusErrored || run.FatalErrors.HasError() {
+		return RequestStatusErrored
+	}
+	if run.State.Finished() {
+		return RequestStatusUnknown
+	}
+	return RequestStatusProcessing
+}
+
+// Subscription returns the state of the VRF v2 subscription with subID on the
+// chain with chainID.
+func (i *Inspector) Subscription(ctx context.Context, chainID *big.Int, subID uint64) (SubscriptionInsight, error) {
+	chain, err := i.chainSet.Get(chainID)
+	if err != nil {
+		return SubscriptionInsight{}, err
+	}
+	insight := SubscriptionInsight{SubscriptionBalance: SubscriptionBalance{EVMChainID: *utils.NewBig(chain.ID()), SubID: subID}}
+
+	if insight.PendingRequests, err = i.orm.PendingRequestsForSubscriptionV2(chain.ID(), subID); err != nil {
+		return insight, err
+	}
+	insight.PendingFulfillments, err = i.fulfillments(`eth_txes.evm_chain_id = $1
+	AND eth_txes.meta->>'MaxLink' IS NOT NULL
+	AND CAST(eth_txes.meta->>'SubId' AS NUMERIC) = $2
+	AND eth_txes.state IN ('unconfirmed', 'unstarted', 'in_progress')`, utils.NewBig(chain.ID()), subID)
+	if err != nil {
+		return insight, err
+	}
+
+	// The coordinator of a subscription is the one of the jobs which received
+	// its requests.
+	var jobIDs []int32
+	err = i.q.Select(&jobIDs, `SELECT job_id FROM vrf_v2_requests WHERE evm_chain_id = $1 AND sub_id = $2 ORDER BY id DESC LIMIT 1`,
+		utils.NewBig(chain.ID()), subID)
+	if err != nil {
+		return insight, errors.Wrap(err, "failed to load the job of the subscription")
+	}
+	if len(jobIDs) == 0 {
+		reserved, err := ReservedLink(i.q, chain.ID().Uint64(), subID)
+		if err != nil {
+			return insight, errors.Wrap(err, "failed to get reserved LINK")
+		}
+		insight.ReservedLink = reserved
+		insight.setError(errors.New("no job received a request of the subscription, so its coordinator is unknown"))
+		return insight, nil
+	}
+	spec, err := i.vrfSpec(jobIDs[0])
+	if err != nil {
+		return insight, err
+	}
+	insight.CoordinatorAddress = &spec.CoordinatorAddress
+	insight.SubscriptionBalance, insight.Owner = i.checkBalance(ctx, chain, spec, subID, nil)
+	return insight, nil
+}
+
+// jobVRFSpec is the part of the VRF spec of a job used to check balances
+type jobVRFSpec struct {
+	JobName            null.String    `db:"job_name"`
+	CoordinatorAddress common.Address `db:"coordinator_address"`
+	FromAddresses      pq.ByteaArray  `db:"from_addresses"`
+}
+
+func (i *Inspector) vrfSpec(jobID int32) (spec jobVRFSpec, err error) {
+	err = i.q.Get(&spec, `SELECT jobs.name AS job_name, vrf_specs.coordinator_address, vrf_specs.from_addresses
+FROM jobs JOIN vrf_specs ON vrf_specs.id = jobs.vrf_spec_id WHERE jobs.id = $1`, jobID)
+	return spec, errors.Wrapf(err, "failed to load VRF spec of job %d", jobID)
+}
+
+// checkBalance checks the balance of the subscription like the VRF v2
+// listener does before processing its requests: it subtracts the LINK
+// reserved for the pending fulfillments, and compares what is left with the
+// fee estimated for req, if not nil. Failures are reported in the Error of the
+// balance.
+func (i *Inspector) checkBalance(ctx context.Context, chain evm.Chain, spec jobVRFSpec, subID uint64, req *RequestV2) (balance SubscriptionBalance, owner *common.Address) {
+	balance = SubscriptionBalance{EVMChainID: *utils.NewBig(chain.ID()), SubID: subID}
+
+	reserved, err := ReservedLink(i.q, chain.ID().Uint64(), subID)
+	if err != nil {
+		balance.setError(errors.Wrap(err, "failed to get reserved LINK"))
+		return
+	}
+	balance.ReservedLink = reserved
+
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(spec.CoordinatorAddress, chain.Client())
+	if err != nil {
+		balance.setError(err)
+		return
+	}
+	sub, err := coordinator.GetSubscription(&bind.CallOpts{Context: ctx}, subID)
+	if err != nil {
+		balance.setError(errors.Wrap(err, "failed to get subscription"))
+		return
+	}
+	owner = &sub.Owner
+	balance.Balance = sub.Balance
+	balance.AvailableLink = new(big.Int).Sub(sub.Balance, reserved)
+
+	if req == nil {
+		return
+	}
+	feedAddress, err := coordinator.LINKETHFEED(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		balance.setError(errors.Wrap(err, "failed to get LINK/ETH feed"))
+		return
+	}
+	aggregator, err := aggregator_v3_interface.NewAggregatorV3Interface(feedAddress, chain.Client())
+	if err != nil {
+		balance.setError(err)
+		return
+	}
+	roundData, err := aggregator.LatestRoundData(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		balance.setError(errors.Wrap(err, "failed to get LINK/ETH price"))
+		return
+	}
+	maxGasPriceWei := chain.Config().EvmMaxGasPriceWei()
+	if len(spec.FromAddresses) > 0 {
+		// Like the listener, assume that all keys have the same max gas price
+		maxGasPriceWei = chain.Config().KeySpecificMaxGasPriceWei(common.BytesToAddress(spec.FromAddresses[0]))
+	}
+	fee, err := EstimateFeeJuels(req.CallbackGasLimit, maxGasPriceWei, roundData.Answer)
+	if err != nil {
+		balance.setError(errors.Wrap(err, "failed to estimate fee"))
+		return
+	}
+	sufficient := balance.AvailableLink.Cmp(fee) >= 0
+	balance.EstimatedFeeJuels = fee
+	balance.Sufficient = &sufficient
+	return
+}
+
+// fulfillments returns the transactions matching the condition on eth_txes,
+// oldest first.
+func (i *Inspector) fulfillments(cond string, args ...interface{}) ([]Fulfillment, error) {
+	stmt := fmt.Sprintf(`
+SELECT eth_txes.id AS eth_tx_id, eth_txes.state, eth_txes.from_address, eth_txes.error, eth_txes.broadcast_at,
+	CAST(eth_txes.meta->>'MaxLink' AS NUMERIC(78, 0)) AS max_link,
+	a.hash, a.gas_price, a.gas_fee_cap, a.block_number, a.receipt
+FROM eth_txes
+LEFT JOIN LATERAL (
+	SELECT eth_tx_attempts.hash, eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap,
+		eth_receipts.block_number, eth_receipts.receipt - 'logs' AS receipt
+	FROM eth_tx_attempts
+	LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
+	ORDER BY eth_receipts.id IS NULL, eth_tx_attempts.id DESC
+	LIMIT 1
+) a ON true
+WHERE %s
+ORDER BY eth_txes.id`, cond)
+	var rows []fulfillmentRow
+	if err := i.q.Select(&rows, stmt, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to load fulfillments")
+	}
+	var fulfillments []Fulfillment
+	for _, row := range rows {
+		f, err := row.toFulfillment()
+		if err != nil {
+			return nil, err
+		}
+		fulfillments = append(fulfillments, f)
+	}
+	return fulfillments, nil
+}
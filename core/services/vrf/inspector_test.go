@@ -0,0 +1,78 @@
+package vrf
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestParseRequestID(t *testing.T) {
+	id, err := ParseRequestID("123")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(123), id)
+
+	id, err = ParseRequestID("0x7b")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(123), id)
+
+	for _, s := range []string{"", "abc", "0xzz", "-1"} {
+		_, err = ParseRequestID(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestFulfillmentRow_ToFulfillment(t *testing.T) {
+	t.Run("without receipt", func(t *testing.T) {
+		f, err := fulfillmentRow{Fulfillment: Fulfillment{EthTxID: 1}}.toFulfillment()
+		require.NoError(t, err)
+		assert.Nil(t, f.GasUsed)
+		assert.Nil(t, f.Fee)
+	})
+
+	t.Run("with effective gas price", func(t *testing.T) {
+		receipt, err := json.Marshal(evmtypes.Receipt{TxHash: common.HexToHash("0x1"), GasUsed: 100, EffectiveGasPrice: big.NewInt(3)})
+		require.NoError(t, err)
+		f, err := fulfillmentRow{Fulfillment: Fulfillment{EthTxID: 1}, GasPrice: utils.NewBigI(5), Receipt: receipt}.toFulfillment()
+		require.NoError(t, err)
+		require.NotNil(t, f.GasUsed)
+		assert.Equal(t, uint64(100), *f.GasUsed)
+		assert.Equal(t, "3", f.EffectiveGasPrice.String())
+		assert.Equal(t, big.NewInt(300), f.Fee.ToInt())
+	})
+
+	t.Run("falls back to the attempt gas price", func(t *testing.T) {
+		receipt, err := json.Marshal(evmtypes.Receipt{TxHash: common.HexToHash("0x1"), GasUsed: 100})
+		require.NoError(t, err)
+		f, err := fulfillmentRow{Fulfillment: Fulfillment{EthTxID: 1}, GasFeeCap: utils.NewBigI(7), Receipt: receipt}.toFulfillment()
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(700), f.Fee.ToInt())
+	})
+}
+
+func TestV1Status(t *testing.T) {
+	running := v1RunRow{State: pipeline.RunStatusRunning}
+	assert.Equal(t, RequestStatusProcessing, v1Status(running, nil))
+	assert.Equal(t, RequestStatusErrored, v1Status(v1RunRow{State: pipeline.RunStatusErrored}, nil))
+	assert.Equal(t, RequestStatusFulfillmentPending, v1Status(running, []Fulfillment{{State: string(txmgr.EthTxUnconfirmed)}}))
+	assert.Equal(t, RequestStatusFulfillmentFailed, v1Status(running, []Fulfillment{{State: string(txmgr.EthTxFatalError)}}))
+	assert.Equal(t, RequestStatusFulfillmentPending, v1Status(running, []Fulfillment{{State: string(txmgr.EthTxConfirmedMissingReceipt)}}))
+	assert.Equal(t, RequestStatusFulfilled, v1Status(running, []Fulfillment{
+		{State: string(txmgr.EthTxFatalError)},
+		{State: string(txmgr.EthTxConfirmed)},
+	}))
+	assert.Equal(t, RequestStatusFulfilled, v1Status(running, []Fulfillment{
+		{State: string(txmgr.EthTxConfirmed)},
+		{State: string(txmgr.EthTxFatalError)},
+	}))
+	// the fulfillment may have been reaped
+	assert.Equal(t, RequestStatusUnknown, v1Status(v1RunRow{State: pipeline.RunStatusCompleted}, nil))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	heaps "github.com/theodesp/go-heaps"
@@ -66,6 +67,7 @@ func newListenerV2(
 	chainID *big.Int,
 	logBroadcaster log.Broadcaster,
 	q pg.Q,
+	orm ORM,
 	coordinator vrf_coordinator_v2.VRFCoordinatorV2Interface,
 	batchCoordinator batch_vrf_coordinator_v2.BatchVRFCoordinatorV2Interface,
 	aggregator *aggregator_v3_interface.AggregatorV3Interface,
@@ -91,6 +93,7 @@ func newListenerV2(
 		pipelineRunner:     pipelineRunner,
 		job:                job,
 		q:                  q,
+		orm:                orm,
 		gethks:             gethks,
 		reqLogs:            reqLogs,
 		chStop:             make(chan struct{}),
@@ -143,12 +146,13 @@ type listenerV2 struct {
 	pipelineRunner pipeline.Runner
 	job            job.Job
 	q              pg.Q
+	orm            ORM
 	gethks         keystore.Eth
 	reqLogs        *utils.Mailbox[log.Broadcast]
 	chStop         chan struct{}
-	// We can keep these pending logs in memory because we
-	// only mark them confirmed once we send a corresponding fulfillment transaction.
-	// So on node restart in the middle of processing, the lb will resend them.
+	// Pending requests are also saved with their state by the orm, and
+	// loaded back on start, so a node restart in the middle of processing
+	// neither drops them nor resets their request timeout.
 	reqsMu   sync.Mutex // Both the log listener and the request handler write to reqs
 	reqs     []pendingRequest
 	reqAdded func() // A simple debug helper
@@ -182,6 +186,10 @@ func (lsn *listenerV2) Start(context.Context) error {
 	return lsn.StartOnce("VRFListenerV2", func() error {
 		spec := job.LoadEnvConfigVarsVRF(lsn.cfg, *lsn.job.VRFSpec)
 
+		// Load the pending requests before the log broadcaster redelivers
+		// their logs, so that the redelivered logs are recognised as duplicates.
+		lsn.loadPendingRequests()
+
 		unsubscribeLogs := lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
 			Contract: lsn.coordinator.Address(),
 			ParseLog: lsn.coordinator.ParseLog,
@@ -218,6 +226,74 @@ func (lsn *listenerV2) Start(context.Context) error {
 	})
 }
 
+// loadPendingRequests loads the requests that were pending when the node
+// stopped. Failing to load them does not prevent the job from starting, as the
+// log broadcaster redelivers the logs that were not consumed.
+func (lsn *listenerV2) loadPendingRequests() {
+	saved, err := lsn.orm.PendingRequestsV2(lsn.job.ID)
+	if err != nil {
+		lsn.l.Errorw("Unable to load pending requests", "err", err)
+		return
+	}
+
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	for _, s := range saved {
+		rawLog := types.Log(s.RawLog)
+		req, err := lsn.coordinator.ParseRandomWordsRequested(rawLog)
+		if err != nil {
+			lsn.l.Errorw("Unable to parse log of pending request", "err", err, "reqID", s.RequestID.String())
+			continue
+		}
+		pr := pendingRequest{
+			confirmedAtBlock: s.ConfirmedAtBlock,
+			req:              req,
+			lb:               newStoredBroadcast(rawLog, *lsn.chainID, req, lsn.job.ID),
+			utcTimestamp:     s.CreatedAt.UTC(),
+			attempts:         int(s.Attempts),
+		}
+		if s.LastTryAt != nil {
+			pr.lastTry = s.LastTryAt.UTC()
+		}
+		lsn.reqs = append(lsn.reqs, pr)
+	}
+	lsn.l.Infow("Loaded pending requests", "count", len(lsn.reqs))
+}
+
+// storedBroadcast is the broadcast of a request log loaded from the database.
+type storedBroadcast struct {
+	log.Broadcast
+	jobID int32
+}
+
+func newStoredBroadcast(rawLog types.Log, chainID big.Int, decodedLog interface{}, jobID int32) log.Broadcast {
+	return storedBroadcast{log.NewLogBroadcast(rawLog, chainID, decodedLog), jobID}
+}
+
+// JobID returns the ID of the job the log was delivered to, so that it is
+// marked consumed for that job.
+func (b storedBroadcast) JobID() int32 {
+	return b.jobID
+}
+
+// setRequestsState saves the state of the given requests. Failing to save it
+// is logged, and does not stop the requests from being processed.
+func (lsn *listenerV2) setRequestsState(l logger.Logger, requestIDs []*big.Int, state RequestState, reqErr error) {
+	var errMsg string
+	if reqErr != nil {
+		errMsg = reqErr.Error()
+	}
+	err := lsn.orm.UpdateRequestsStateV2(lsn.job.ID, requestIDs, state, errMsg)
+	l.ErrorIf(err, fmt.Sprintf("Unable to set state of requests to %s", state))
+}
+
+// markRequestsFulfilled saves the given requests as fulfilled, with a nil
+// ethTxID if they were already fulfilled on chain.
+func (lsn *listenerV2) markRequestsFulfilled(l logger.Logger, requestIDs []*big.Int, ethTxID *int64) {
+	err := lsn.orm.MarkRequestsFulfilledV2(lsn.job.ID, requestIDs, ethTxID)
+	l.ErrorIf(err, "Unable to mark requests as fulfilled")
+}
+
 func (lsn *listenerV2) setLatestHead(head *evmtypes.Head) {
 	lsn.latestHeadMu.Lock()
 	defer lsn.latestHeadMu.Unlock()
@@ -328,12 +404,15 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 	// Add any unprocessed requests back to lsn.reqs after request processing is complete.
 	defer func() {
 		var toKeep []pendingRequest
+		var attempted []*big.Int
+		lastTry := time.Now().UTC()
 		for _, subReqs := range confirmed {
 			for _, req := range subReqs {
 				if _, ok := processed[req.req.RequestId.String()]; !ok {
 					req.attempts++
-					req.lastTry = time.Now().UTC()
+					req.lastTry = lastTry
 					toKeep = append(toKeep, req)
+					attempted = append(attempted, req.req.RequestId)
 					if lsn.job.VRFSpec.BackoffInitialDelay != 0 {
 						lsn.l.Infow("Request failed, next retry will be delayed.",
 							"reqID", req.req.RequestId.String(),
@@ -349,6 +428,9 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 				}
 			}
 		}
+		err := lsn.orm.RecordRequestsAttemptV2(lsn.job.ID, attempted, lastTry)
+		lsn.l.ErrorIf(err, "Unable to record attempts of requests")
+
 		// There could be logs accumulated to this slice while request processor is running,
 		// so we merged the new ones with the ones that need to be requeued.
 		lsn.reqsMu.Lock()
@@ -379,6 +461,8 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 
 		if !lsn.shouldProcessSub(subID, sub, reqs) {
 			lsn.l.Warnw("Not processing sub", "subID", subID, "balance", sub.Balance)
+			lsn.setRequestsState(lsn.l, requestIDs(reqs), RequestStateInsufficientBalance,
+				errors.Errorf("subscription balance %s is too low to fulfill a request", sub.Balance))
 			continue
 		}
 
@@ -438,6 +522,19 @@ func (lsn *listenerV2) shouldProcessSub(subID uint64, sub vrf_coordinator_v2.Get
 // have not been fully confirmed yet on-chain, and subtracts that from the given startBalance,
 // and returns that value if there are no errors.
 func MaybeSubtractReservedLink(l logger.Logger, q pg.Q, startBalance *big.Int, chainID, subID uint64) (*big.Int, error) {
+	reservedLink, err := ReservedLink(q, chainID, subID)
+	if err != nil {
+		l.Errorw("Could not get reserved link", "err", err)
+		return nil, err
+	}
+
+	return new(big.Int).Sub(startBalance, reservedLink), nil
+}
+
+// ReservedLink returns the LINK reserved for the fulfillments of the requests
+// of the subscription which are not confirmed yet on-chain, which is the sum of
+// the max LINK they could be billed.
+func ReservedLink(q pg.Q, chainID, subID uint64) (*big.Int, error) {
 	var reservedLink string
 	err := q.Get(&reservedLink, `SELECT SUM(CAST(meta->>'MaxLink' AS NUMERIC(78, 0)))
 				   FROM eth_txes
@@ -447,21 +544,17 @@ func MaybeSubtractReservedLink(l logger.Logger, q pg.Q, startBalance *big.Int, c
 				   AND state IN ('unconfirmed', 'unstarted', 'in_progress')
 				   GROUP BY meta->>'SubId'`, chainID, subID)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		l.Errorw("Could not get reserved link", "err", err)
 		return nil, err
 	}
 
-	if reservedLink != "" {
-		reservedLinkInt, success := big.NewInt(0).SetString(reservedLink, 10)
-		if !success {
-			l.Errorw("Error converting reserved link", "reservedLink", reservedLink)
-			return nil, errors.New("unable to convert returned link")
-		}
-
-		return new(big.Int).Sub(startBalance, reservedLinkInt), nil
+	if reservedLink == "" {
+		return big.NewInt(0), nil
 	}
-
-	return new(big.Int).Set(startBalance), nil
+	reservedLinkInt, success := big.NewInt(0).SetString(reservedLink, 10)
+	if !success {
+		return nil, errors.Errorf("unable to convert reserved link %q", reservedLink)
+	}
+	return reservedLinkInt, nil
 }
 
 type fulfilledReqV2 struct {
@@ -555,14 +648,17 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 		} else if err != nil {
 			l.Errorw("Error checking for already fulfilled requests, proceeding anyway", "err", err)
 		}
+		var fulfilled []*big.Int
 		for i, a := range alreadyFulfilled {
 			if a {
 				lsn.markLogAsConsumed(chunk[i].lb)
 				processed[chunk[i].req.RequestId.String()] = struct{}{}
+				fulfilled = append(fulfilled, chunk[i].req.RequestId)
 			} else {
 				unfulfilled = append(unfulfilled, chunk[i])
 			}
 		}
+		lsn.markRequestsFulfilled(l, fulfilled, nil)
 
 		fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, lsn.fromAddresses()...)
 		if err != nil {
@@ -586,10 +682,12 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 			if p.err != nil {
 				if startBalanceNoReserveLink.Cmp(p.juelsNeeded) < 0 {
 					ll.Infow("Insufficient link balance to fulfill a request based on estimate, returning")
+					lsn.setRequestsState(ll, []*big.Int{p.req.req.RequestId}, RequestStateInsufficientBalance, p.err)
 					return processed
 				}
 
 				ll.Errorw("Pipeline error", "err", p.err)
+				lsn.setRequestsState(ll, []*big.Int{p.req.req.RequestId}, RequestStateSimulationReverted, p.err)
 				continue
 			}
 
@@ -598,6 +696,8 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient link balance to fulfill a request, breaking")
+				lsn.setRequestsState(ll, []*big.Int{p.req.req.RequestId}, RequestStateInsufficientBalance,
+					insufficientBalanceError(startBalanceNoReserveLink, p.maxLink))
 				break
 			}
 
@@ -679,14 +779,17 @@ func (lsn *listenerV2) processRequestsPerSub(
 		} else if err != nil {
 			l.Errorw("Error checking for already fulfilled requests, proceeding anyway", "err", err)
 		}
+		var fulfilled []*big.Int
 		for i, a := range alreadyFulfilled {
 			if a {
 				lsn.markLogAsConsumed(chunk[i].lb)
 				processed[chunk[i].req.RequestId.String()] = struct{}{}
+				fulfilled = append(fulfilled, chunk[i].req.RequestId)
 			} else {
 				unfulfilled = append(unfulfilled, chunk[i])
 			}
 		}
+		lsn.markRequestsFulfilled(l, fulfilled, nil)
 
 		fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, lsn.fromAddresses()...)
 		if err != nil {
@@ -709,16 +812,20 @@ func (lsn *listenerV2) processRequestsPerSub(
 			if p.err != nil {
 				if startBalanceNoReserveLink.Cmp(p.juelsNeeded) < 0 {
 					ll.Infow("Insufficient link balance to fulfill a request based on estimate, returning")
+					lsn.setRequestsState(ll, []*big.Int{p.req.req.RequestId}, RequestStateInsufficientBalance, p.err)
 					return processed
 				}
 
 				ll.Errorw("Pipeline error", "err", p.err)
+				lsn.setRequestsState(ll, []*big.Int{p.req.req.RequestId}, RequestStateSimulationReverted, p.err)
 				continue
 			}
 
 			if startBalanceNoReserveLink.Cmp(p.maxLink) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
 				ll.Infow("Insufficient link balance to fulfill a request, returning")
+				lsn.setRequestsState(ll, []*big.Int{p.req.req.RequestId}, RequestStateInsufficientBalance,
+					insufficientBalanceError(startBalanceNoReserveLink, p.maxLink))
 				return processed
 			}
 
@@ -751,13 +858,17 @@ func (lsn *listenerV2) processRequestsPerSub(
 						VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw.BlockNumber),
 					},
 				}, pg.WithQueryer(tx), pg.WithParentCtx(ctx))
-				return err
+				if err != nil {
+					return err
+				}
+				return lsn.orm.MarkRequestsFulfilledV2(lsn.job.ID, []*big.Int{p.req.req.RequestId}, &ethTX.ID, pg.WithQueryer(tx))
 			})
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
 				continue
 			}
 			ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.ID)
+			lsn.pipelineRunner.NotifyRunsFinished(&p.run)
 
 			// If we successfully enqueued for the txm, subtract that balance
 			// And loop to attempt to enqueue another fulfillment
@@ -1082,8 +1193,17 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 		return
 	}
 
+	if lsn.isPending(req.Raw) {
+		lsn.l.Debugw("Request is already pending", "reqID", req.RequestId, "txHash", req.Raw.TxHash)
+		return
+	}
+
 	confirmedAt := lsn.getConfirmedAt(req, minConfs)
 	lsn.l.Infow("VRFListenerV2: Received log request", "reqID", req.RequestId, "confirmedAt", confirmedAt, "subID", req.SubId, "sender", req.Sender)
+	saved := NewRequestV2(lsn.job.ID, lsn.chainID, req, confirmedAt)
+	if err = lsn.orm.UpsertRequestV2(&saved); err != nil {
+		lsn.l.Errorw("Unable to save request, processing it anyway", "err", err, "reqID", req.RequestId)
+	}
 	lsn.reqsMu.Lock()
 	lsn.reqs = append(lsn.reqs, pendingRequest{
 		confirmedAtBlock: confirmedAt,
@@ -1095,6 +1215,20 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 	lsn.reqsMu.Unlock()
 }
 
+// isPending returns whether the request of the given log is already pending,
+// which is the case for the logs of the requests loaded on start when the log
+// broadcaster redelivers them.
+func (lsn *listenerV2) isPending(rawLog types.Log) bool {
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	for _, r := range lsn.reqs {
+		if r.req.Raw.BlockHash == rawLog.BlockHash && r.req.Raw.Index == rawLog.Index {
+			return true
+		}
+	}
+	return false
+}
+
 func (lsn *listenerV2) markLogAsConsumed(lb log.Broadcast) {
 	err := lsn.logBroadcaster.MarkConsumed(lb)
 	lsn.l.ErrorIf(err, fmt.Sprintf("Unable to mark log %v as consumed", lb.String()))
@@ -1136,6 +1270,18 @@ func (lsn *listenerV2) fromAddresses() []common.Address {
 	return addresses
 }
 
+func requestIDs(reqs []pendingRequest) []*big.Int {
+	ids := make([]*big.Int, len(reqs))
+	for i, r := range reqs {
+		ids[i] = r.req.RequestId
+	}
+	return ids
+}
+
+func insufficientBalanceError(balance, maxLink *big.Int) error {
+	return errors.Errorf("subscription balance %s, less the LINK reserved for pending fulfillments, is below the max LINK %s needed", balance, maxLink)
+}
+
 func uniqueReqs(reqs []pendingRequest) int {
 	s := map[string]struct{}{}
 	for _, r := range reqs {
@@ -433,3 +433,77 @@ func TestListener_ShouldProcessSub_NoFromAddresses(t *testing.T) {
 	})
 	assert.True(t, shouldProcess) // no addresses, but try to process it.
 }
+
+// pendingRequestsORM returns saved requests as pending, and ignores the rest
+// of the ORM, which is left nil.
+type pendingRequestsORM struct {
+	ORM
+	pending []RequestV2
+}
+
+func (o *pendingRequestsORM) PendingRequestsV2(jobID int32, qopts ...pg.QOpt) ([]RequestV2, error) {
+	return o.pending, nil
+}
+
+func newRandomWordsRequestedLog(t *testing.T, requestID *big.Int, subID uint64, sender common.Address) types.Log {
+	event := coordinatorV2ABI.Events["RandomWordsRequested"]
+	data, err := event.Inputs.NonIndexed().Pack(requestID, big.NewInt(42), uint16(3), uint32(100_000), uint32(1))
+	require.NoError(t, err)
+	return types.Log{
+		Address: common.HexToAddress("0x7Bf4E7069d96eEce4f48F50A9768f8615A8cD6D8"),
+		Topics: []common.Hash{
+			event.ID,
+			utils.NewHash(),
+			common.BigToHash(new(big.Int).SetUint64(subID)),
+			sender.Hash(),
+		},
+		Data:        data,
+		BlockNumber: 10,
+		TxHash:      utils.NewHash(),
+		BlockHash:   utils.NewHash(),
+		Index:       2,
+	}
+}
+
+func TestListener_LoadPendingRequests(t *testing.T) {
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(common.HexToAddress("0x7Bf4E7069d96eEce4f48F50A9768f8615A8cD6D8"), nil)
+	require.NoError(t, err)
+
+	sender := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	rawLog := newRandomWordsRequestedLog(t, big.NewInt(7), 3, sender)
+	req, err := coordinator.ParseRandomWordsRequested(rawLog)
+	require.NoError(t, err)
+
+	chainID := big.NewInt(1337)
+	saved := NewRequestV2(11, chainID, req, 13)
+	saved.Attempts = 2
+	lastTry := time.Now().Add(-time.Minute)
+	saved.LastTryAt = &lastTry
+	saved.CreatedAt = time.Now().Add(-time.Hour)
+	unparseable := NewRequestV2(11, chainID, req, 13)
+	unparseable.RawLog.Topics = unparseable.RawLog.Topics[:1]
+
+	lsn := &listenerV2{
+		job:         job.Job{ID: 11},
+		l:           logger.TestLogger(t),
+		chainID:     chainID,
+		coordinator: coordinator,
+		orm:         &pendingRequestsORM{pending: []RequestV2{saved, unparseable}},
+	}
+	lsn.loadPendingRequests()
+
+	require.Len(t, lsn.reqs, 1)
+	loaded := lsn.reqs[0]
+	assert.Equal(t, uint64(13), loaded.confirmedAtBlock)
+	assert.Equal(t, 2, loaded.attempts)
+	assert.True(t, lastTry.Equal(loaded.lastTry))
+	assert.True(t, saved.CreatedAt.Equal(loaded.utcTimestamp))
+	assert.Equal(t, "7", loaded.req.RequestId.String())
+	assert.Equal(t, uint64(3), loaded.req.SubId)
+	assert.Equal(t, sender, loaded.req.Sender)
+	assert.Equal(t, int32(11), loaded.lb.JobID())
+	assert.Equal(t, rawLog, loaded.lb.RawLog())
+
+	assert.True(t, lsn.isPending(rawLog))
+	assert.False(t, lsn.isPending(newRandomWordsRequestedLog(t, big.NewInt(7), 3, sender)))
+}
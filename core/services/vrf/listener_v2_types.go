@@ -157,14 +157,18 @@ func (lsn *listenerV2) processBatch(
 				SubID:      &subID,
 			},
 		}, pg.WithQueryer(tx))
+		if err != nil {
+			return errors.Wrap(err, "create batch fulfillment eth transaction")
+		}
 
-		return errors.Wrap(err, "create batch fulfillment eth transaction")
+		return lsn.orm.MarkRequestsFulfilledV2(lsn.job.ID, batch.reqIDs, &ethTX.ID, pg.WithQueryer(tx))
 	})
 	if err != nil {
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
 		return
 	}
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.ID)
+	lsn.pipelineRunner.NotifyRunsFinished(batch.runs...)
 
 	// mark requests as processed since the fulfillment has been successfully enqueued
 	// to the txm.
@@ -181,6 +185,12 @@ func (lsn *listenerV2) processBatch(
 // getUnconsumed returns the requests in the given slice that are not expired
 // and not marked consumed in the log broadcaster.
 func (lsn *listenerV2) getUnconsumed(l logger.Logger, reqs []pendingRequest) (unconsumed []pendingRequest, processed []string) {
+	var expired, consumed []*big.Int
+	defer func() {
+		lsn.setRequestsState(l, expired, RequestStateExpired, nil)
+		lsn.markRequestsFulfilled(l, consumed, nil)
+	}()
+
 	for _, req := range reqs {
 		// Check if we can ignore the request due to its age.
 		if time.Now().UTC().Sub(req.utcTimestamp) >= lsn.job.VRFSpec.RequestTimeout {
@@ -190,20 +200,22 @@ func (lsn *listenerV2) getUnconsumed(l logger.Logger, reqs []pendingRequest) (un
 			lsn.markLogAsConsumed(req.lb)
 			processed = append(processed, req.req.RequestId.String())
 			incDroppedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, v2, reasonAge)
+			expired = append(expired, req.req.RequestId)
 			continue
 		}
 
 		// This check to see if the log was consumed needs to be in the same
 		// goroutine as the mark consumed to avoid processing duplicates.
-		consumed, err := lsn.logBroadcaster.WasAlreadyConsumed(req.lb)
+		wasConsumed, err := lsn.logBroadcaster.WasAlreadyConsumed(req.lb)
 		if err != nil {
 			// Do not process for now, retry on next iteration.
 			l.Errorw("Could not determine if log was already consumed",
 				"reqID", req.req.RequestId.String(),
 				"txHash", req.req.Raw.TxHash,
 				"error", err)
-		} else if consumed {
+		} else if wasConsumed {
 			processed = append(processed, req.req.RequestId.String())
+			consumed = append(consumed, req.req.RequestId)
 		} else {
 			unconsumed = append(unconsumed, req)
 		}
@@ -0,0 +1,308 @@
+package vrf
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// RequestState is the state of a VRF v2 request received by a job.
+type RequestState string
+
+const (
+	// RequestStateWaitingForConfirmations is the state of a request until it
+	// has the confirmations required to be fulfilled.
+	RequestStateWaitingForConfirmations RequestState = "waiting_for_confirmations"
+	// RequestStateInsufficientBalance is the state of a request whose
+	// subscription does not have enough LINK to pay for its fulfillment.
+	RequestStateInsufficientBalance RequestState = "insufficient_balance"
+	// RequestStateSimulationReverted is the state of a request whose
+	// fulfillment reverted when simulated.
+	RequestStateSimulationReverted RequestState = "simulation_reverted"
+	// RequestStateFulfilled is the state of a request whose fulfillment
+	// transaction was enqueued, or which was already fulfilled on chain.
+	RequestStateFulfilled RequestState = "fulfilled"
+	// RequestStateExpired is the state of a request that was dropped because
+	// it was older than the request timeout of the job.
+	RequestStateExpired RequestState = "expired"
+)
+
+// ParseRequestState parses a request state, as stored in the database.
+func ParseRequestState(s string) (RequestState, error) {
+	switch state := RequestState(s); state {
+	case RequestStateWaitingForConfirmations, RequestStateInsufficientBalance,
+		RequestStateSimulationReverted, RequestStateFulfilled, RequestStateExpired:
+		return state, nil
+	default:
+		return "", errors.Errorf("invalid request state: %q", s)
+	}
+}
+
+// IsFinal returns whether requests in this state are no longer processed.
+func (s RequestState) IsFinal() bool {
+	return s == RequestStateFulfilled || s == RequestStateExpired
+}
+
+// RawLog is the log of a request, stored as JSON.
+type RawLog types.Log
+
+// Value implements the driver.Valuer interface.
+func (l RawLog) Value() (driver.Value, error) {
+	return json.Marshal(types.Log(l))
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *RawLog) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("unable to convert %v of %T to RawLog", value, value)
+	}
+	var lg types.Log
+	if err := json.Unmarshal(b, &lg); err != nil {
+		return errors.Wrap(err, "unable to unmarshal RawLog")
+	}
+	*l = RawLog(lg)
+	return nil
+}
+
+// RequestV2 is a VRF v2 request received by a job, along with the state of
+// its processing.
+type RequestV2 struct {
+	ID                      int64
+	JobID                   int32
+	EVMChainID              utils.Big `db:"evm_chain_id"`
+	RequestID               utils.Big
+	SubID                   uint64
+	Sender                  common.Address
+	CallbackGasLimit        uint32
+	NumWords                uint32
+	MinRequestConfirmations uint16
+	RequestTxHash           common.Hash
+	RequestBlockNumber      uint64
+	RawLog                  RawLog
+	ConfirmedAtBlock        uint64
+	State                   RequestState
+	Error                   *string
+	Attempts                int64
+	LastTryAt               *time.Time
+	EthTxID                 *int64
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+// NewRequestV2 returns the request of the given log, waiting for
+// confirmations until the given block.
+func NewRequestV2(jobID int32, chainID *big.Int, req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, confirmedAtBlock uint64) RequestV2 {
+	return RequestV2{
+		JobID:                   jobID,
+		EVMChainID:              *utils.NewBig(chainID),
+		RequestID:               *utils.NewBig(req.RequestId),
+		SubID:                   req.SubId,
+		Sender:                  req.Sender,
+		CallbackGasLimit:        req.CallbackGasLimit,
+		NumWords:                req.NumWords,
+		MinRequestConfirmations: req.MinimumRequestConfirmations,
+		RequestTxHash:           req.Raw.TxHash,
+		RequestBlockNumber:      req.Raw.BlockNumber,
+		RawLog:                  RawLog(req.Raw),
+		ConfirmedAtBlock:        confirmedAtBlock,
+		State:                   RequestStateWaitingForConfirmations,
+	}
+}
+
+// RequestsV2Filter filters the requests listed by RequestsV2. Nil fields
+// match every request.
+type RequestsV2Filter struct {
+	JobID *int32
+	SubID *uint64
+	State *RequestState
+}
+
+// ORM persists the VRF v2 requests received by jobs, so that the requests
+// which are pending are not lost when the node restarts.
+type ORM interface {
+	// UpsertRequestV2 saves a request which was received by a job. A request
+	// received again, for instance after a reorg, is waiting for
+	// confirmations again, but keeps the time it was first received at.
+	// Requests which are already fulfilled or expired are left as they are,
+	// and req is set to the saved request.
+	UpsertRequestV2(req *RequestV2, qopts ...pg.QOpt) error
+	// PendingRequestsV2 returns the requests of the job which are not in a
+	// final state, oldest first.
+	PendingRequestsV2(jobID int32, qopts ...pg.QOpt) ([]RequestV2, error)
+	// UpdateRequestsStateV2 sets the state of the given requests of the job,
+	// along with the error which caused it, if any.
+	UpdateRequestsStateV2(jobID int32, requestIDs []*big.Int, state RequestState, reqErr string, qopts ...pg.QOpt) error
+	// MarkRequestsFulfilledV2 marks the given requests of the job as
+	// fulfilled, by the given eth transaction if not nil.
+	MarkRequestsFulfilledV2(jobID int32, requestIDs []*big.Int, ethTxID *int64, qopts ...pg.QOpt) error
+	// RecordRequestsAttemptV2 records a failed attempt at fulfilling the
+	// given requests of the job.
+	RecordRequestsAttemptV2(jobID int32, requestIDs []*big.Int, at time.Time, qopts ...pg.QOpt) error
+	// RequestsV2 returns a page of the requests matching the filter, most
+	// recent first, and the total number of requests matching it.
+	RequestsV2(filter RequestsV2Filter, offset, limit int) ([]RequestV2, int, error)
+	// FindRequestsV2 returns the requests, of any job, with the given request
+	// ID or sent in the transaction with the given hash, oldest first.
+	FindRequestsV2(requestID *big.Int, txHash *common.Hash) ([]RequestV2, error)
+	// PendingRequestsForSubscriptionV2 returns the requests of the
+	// subscription which are not in a final state, oldest first.
+	PendingRequestsForSubscriptionV2(chainID *big.Int, subID uint64) ([]RequestV2, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	namedLogger := lggr.Named("VRFORM")
+	return &orm{pg.NewQ(db, namedLogger, cfg)}
+}
+
+func (o *orm) UpsertRequestV2(req *RequestV2, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	stmt := fmt.Sprintf(`INSERT INTO vrf_v2_requests (job_id, evm_chain_id, request_id, sub_id, sender, callback_gas_limit, num_words,
+	min_request_confirmations, request_tx_hash, request_block_number, raw_log, confirmed_at_block, state, created_at, updated_at)
+VALUES (:job_id, :evm_chain_id, :request_id, :sub_id, :sender, :callback_gas_limit, :num_words,
+	:min_request_confirmations, :request_tx_hash, :request_block_number, :raw_log, :confirmed_at_block, :state, NOW(), NOW())
+ON CONFLICT (job_id, request_id) DO UPDATE SET
+	sender = EXCLUDED.sender,
+	callback_gas_limit = EXCLUDED.callback_gas_limit,
+	num_words = EXCLUDED.num_words,
+	min_request_confirmations = EXCLUDED.min_request_confirmations,
+	request_tx_hash = EXCLUDED.request_tx_hash,
+	request_block_number = EXCLUDED.request_block_number,
+	raw_log = EXCLUDED.raw_log,
+	confirmed_at_block = EXCLUDED.confirmed_at_block,
+	state = EXCLUDED.state,
+	error = NULL,
+	attempts = 0,
+	last_try_at = NULL,
+	updated_at = NOW()
+WHERE vrf_v2_requests.state NOT IN ('%s', '%s')
+RETURNING *`, RequestStateFulfilled, RequestStateExpired)
+	err := q.GetNamed(stmt, req, req)
+	if errors.Is(err, sql.ErrNoRows) {
+		// the request is in a final state, so it was not updated
+		err = q.Get(req, `SELECT * FROM vrf_v2_requests WHERE job_id = $1 AND request_id = $2`, req.JobID, req.RequestID)
+	}
+	return errors.Wrap(err, "UpsertRequestV2 failed to save request")
+}
+
+func (o *orm) PendingRequestsV2(jobID int32, qopts ...pg.QOpt) (reqs []RequestV2, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `SELECT * FROM vrf_v2_requests WHERE job_id = $1 AND state NOT IN ($2, $3) ORDER BY id ASC`
+	err = q.Select(&reqs, sql, jobID, RequestStateFulfilled, RequestStateExpired)
+	return reqs, errors.Wrap(err, "PendingRequestsV2 failed to load requests")
+}
+
+func (o *orm) UpdateRequestsStateV2(jobID int32, requestIDs []*big.Int, state RequestState, reqErr string, qopts ...pg.QOpt) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE vrf_v2_requests SET state = $3, error = NULLIF($4, ''), updated_at = NOW()
+WHERE job_id = $1 AND request_id = ANY($2::numeric[])`
+	err := q.ExecQ(sql, jobID, requestIDsArray(requestIDs), state, reqErr)
+	return errors.Wrap(err, "UpdateRequestsStateV2 failed to update requests")
+}
+
+func (o *orm) MarkRequestsFulfilledV2(jobID int32, requestIDs []*big.Int, ethTxID *int64, qopts ...pg.QOpt) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE vrf_v2_requests SET state = $3, error = NULL, eth_tx_id = COALESCE($4, eth_tx_id), updated_at = NOW()
+WHERE job_id = $1 AND request_id = ANY($2::numeric[])`
+	err := q.ExecQ(sql, jobID, requestIDsArray(requestIDs), RequestStateFulfilled, ethTxID)
+	return errors.Wrap(err, "MarkRequestsFulfilledV2 failed to update requests")
+}
+
+func (o *orm) RecordRequestsAttemptV2(jobID int32, requestIDs []*big.Int, at time.Time, qopts ...pg.QOpt) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE vrf_v2_requests SET attempts = attempts + 1, last_try_at = $3, updated_at = NOW()
+WHERE job_id = $1 AND request_id = ANY($2::numeric[])`
+	err := q.ExecQ(sql, jobID, requestIDsArray(requestIDs), at)
+	return errors.Wrap(err, "RecordRequestsAttemptV2 failed to update requests")
+}
+
+func (o *orm) RequestsV2(filter RequestsV2Filter, offset, limit int) (reqs []RequestV2, count int, err error) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	if filter.JobID != nil {
+		args = append(args, *filter.JobID)
+		conds = append(conds, fmt.Sprintf("job_id = $%d", len(args)))
+	}
+	if filter.SubID != nil {
+		args = append(args, *filter.SubID)
+		conds = append(conds, fmt.Sprintf("sub_id = $%d", len(args)))
+	}
+	if filter.State != nil {
+		args = append(args, *filter.State)
+		conds = append(conds, fmt.Sprintf("state = $%d", len(args)))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, "SELECT COUNT(*) FROM vrf_v2_requests "+where, args...); err != nil {
+			return errors.Wrap(err, "RequestsV2 failed to get count")
+		}
+		sql := fmt.Sprintf(`SELECT * FROM vrf_v2_requests %s ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d`,
+			where, len(args)+1, len(args)+2)
+		if err = tx.Select(&reqs, sql, append(args, limit, offset)...); err != nil {
+			return errors.Wrap(err, "RequestsV2 failed to load requests")
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+
+	return
+}
+
+func (o *orm) FindRequestsV2(requestID *big.Int, txHash *common.Hash) (reqs []RequestV2, err error) {
+	var id *utils.Big
+	if requestID != nil {
+		id = utils.NewBig(requestID)
+	}
+	sql := `SELECT * FROM vrf_v2_requests WHERE request_id = $1 OR request_tx_hash = $2 ORDER BY id ASC`
+	err = o.q.Select(&reqs, sql, id, txHash)
+	return reqs, errors.Wrap(err, "FindRequestsV2 failed to load requests")
+}
+
+func (o *orm) PendingRequestsForSubscriptionV2(chainID *big.Int, subID uint64) (reqs []RequestV2, err error) {
+	sql := `SELECT * FROM vrf_v2_requests WHERE evm_chain_id = $1 AND sub_id = $2 AND state NOT IN ($3, $4) ORDER BY id ASC`
+	err = o.q.Select(&reqs, sql, utils.NewBig(chainID), subID, RequestStateFulfilled, RequestStateExpired)
+	return reqs, errors.Wrap(err, "PendingRequestsForSubscriptionV2 failed to load requests")
+}
+
+func requestIDsArray(requestIDs []*big.Int) pq.StringArray {
+	ids := make(pq.StringArray, len(requestIDs))
+	for i, id := range requestIDs {
+		ids[i] = id.String()
+	}
+	return ids
+}
@@ -0,0 +1,195 @@
+package vrf_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+)
+
+func newRandomWordsRequested(requestID int64, subID uint64) *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested {
+	return &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId:                   big.NewInt(requestID),
+		PreSeed:                     big.NewInt(42),
+		SubId:                       subID,
+		MinimumRequestConfirmations: 3,
+		CallbackGasLimit:            100_000,
+		NumWords:                    2,
+		Sender:                      testutils.NewAddress(),
+		Raw: types.Log{
+			Address:     testutils.NewAddress(),
+			Topics:      []common.Hash{testutils.Random32Byte()},
+			Data:        []byte{1, 2, 3},
+			BlockNumber: 10,
+			TxHash:      testutils.Random32Byte(),
+			BlockHash:   testutils.Random32Byte(),
+			Index:       1,
+		},
+	}
+}
+
+func TestParseRequestState(t *testing.T) {
+	state, err := vrf.ParseRequestState("insufficient_balance")
+	require.NoError(t, err)
+	assert.Equal(t, vrf.RequestStateInsufficientBalance, state)
+	assert.False(t, state.IsFinal())
+	assert.True(t, vrf.RequestStateExpired.IsFinal())
+
+	_, err = vrf.ParseRequestState("pending")
+	require.EqualError(t, err, `invalid request state: "pending"`)
+}
+
+func TestRawLog_ValueScan(t *testing.T) {
+	raw := vrf.RawLog(newRandomWordsRequested(1, 1).Raw)
+	value, err := raw.Value()
+	require.NoError(t, err)
+
+	var scanned vrf.RawLog
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, raw, scanned)
+
+	require.Error(t, scanned.Scan("not bytes"))
+}
+
+func TestORM_RequestsV2(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	keyStore := cltest.NewKeyStore(t, db, config)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewORM(db, cc, pipelineORM, keyStore, logger.TestLogger(t), config)
+
+	jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(&jb))
+
+	orm := vrf.NewORM(db, logger.TestLogger(t), config)
+	chainID := &cltest.FixtureChainID
+
+	req1 := vrf.NewRequestV2(jb.ID, chainID, newRandomWordsRequested(1, 5), 13)
+	require.NoError(t, orm.UpsertRequestV2(&req1))
+	req2 := vrf.NewRequestV2(jb.ID, chainID, newRandomWordsRequested(2, 6), 14)
+	require.NoError(t, orm.UpsertRequestV2(&req2))
+	assert.NotZero(t, req1.ID)
+	assert.False(t, req1.CreatedAt.IsZero())
+
+	t.Run("loads pending requests", func(t *testing.T) {
+		pending, err := orm.PendingRequestsV2(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, req1.ID, pending[0].ID)
+		assert.Equal(t, vrf.RequestStateWaitingForConfirmations, pending[0].State)
+		assert.Equal(t, "1", pending[0].RequestID.String())
+		assert.Equal(t, uint64(5), pending[0].SubID)
+		assert.Equal(t, uint64(13), pending[0].ConfirmedAtBlock)
+		assert.Equal(t, uint32(100_000), pending[0].CallbackGasLimit)
+		assert.Equal(t, req1.Sender, pending[0].Sender)
+		assert.Equal(t, req1.RawLog, pending[0].RawLog)
+	})
+
+	t.Run("updates state and attempts", func(t *testing.T) {
+		require.NoError(t, orm.UpdateRequestsStateV2(jb.ID, []*big.Int{big.NewInt(1)}, vrf.RequestStateSimulationReverted, "execution reverted"))
+		lastTry := time.Now()
+		require.NoError(t, orm.RecordRequestsAttemptV2(jb.ID, []*big.Int{big.NewInt(1), big.NewInt(2)}, lastTry))
+
+		pending, err := orm.PendingRequestsV2(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, vrf.RequestStateSimulationReverted, pending[0].State)
+		require.NotNil(t, pending[0].Error)
+		assert.Equal(t, "execution reverted", *pending[0].Error)
+		assert.Equal(t, int64(1), pending[0].Attempts)
+		require.NotNil(t, pending[0].LastTryAt)
+		assert.Equal(t, vrf.RequestStateWaitingForConfirmations, pending[1].State)
+		assert.Nil(t, pending[1].Error)
+		assert.Equal(t, int64(1), pending[1].Attempts)
+	})
+
+	t.Run("marks requests fulfilled", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth())
+		etx := cltest.MustInsertUnconfirmedEthTx(t, cltest.NewTxmORM(t, db, config), 0, fromAddress)
+		require.NoError(t, orm.MarkRequestsFulfilledV2(jb.ID, []*big.Int{big.NewInt(1)}, &etx.ID))
+		// Already fulfilled on chain, no eth tx of this node
+		require.NoError(t, orm.MarkRequestsFulfilledV2(jb.ID, []*big.Int{big.NewInt(1)}, nil))
+
+		pending, err := orm.PendingRequestsV2(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, req2.ID, pending[0].ID)
+
+		fulfilled := vrf.RequestStateFulfilled
+		reqs, count, err := orm.RequestsV2(vrf.RequestsV2Filter{State: &fulfilled}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Len(t, reqs, 1)
+		assert.Nil(t, reqs[0].Error)
+		require.NotNil(t, reqs[0].EthTxID)
+		assert.Equal(t, etx.ID, *reqs[0].EthTxID)
+	})
+
+	t.Run("resets a pending request received again", func(t *testing.T) {
+		again := vrf.NewRequestV2(jb.ID, chainID, newRandomWordsRequested(2, 6), 20)
+		require.NoError(t, orm.UpsertRequestV2(&again))
+		assert.Equal(t, req2.ID, again.ID)
+		assert.True(t, req2.CreatedAt.Equal(again.CreatedAt))
+		assert.Equal(t, vrf.RequestStateWaitingForConfirmations, again.State)
+		assert.Equal(t, uint64(20), again.ConfirmedAtBlock)
+		assert.Equal(t, int64(0), again.Attempts)
+		assert.Nil(t, again.LastTryAt)
+	})
+
+	t.Run("keeps a fulfilled request received again", func(t *testing.T) {
+		again := vrf.NewRequestV2(jb.ID, chainID, newRandomWordsRequested(1, 5), 20)
+		require.NoError(t, orm.UpsertRequestV2(&again))
+		assert.Equal(t, req1.ID, again.ID)
+		assert.Equal(t, vrf.RequestStateFulfilled, again.State)
+		assert.Equal(t, uint64(13), again.ConfirmedAtBlock)
+		assert.Equal(t, int64(1), again.Attempts)
+		assert.NotNil(t, again.EthTxID)
+
+		pending, err := orm.PendingRequestsV2(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, req2.ID, pending[0].ID)
+	})
+
+	t.Run("lists requests", func(t *testing.T) {
+		reqs, count, err := orm.RequestsV2(vrf.RequestsV2Filter{}, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, reqs, 1)
+		assert.Equal(t, req2.ID, reqs[0].ID)
+
+		subID := uint64(5)
+		reqs, count, err = orm.RequestsV2(vrf.RequestsV2Filter{JobID: &jb.ID, SubID: &subID}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, reqs, 1)
+		assert.Equal(t, req1.ID, reqs[0].ID)
+
+		otherJobID := jb.ID + 1
+		_, count, err = orm.RequestsV2(vrf.RequestsV2Filter{JobID: &otherJobID}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("deletes requests with their job", func(t *testing.T) {
+		require.NoError(t, jobORM.DeleteJob(jb.ID))
+		cltest.AssertCount(t, db, "vrf_v2_requests", 0)
+	})
+}
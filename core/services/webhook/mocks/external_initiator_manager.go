@@ -4,7 +4,11 @@ package mocks
 
 import (
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
+	job "github.com/smartcontractkit/chainlink/core/services/job"
+
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 // ExternalInitiatorManager is an autogenerated mock type for the ExternalInitiatorManager type
@@ -47,6 +51,38 @@ func (_m *ExternalInitiatorManager) FindExternalInitiatorByName(name string) (br
 	return r0, r1
 }
 
+// Load provides a mock function with given fields: webhookSpecID
+func (_m *ExternalInitiatorManager) Load(webhookSpecID int32) ([]job.ExternalInitiatorWebhookSpec, uuid.UUID, error) {
+	ret := _m.Called(webhookSpecID)
+
+	var r0 []job.ExternalInitiatorWebhookSpec
+	if rf, ok := ret.Get(0).(func(int32) []job.ExternalInitiatorWebhookSpec); ok {
+		r0 = rf(webhookSpecID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.ExternalInitiatorWebhookSpec)
+		}
+	}
+
+	var r1 uuid.UUID
+	if rf, ok := ret.Get(1).(func(int32) uuid.UUID); ok {
+		r1 = rf(webhookSpecID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(uuid.UUID)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int32) error); ok {
+		r2 = rf(webhookSpecID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Notify provides a mock function with given fields: webhookSpecID
 func (_m *ExternalInitiatorManager) Notify(webhookSpecID int32) error {
 	ret := _m.Called(webhookSpecID)
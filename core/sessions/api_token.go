@@ -0,0 +1,194 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ScopeLevel is the access a scoped API token has to a resource
+type ScopeLevel string
+
+const (
+	// ScopeNone denies all requests
+	ScopeNone ScopeLevel = "none"
+	// ScopeRead allows GET requests
+	ScopeRead ScopeLevel = "read"
+	// ScopeWrite allows all requests
+	ScopeWrite ScopeLevel = "write"
+)
+
+var scopeLevelRanks = map[ScopeLevel]int{
+	ScopeNone:  0,
+	ScopeRead:  1,
+	ScopeWrite: 2,
+}
+
+// ScopeAllResources is the resource of a scope applying to every resource
+// which is not scoped explicitly, e.g. "*:read".
+const ScopeAllResources = "*"
+
+// ScopeReadOnly is shorthand for "*:read"
+const ScopeReadOnly = "read-only"
+
+// ScopeResources are the resources the API routes are grouped into for
+// scoping API tokens.
+var ScopeResources = []string{
+	"bridges", // bridge types and external initiators
+	"bundle",  // bundle exports and imports, which also take keys:write if they include keys
+	"chains",  // chains, nodes, forwarders and log replays
+	"config",  // node configuration and log levels
+	"feeds",   // feeds managers
+	"jobs",    // jobs, pipeline runs and events
+	"keeper",  // keeper upkeeps, their stats and simulations
+	"keys",    // keys and transfers
+	"node",    // health, build info, features, diagnostics and debug endpoints
+	"txs",     // transactions and transaction attempts
+	"users",   // users, API tokens and the audit log
+	"vrf",     // VRF requests and subscriptions
+}
+
+// TokenScopes limit the access of an API token per resource. Each scope is
+// "<resource>:<level>", where the resource is one of ScopeResources or
+// ScopeAllResources. An explicitly scoped resource gets that level, other
+// resources the level of the "*" scope, or none without one. Tokens without
+// scopes have write access to everything.
+//
+// Scopes never grant more than the role of the token's user allows.
+type TokenScopes []string
+
+// ParseTokenScopes validates and normalizes scopes, expanding "read-only" to
+// "*:read".
+func ParseTokenScopes(scopes []string) (TokenScopes, error) {
+	parsed := TokenScopes{}
+	seen := map[string]bool{}
+	for _, s := range scopes {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s == ScopeReadOnly {
+			s = ScopeAllResources + ":" + string(ScopeRead)
+		}
+		parts := strings.Split(s, ":")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid scope %q, expected <resource>:<level> or %s", s, ScopeReadOnly)
+		}
+		resource, level := parts[0], ScopeLevel(parts[1])
+		if resource != ScopeAllResources && !isScopeResource(resource) {
+			return nil, errors.Errorf("invalid scope %q, resource must be %s or one of: %s", s, ScopeAllResources, strings.Join(ScopeResources, ", "))
+		}
+		if _, ok := scopeLevelRanks[level]; !ok {
+			return nil, errors.Errorf("invalid scope %q, level must be one of: %s, %s, %s", s, ScopeNone, ScopeRead, ScopeWrite)
+		}
+		if seen[resource] {
+			return nil, errors.Errorf("resource %s is scoped more than once", resource)
+		}
+		seen[resource] = true
+		parsed = append(parsed, s)
+	}
+	return parsed, nil
+}
+
+func isScopeResource(resource string) bool {
+	for _, r := range ScopeResources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// Level returns the access the scopes give to resource.
+func (s TokenScopes) Level(resource string) ScopeLevel {
+	if len(s) == 0 {
+		return ScopeWrite
+	}
+	level := ScopeNone
+	for _, scope := range s {
+		parts := strings.SplitN(scope, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case resource:
+			return ScopeLevel(parts[1])
+		case ScopeAllResources:
+			level = ScopeLevel(parts[1])
+		}
+	}
+	return level
+}
+
+// Allows returns true if the scopes give at least the required access to
+// resource.
+func (s TokenScopes) Allows(resource string, required ScopeLevel) bool {
+	return scopeLevelRanks[s.Level(resource)] >= scopeLevelRanks[required]
+}
+
+// APIToken is a named API token of a user, limited by scopes and an
+// optional expiry. Unlike the user's own token, a user can have any number
+// of them.
+type APIToken struct {
+	ID           int64
+	UserEmail    string
+	Name         string
+	AccessKey    string
+	Salt         string
+	HashedSecret string
+	Scopes       pq.StringArray
+	ExpiresAt    null.Time
+	LastUsedAt   null.Time
+	CreatedAt    time.Time
+}
+
+// TokenScopes returns the scopes of the token
+func (t APIToken) TokenScopes() TokenScopes {
+	return TokenScopes(t.Scopes)
+}
+
+// Expired returns true if the token has an expiry which has passed
+func (t APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt.Valid && !now.Before(t.ExpiresAt.Time)
+}
+
+// newAPIToken generates the secret of a new token
+func newAPIToken(email, name string, scopes TokenScopes, expiresAt null.Time) (APIToken, *auth.Token, error) {
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return APIToken{}, nil, errors.Wrap(err, "api token")
+	}
+	return APIToken{
+		UserEmail:    email,
+		Name:         name,
+		AccessKey:    token.AccessKey,
+		Salt:         salt,
+		HashedSecret: hashedSecret,
+		Scopes:       pq.StringArray(scopes),
+		ExpiresAt:    expiresAt,
+	}, token, nil
+}
+
+// AuthenticateAPIToken returns true if token is the secret of apiToken.
+func AuthenticateAPIToken(token *auth.Token, apiToken APIToken) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, apiToken.Salt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(apiToken.HashedSecret)) == 1, nil
+}
+
+// CreateAPITokenRequest is sent to create a named API token.
+type CreateAPITokenRequest struct {
+	Password string   `json:"password"`
+	Name     string   `json:"name"`
+	Scopes   []string `json:"scopes"`
+	// ExpiresIn is a duration such as "720h". Empty tokens never expire.
+	ExpiresIn string `json:"expiresIn"`
+}
@@ -0,0 +1,88 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+func TestParseTokenScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		want    sessions.TokenScopes
+		wantErr bool
+	}{
+		{"none", nil, sessions.TokenScopes{}, false},
+		{"read-only", []string{"read-only"}, sessions.TokenScopes{"*:read"}, false},
+		{"normalized", []string{" Jobs:Write ", "keys:none"}, sessions.TokenScopes{"jobs:write", "keys:none"}, false},
+		{"combined", []string{"read-only", "jobs:write", "keys:none"}, sessions.TokenScopes{"*:read", "jobs:write", "keys:none"}, false},
+		{"unknown resource", []string{"widgets:read"}, nil, true},
+		{"unknown level", []string{"jobs:admin"}, nil, true},
+		{"missing level", []string{"jobs"}, nil, true},
+		{"duplicate resource", []string{"jobs:read", "jobs:write"}, nil, true},
+		{"duplicate read-only", []string{"read-only", "*:write"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scopes, err := sessions.ParseTokenScopes(tt.scopes)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, scopes)
+		})
+	}
+}
+
+func TestTokenScopes_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		scopes   sessions.TokenScopes
+		resource string
+		level    sessions.ScopeLevel
+		want     bool
+	}{
+		{"unscoped write", sessions.TokenScopes{}, "keys", sessions.ScopeWrite, true},
+		{"read-only read", sessions.TokenScopes{"*:read"}, "jobs", sessions.ScopeRead, true},
+		{"read-only write", sessions.TokenScopes{"*:read"}, "jobs", sessions.ScopeWrite, false},
+		{"explicit write", sessions.TokenScopes{"*:read", "jobs:write"}, "jobs", sessions.ScopeWrite, true},
+		{"explicit none", sessions.TokenScopes{"*:read", "keys:none"}, "keys", sessions.ScopeRead, false},
+		{"unlisted resource", sessions.TokenScopes{"jobs:write"}, "bridges", sessions.ScopeRead, false},
+		{"unknown route", sessions.TokenScopes{"jobs:write"}, sessions.ScopeAllResources, sessions.ScopeRead, false},
+		{"unknown route with default", sessions.TokenScopes{"*:read"}, sessions.ScopeAllResources, sessions.ScopeRead, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.scopes.Allows(tt.resource, tt.level))
+		})
+	}
+}
+
+func TestAPIToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	assert.False(t, sessions.APIToken{}.Expired(now))
+	assert.False(t, sessions.APIToken{ExpiresAt: null.TimeFrom(now.Add(time.Minute))}.Expired(now))
+	assert.True(t, sessions.APIToken{ExpiresAt: null.TimeFrom(now)}.Expired(now))
+	assert.True(t, sessions.APIToken{ExpiresAt: null.TimeFrom(now.Add(-time.Minute))}.Expired(now))
+}
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	null "gopkg.in/guregu/null.v4"
+
 	sessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
@@ -51,6 +53,36 @@ func (_m *ORM) ClearNonCurrentSessions(sessionID string) error {
 	return r0
 }
 
+// CreateAPIToken provides a mock function with given fields: email, name, scopes, expiresAt
+func (_m *ORM) CreateAPIToken(email string, name string, scopes sessions.TokenScopes, expiresAt null.Time) (sessions.APIToken, *auth.Token, error) {
+	ret := _m.Called(email, name, scopes, expiresAt)
+
+	var r0 sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string, string, sessions.TokenScopes, null.Time) sessions.APIToken); ok {
+		r0 = rf(email, name, scopes, expiresAt)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	var r1 *auth.Token
+	if rf, ok := ret.Get(1).(func(string, string, sessions.TokenScopes, null.Time) *auth.Token); ok {
+		r1 = rf(email, name, scopes, expiresAt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*auth.Token)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, sessions.TokenScopes, null.Time) error); ok {
+		r2 = rf(email, name, scopes, expiresAt)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: user
 func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(user)
@@ -123,13 +155,13 @@ func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	return r0
 }
 
-// DeleteUser provides a mock function with given fields:
-func (_m *ORM) DeleteUser() error {
-	ret := _m.Called()
+// DeleteUser provides a mock function with given fields: email
+func (_m *ORM) DeleteUser(email string) error {
+	ret := _m.Called(email)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -151,6 +183,27 @@ func (_m *ORM) DeleteUserSession(sessionID string) error {
 	return r0
 }
 
+// FindAPIToken provides a mock function with given fields: accessKey
+func (_m *ORM) FindAPIToken(accessKey string) (sessions.APIToken, error) {
+	ret := _m.Called(accessKey)
+
+	var r0 sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string) sessions.APIToken); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindExternalInitiator provides a mock function with given fields: eia
 func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	ret := _m.Called(eia)
@@ -174,20 +227,41 @@ func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiato
 	return r0, r1
 }
 
-// FindUser provides a mock function with given fields:
-func (_m *ORM) FindUser() (sessions.User, error) {
-	ret := _m.Called()
+// FindUser provides a mock function with given fields: email
+func (_m *ORM) FindUser(email string) (sessions.User, error) {
+	ret := _m.Called(email)
 
 	var r0 sessions.User
-	if rf, ok := ret.Get(0).(func() sessions.User); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(email)
 	} else {
 		r0 = ret.Get(0).(sessions.User)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUserByAPIToken provides a mock function with given fields: apiToken
+func (_m *ORM) FindUserByAPIToken(apiToken string) (sessions.User, error) {
+	ret := _m.Called(apiToken)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(apiToken)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(apiToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,6 +292,80 @@ func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	return r0, r1
 }
 
+// ListAPITokens provides a mock function with given fields: email
+func (_m *ORM) ListAPITokens(email string) ([]sessions.APIToken, error) {
+	ret := _m.Called(email)
+
+	var r0 []sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string) []sessions.APIToken); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields:
+func (_m *ORM) ListUsers() ([]sessions.User, error) {
+	ret := _m.Called()
+
+	var r0 []sessions.User
+	if rf, ok := ret.Get(0).(func() []sessions.User); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAPITokenUsed provides a mock function with given fields: id
+func (_m *ORM) MarkAPITokenUsed(id int64) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeAPIToken provides a mock function with given fields: email, name
+func (_m *ORM) RevokeAPIToken(email string, name string) error {
+	ret := _m.Called(email, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(email, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
@@ -282,3 +430,24 @@ func (_m *ORM) SetPassword(user *sessions.User, newPassword string) error {
 
 	return r0
 }
+
+// UpdateRole provides a mock function with given fields: email, role
+func (_m *ORM) UpdateRole(email string, role sessions.UserRole) (sessions.User, error) {
+	ret := _m.Called(email, role)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string, sessions.UserRole) sessions.User); ok {
+		r0 = rf(email, role)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, sessions.UserRole) error); ok {
+		r1 = rf(email, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
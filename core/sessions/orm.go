@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -20,16 +21,24 @@ import (
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
-	FindUser() (User, error)
+	FindUser(email string) (User, error)
+	FindUserByAPIToken(apiToken string) (User, error)
+	ListUsers() ([]User, error)
 	AuthorizedUserWithSession(sessionID string) (User, error)
-	DeleteUser() error
+	DeleteUser(email string) error
 	DeleteUserSession(sessionID string) error
 	CreateSession(sr SessionRequest) (string, error)
 	ClearNonCurrentSessions(sessionID string) error
 	CreateUser(user *User) error
+	UpdateRole(email string, role UserRole) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	CreateAndSetAuthToken(user *User) (*auth.Token, error)
 	DeleteAuthToken(user *User) error
+	CreateAPIToken(email, name string, scopes TokenScopes, expiresAt null.Time) (APIToken, *auth.Token, error)
+	ListAPITokens(email string) ([]APIToken, error)
+	FindAPIToken(accessKey string) (APIToken, error)
+	MarkAPITokenUsed(id int64) error
+	RevokeAPIToken(email, name string) error
 	SetPassword(user *User, newPassword string) error
 	Sessions(offset, limit int) ([]Session, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
@@ -50,53 +59,74 @@ func NewORM(db *sqlx.DB, sessionDuration time.Duration, lggr logger.Logger) ORM
 	return &orm{db, sessionDuration, lggr.Named("SessionsORM")}
 }
 
-// FindUser will return the one API user, or an error.
-func (o *orm) FindUser() (User, error) {
-	return o.findUser()
+// FindUser will return the API user with the given email, or an error.
+func (o *orm) FindUser(email string) (user User, err error) {
+	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	err = o.db.Get(&user, sql, email)
+	return
+}
+
+// FindUserByAPIToken will return the API user owning the API token with the
+// given access key, or an error.
+func (o *orm) FindUserByAPIToken(apiToken string) (user User, err error) {
+	if len(apiToken) == 0 {
+		return User{}, sql.ErrNoRows
+	}
+	sql := "SELECT * FROM users WHERE token_key = $1"
+	err = o.db.Get(&user, sql, apiToken)
+	return
 }
 
-func (o *orm) findUser() (user User, err error) {
-	sql := "SELECT * FROM users ORDER BY created_at desc LIMIT 1"
-	err = o.db.Get(&user, sql)
+// ListUsers returns all API users, oldest first.
+func (o *orm) ListUsers() (users []User, err error) {
+	sql := "SELECT * FROM users ORDER BY created_at, email"
+	err = o.db.Select(&users, sql)
 	return
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
-// and hasn't expired, and update session's LastUsed field.
+// AuthorizedUserWithSession will return the API user of the session if the
+// Session ID exists and hasn't expired, and update session's LastUsed field.
 func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 	if len(sessionID) == 0 {
 		return User{}, errors.New("Session ID cannot be empty")
 	}
 
-	result, err := o.db.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
+	var email string
+	err := o.db.Get(&email, "UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now() RETURNING email", sessionID, o.sessionDuration)
 	if err != nil {
 		return User{}, err
 	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return User{}, err
-	}
-	if rowsAffected == 0 {
-		return User{}, sql.ErrNoRows
-	}
-	return o.FindUser()
+	return o.FindUser(email)
 }
 
-// DeleteUser will delete the API User in the db.
-func (o *orm) DeleteUser() error {
+// DeleteUser will delete the API user with the given email, along with their
+// sessions and WebAuthn tokens.
+func (o *orm) DeleteUser(email string) error {
 	ctx, cancel := pg.DefaultQueryCtx()
 	defer cancel()
 	return pg.SqlxTransaction(ctx, o.db, o.lggr, func(tx pg.Queryer) error {
-		if _, err := tx.Exec("DELETE FROM users"); err != nil {
+		if _, err := tx.Exec("DELETE FROM sessions WHERE lower(email) = lower($1)", email); err != nil {
 			return err
 		}
-
-		_, err := tx.Exec("DELETE FROM sessions")
-		return err
+		if _, err := tx.Exec("DELETE FROM web_authns WHERE lower(email) = lower($1)", email); err != nil {
+			return err
+		}
+		result, err := tx.Exec("DELETE FROM users WHERE lower(email) = lower($1)", email)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
 	})
 }
 
-// DeleteUserSession will erase the session ID for the sole API User.
+// DeleteUserSession will erase the session ID.
 func (o *orm) DeleteUserSession(sessionID string) error {
 	_, err := o.db.Exec("DELETE FROM sessions WHERE id = $1", sessionID)
 	return err
@@ -121,8 +151,10 @@ func (o *orm) GetUserWebAuthn(email string) ([]WebAuthn, error) {
 // the hashed API User password in the db. Also will check WebAuthn if it's
 // enabled for that user.
 func (o *orm) CreateSession(sr SessionRequest) (string, error) {
-	user, err := o.FindUser()
-	if err != nil {
+	user, err := o.FindUser(sr.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errors.New("Invalid email")
+	} else if err != nil {
 		return "", err
 	}
 	lggr := o.lggr.With("user", user.Email)
@@ -130,7 +162,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 
 	// Do email and password check first to prevent extra database look up
 	// for MFA tokens leaking if an account has MFA tokens or not.
-	if !constantTimeEmailCompare(sr.Email, user.Email) {
+	if !constantTimeEmailCompare(strings.ToLower(sr.Email), strings.ToLower(user.Email)) {
 		return "", errors.New("Invalid email")
 	}
 
@@ -150,7 +182,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	if len(uwas) == 0 {
 		lggr.Infof("No MFA for user. Creating Session")
 		session := NewSession()
-		_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+		_, err = o.db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
 		return session.ID, err
 	}
 
@@ -188,7 +220,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	lggr.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
 	session := NewSession()
-	_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	_, err = o.db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
 	return session.ID, err
 }
 
@@ -203,16 +235,27 @@ func constantTimeEmailCompare(left, right string) bool {
 	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
 }
 
-// ClearNonCurrentSessions removes all sessions but the id passed in.
+// ClearNonCurrentSessions removes all sessions of the session's user but the
+// id passed in.
 func (o *orm) ClearNonCurrentSessions(sessionID string) error {
-	_, err := o.db.Exec("DELETE FROM sessions where id != $1", sessionID)
+	_, err := o.db.Exec("DELETE FROM sessions WHERE id != $1 AND email = (SELECT email FROM sessions WHERE id = $1)", sessionID)
 	return err
 }
 
 // Creates creates the user.
 func (o *orm) CreateUser(user *User) error {
-	sql := "INSERT INTO users (email, hashed_password, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
-	return o.db.Get(user, sql, user.Email, user.HashedPassword)
+	sql := "INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *"
+	return o.db.Get(user, sql, user.Email, user.HashedPassword, user.Role)
+}
+
+// UpdateRole changes the role of the user with the given email.
+func (o *orm) UpdateRole(email string, role UserRole) (user User, err error) {
+	if _, err = GetUserRole(string(role)); err != nil {
+		return
+	}
+	sql := "UPDATE users SET role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
+	err = o.db.Get(&user, sql, role, email)
+	return
 }
 
 // SetAuthToken updates the user to use the given Authentication Token.
@@ -253,6 +296,82 @@ func (o *orm) DeleteAuthToken(user *User) error {
 	return o.db.Get(user, sql, user.Email)
 }
 
+// ErrAPITokenNameTaken is returned when a user already has an API token with
+// the requested name.
+var ErrAPITokenNameTaken = errors.New("an API token with this name already exists")
+
+// apiTokenLastUsedResolution limits how often the last use of an API token is
+// written, as it is used by every request authenticated with the token.
+const apiTokenLastUsedResolution = time.Minute
+
+// CreateAPIToken creates a named API token for the user, and returns it with
+// its secret. The secret can't be retrieved again.
+func (o *orm) CreateAPIToken(email, name string, scopes TokenScopes, expiresAt null.Time) (APIToken, *auth.Token, error) {
+	apiToken, token, err := newAPIToken(email, name, scopes, expiresAt)
+	if err != nil {
+		return APIToken{}, nil, err
+	}
+	stmt := `INSERT INTO api_tokens (user_email, name, access_key, salt, hashed_secret, scopes, expires_at, created_at)
+SELECT email, $2, $3, $4, $5, $6, $7, now() FROM users WHERE lower(email) = lower($1)
+ON CONFLICT (user_email, name) DO NOTHING
+RETURNING *`
+	err = o.db.Get(&apiToken, stmt, email, name, apiToken.AccessKey, apiToken.Salt, apiToken.HashedSecret, apiToken.Scopes, apiToken.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, ferr := o.FindUser(email); ferr != nil {
+			return APIToken{}, nil, errors.Wrap(ferr, "CreateAPIToken failed to find user")
+		}
+		return APIToken{}, nil, ErrAPITokenNameTaken
+	}
+	if err != nil {
+		return APIToken{}, nil, errors.Wrap(err, "CreateAPIToken failed to insert token")
+	}
+	return apiToken, token, nil
+}
+
+// ListAPITokens returns the named API tokens of a user, including expired
+// ones.
+func (o *orm) ListAPITokens(email string) (tokens []APIToken, err error) {
+	sql := "SELECT * FROM api_tokens WHERE lower(user_email) = lower($1) ORDER BY created_at, id"
+	err = o.db.Select(&tokens, sql, email)
+	return
+}
+
+// FindAPIToken returns the named API token with the given access key.
+func (o *orm) FindAPIToken(accessKey string) (token APIToken, err error) {
+	if len(accessKey) == 0 {
+		return token, sql.ErrNoRows
+	}
+	sql := "SELECT * FROM api_tokens WHERE access_key = $1"
+	err = o.db.Get(&token, sql, accessKey)
+	return
+}
+
+// MarkAPITokenUsed records the use of an API token. Uses within a minute of
+// the recorded one are not written.
+func (o *orm) MarkAPITokenUsed(id int64) error {
+	sql := "UPDATE api_tokens SET last_used_at = now() WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - $2::interval)"
+	_, err := o.db.Exec(sql, id, apiTokenLastUsedResolution.String())
+	return err
+}
+
+// RevokeAPIToken deletes a named API token of the user. It returns
+// sql.ErrNoRows if the user has no token with this name.
+func (o *orm) RevokeAPIToken(email, name string) error {
+	stmt := "DELETE FROM api_tokens WHERE lower(user_email) = lower($1) AND name = $2"
+	result, err := o.db.Exec(stmt, email, name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(token *WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
@@ -1,11 +1,14 @@
 package sessions_test
 
 import (
+	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -28,19 +31,68 @@ func setupORM(t *testing.T) (*sqlx.DB, sessions.ORM) {
 func TestORM_FindUser(t *testing.T) {
 	t.Parallel()
 
-	db, orm := setupORM(t)
+	_, orm := setupORM(t)
 	user1 := cltest.MustNewUser(t, "test1@email1.net", "password1")
 	user2 := cltest.MustNewUser(t, "test2@email2.net", "password2")
 
 	require.NoError(t, orm.CreateUser(&user1))
 	require.NoError(t, orm.CreateUser(&user2))
-	_, err := db.Exec("UPDATE users SET created_at = now() - interval '1 day' WHERE email = $1", user2.Email)
-	require.NoError(t, err)
 
-	actual, err := orm.FindUser()
+	actual, err := orm.FindUser("Test1@Email1.net")
 	require.NoError(t, err)
 	assert.Equal(t, user1.Email, actual.Email)
 	assert.Equal(t, user1.HashedPassword, actual.HashedPassword)
+	assert.Equal(t, sessions.UserRoleAdmin, actual.Role)
+
+	_, err = orm.FindUser("test3@email3.net")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_FindUserByAPIToken(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	token, err := orm.CreateAndSetAuthToken(&user)
+	require.NoError(t, err)
+
+	actual, err := orm.FindUserByAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, actual.Email)
+
+	_, err = orm.FindUserByAPIToken("")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.DeleteAuthToken(&user))
+	_, err = orm.FindUserByAPIToken(token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_ListUsers_UpdateRole(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user, err := sessions.NewUser("viewer@chain.link", cltest.Password, sessions.UserRoleView)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateUser(&user))
+
+	users, err := orm.ListUsers()
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, cltest.APIEmail, users[0].Email)
+	assert.Equal(t, sessions.UserRoleAdmin, users[0].Role)
+	assert.Equal(t, user.Email, users[1].Email)
+	assert.Equal(t, sessions.UserRoleView, users[1].Role)
+
+	updated, err := orm.UpdateRole(user.Email, sessions.UserRoleEdit)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, updated.Role)
+
+	_, err = orm.UpdateRole(user.Email, "superuser")
+	require.Error(t, err)
+	_, err = orm.UpdateRole("nobody@chain.link", sessions.UserRoleEdit)
+	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
 func TestORM_AuthorizedUserWithSession(t *testing.T) {
@@ -69,7 +121,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 
 			prevSession := cltest.NewSession("correctID")
 			prevSession.LastUsed = time.Now().Add(-cltest.MustParseDuration(t, "2m"))
-			_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, $2, now())", prevSession.ID, prevSession.LastUsed)
+			_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, $3, now())", prevSession.ID, user.Email, prevSession.LastUsed)
 			require.NoError(t, err)
 
 			expectedTime := utils.ISO8601UTC(time.Now())
@@ -90,16 +142,27 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 
 func TestORM_DeleteUser(t *testing.T) {
 	t.Parallel()
-	_, orm := setupORM(t)
+	db, orm := setupORM(t)
 
-	_, err := orm.FindUser()
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", "userSession", user.Email)
 	require.NoError(t, err)
 
-	err = orm.DeleteUser()
+	err = orm.DeleteUser(user.Email)
 	require.NoError(t, err)
 
-	_, err = orm.FindUser()
+	_, err = orm.FindUser(user.Email)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = orm.AuthorizedUserWithSession("userSession")
 	require.Error(t, err)
+
+	// The other users are kept
+	_, err = orm.FindUser(cltest.APIEmail)
+	require.NoError(t, err)
+
+	err = orm.DeleteUser(user.Email)
+	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
 func TestORM_DeleteUserSession(t *testing.T) {
@@ -108,13 +171,13 @@ func TestORM_DeleteUserSession(t *testing.T) {
 	db, orm := setupORM(t)
 
 	session := sessions.NewSession()
-	_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, cltest.APIEmail)
 	require.NoError(t, err)
 
 	err = orm.DeleteUserSession(session.ID)
 	require.NoError(t, err)
 
-	_, err = orm.FindUser()
+	_, err = orm.FindUser(cltest.APIEmail)
 	require.NoError(t, err)
 
 	sessions, err := orm.Sessions(0, 10)
@@ -172,7 +235,7 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	token, err := orm.CreateAndSetAuthToken(&initial)
 	require.NoError(t, err)
 
-	dbUser, err := orm.FindUser()
+	dbUser, err := orm.FindUser(initial.Email)
 	require.NoError(t, err)
 
 	hashedSecret, err := auth.HashedSecret(token, dbUser.TokenSalt.String)
@@ -184,3 +247,67 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Equal(t, dbUser.TokenKey.String, token.AccessKey)
 	assert.Equal(t, dbUser.TokenHashedSecret.String, hashedSecret)
 }
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+
+	scopes := sessions.TokenScopes{"*:read", "jobs:write"}
+	expiresAt := null.TimeFrom(time.Now().Add(time.Hour).Truncate(time.Second))
+	apiToken, token, err := orm.CreateAPIToken(strings.ToUpper(user.Email), "ci", scopes, expiresAt)
+	require.NoError(t, err)
+	assert.NotZero(t, apiToken.ID)
+	assert.Equal(t, user.Email, apiToken.UserEmail)
+	assert.Equal(t, token.AccessKey, apiToken.AccessKey)
+	assert.Equal(t, scopes, apiToken.TokenScopes())
+	assert.True(t, expiresAt.Time.Equal(apiToken.ExpiresAt.Time))
+	assert.False(t, apiToken.LastUsedAt.Valid)
+
+	ok, err := sessions.AuthenticateAPIToken(token, apiToken)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = sessions.AuthenticateAPIToken(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"}, apiToken)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = orm.CreateAPIToken(user.Email, "ci", nil, null.Time{})
+	require.ErrorIs(t, err, sessions.ErrAPITokenNameTaken)
+	_, _, err = orm.CreateAPIToken("nobody@chain.link", "ci", nil, null.Time{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	dashboard, _, err := orm.CreateAPIToken(user.Email, "dashboard", sessions.TokenScopes{}, null.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, dashboard.TokenScopes())
+	assert.False(t, dashboard.ExpiresAt.Valid)
+
+	tokens, err := orm.ListAPITokens(user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.Equal(t, "dashboard", tokens[1].Name)
+
+	found, err := orm.FindAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, apiToken.ID, found.ID)
+	_, err = orm.FindAPIToken("")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.MarkAPITokenUsed(apiToken.ID))
+	found, err = orm.FindAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	require.True(t, found.LastUsedAt.Valid)
+
+	require.NoError(t, orm.RevokeAPIToken(user.Email, "ci"))
+	require.ErrorIs(t, orm.RevokeAPIToken(user.Email, "ci"), sql.ErrNoRows)
+	_, err = orm.FindAPIToken(token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Tokens are deleted with their user
+	require.NoError(t, orm.DeleteUser(user.Email))
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT count(*) FROM api_tokens"))
+	assert.Zero(t, count)
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -22,9 +23,48 @@ type User struct {
 	TokenKey          null.String
 	TokenSalt         null.String
 	TokenHashedSecret null.String
+	Role              UserRole
 	UpdatedAt         time.Time
 }
 
+// UserRole is the role of an API user, which determines the actions the user
+// and their API token are allowed to take.
+type UserRole string
+
+const (
+	// UserRoleView can read everything but change nothing.
+	UserRoleView UserRole = "view"
+	// UserRoleEdit can also create, delete and run jobs and manage bridges and
+	// external initiators.
+	UserRoleEdit UserRole = "edit"
+	// UserRoleAdmin can also manage keys, transfers, chains, nodes, config and
+	// users.
+	UserRoleAdmin UserRole = "admin"
+)
+
+var userRoleRanks = map[UserRole]int{
+	UserRoleView:  1,
+	UserRoleEdit:  2,
+	UserRoleAdmin: 3,
+}
+
+// GetUserRole parses a user role from its name.
+func GetUserRole(role string) (UserRole, error) {
+	r := UserRole(strings.ToLower(strings.TrimSpace(role)))
+	if _, ok := userRoleRanks[r]; !ok {
+		return "", errors.Errorf("invalid user role %q, must be one of: admin, edit, view", role)
+	}
+	return r, nil
+}
+
+// Includes returns true if a user with role r may take the actions allowed to
+// required. Each role includes the ones below it: admin includes edit, which
+// includes view.
+func (r UserRole) Includes(required UserRole) bool {
+	rank, ok := userRoleRanks[r]
+	return ok && rank >= userRoleRanks[required]
+}
+
 // https://davidcel.is/posts/stop-validating-email-addresses-with-regex/
 var emailRegexp = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 
@@ -33,8 +73,9 @@ const (
 	MaxBcryptPasswordLength = 50
 )
 
-// NewUser creates a new user by hashing the passed plainPwd with bcrypt.
-func NewUser(email, plainPwd string) (User, error) {
+// NewUser creates a new user with the given role by hashing the passed
+// plainPwd with bcrypt.
+func NewUser(email, plainPwd string, role UserRole) (User, error) {
 	if len(email) == 0 {
 		return User{}, errors.New("Must enter an email")
 	}
@@ -47,6 +88,10 @@ func NewUser(email, plainPwd string) (User, error) {
 		return User{}, fmt.Errorf("must enter a password with 8 - %v characters", MaxBcryptPasswordLength)
 	}
 
+	if _, ok := userRoleRanks[role]; !ok {
+		return User{}, errors.Errorf("invalid user role %q", role)
+	}
+
 	pwd, err := utils.HashPassword(plainPwd)
 	if err != nil {
 		return User{}, err
@@ -55,6 +100,7 @@ func NewUser(email, plainPwd string) (User, error) {
 	return User{
 		Email:          email,
 		HashedPassword: pwd,
+		Role:           role,
 	}, nil
 }
 
@@ -72,6 +118,7 @@ type SessionRequest struct {
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id"`
+	Email     string    `json:"email"`
 	LastUsed  time.Time `json:"lastUsed"`
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -30,12 +30,13 @@ func TestNewUser(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.email, func(t *testing.T) {
-			user, err := sessions.NewUser(test.email, test.pwd)
+			user, err := sessions.NewUser(test.email, test.pwd, sessions.UserRoleEdit)
 			if test.wantError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, test.email, user.Email)
+				assert.Equal(t, sessions.UserRoleEdit, user.Role)
 				assert.NotEmpty(t, user.HashedPassword)
 				newHash, _ := utils.HashPassword(test.pwd)
 				assert.NotEqual(t, newHash, user.HashedPassword, "Salt should prevent equality")
@@ -44,6 +45,54 @@ func TestNewUser(t *testing.T) {
 	}
 }
 
+func TestNewUser_InvalidRole(t *testing.T) {
+	t.Parallel()
+
+	_, err := sessions.NewUser("good@email.com", "goodpassword", "superuser")
+	assert.Error(t, err)
+}
+
+func TestGetUserRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		want      sessions.UserRole
+		wantError bool
+	}{
+		{"admin", sessions.UserRoleAdmin, false},
+		{" Edit ", sessions.UserRoleEdit, false},
+		{"VIEW", sessions.UserRoleView, false},
+		{"run", "", true},
+		{"", "", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.input, func(t *testing.T) {
+			role, err := sessions.GetUserRole(test.input)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.want, role)
+			}
+		})
+	}
+}
+
+func TestUserRole_Includes(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, sessions.UserRoleAdmin.Includes(sessions.UserRoleAdmin))
+	assert.True(t, sessions.UserRoleAdmin.Includes(sessions.UserRoleEdit))
+	assert.True(t, sessions.UserRoleAdmin.Includes(sessions.UserRoleView))
+	assert.False(t, sessions.UserRoleEdit.Includes(sessions.UserRoleAdmin))
+	assert.True(t, sessions.UserRoleEdit.Includes(sessions.UserRoleView))
+	assert.False(t, sessions.UserRoleView.Includes(sessions.UserRoleEdit))
+	assert.False(t, sessions.UserRole("").Includes(sessions.UserRoleView))
+}
+
 func TestUserGenerateAuthToken(t *testing.T) {
 	var user sessions.User
 	token, err := user.GenerateAuthToken()
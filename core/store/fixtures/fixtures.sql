@@ -1,11 +1,28 @@
-INSERT INTO users (email, hashed_password, token_hashed_secret, created_at, updated_at) VALUES (
+INSERT INTO users (email, hashed_password, token_hashed_secret, role, created_at, updated_at) VALUES (
     'apiuser@chainlink.test',
     '$2a$10$Ee8YjCtcBgflgR7NWmii.u5kwOuWNF1bniacRf/sqobB5YaQv.Lm.', -- hash of literal string 'p4SsW0rD1!@#_'
     '1eCP/w0llVkchejFaoBpfIGaLRxZK54lTXBCT22YLW+pdzE4Fafy/XO5LoJ2uwHi',
+    'admin',
     '2019-01-01',
     '2019-01-01'
 );
 
+INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES (
+    'apiuser-edit@chainlink.test',
+    '$2a$10$Ee8YjCtcBgflgR7NWmii.u5kwOuWNF1bniacRf/sqobB5YaQv.Lm.', -- hash of literal string 'p4SsW0rD1!@#_'
+    'edit',
+    '2019-01-02',
+    '2019-01-02'
+);
+
+INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES (
+    'apiuser-view@chainlink.test',
+    '$2a$10$Ee8YjCtcBgflgR7NWmii.u5kwOuWNF1bniacRf/sqobB5YaQv.Lm.', -- hash of literal string 'p4SsW0rD1!@#_'
+    'view',
+    '2019-01-02',
+    '2019-01-02'
+);
+
 INSERT INTO evm_chains (id, created_at, updated_at) VALUES (0, NOW(), NOW());
 
 INSERT INTO evm_nodes (name, evm_chain_id, ws_url, http_url, send_only, created_at, updated_at) VALUES (
@@ -123,6 +123,20 @@ func Current(db *sql.DB, lggr logger.Logger) (int64, error) {
 	return goose.EnsureDBVersion(db)
 }
 
+// Latest returns the version of the last migration, which Migrate brings the
+// database up to.
+func Latest() (int64, error) {
+	ms, err := goose.CollectMigrations(MIGRATIONS_DIR, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, err
+	}
+	last, err := ms.Last()
+	if err != nil {
+		return 0, err
+	}
+	return last.Version, nil
+}
+
 func Status(db *sql.DB, lggr logger.Logger) error {
 	ensureMigrated(db, lggr)
 	return goose.Status(db, MIGRATIONS_DIR)
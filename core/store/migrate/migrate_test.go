@@ -1,6 +1,9 @@
 package migrate_test
 
 import (
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,11 +19,31 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 var migrationDir = "migrations"
 
+func TestLatest(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join(migrationDir, "0*"))
+	require.NoError(t, err)
+	var want int64
+	for _, f := range files {
+		v, err := strconv.ParseInt(strings.SplitN(filepath.Base(f), "_", 2)[0], 10, 64)
+		require.NoError(t, err)
+		if v > want {
+			want = v
+		}
+	}
+
+	latest, err := migrate.Latest()
+	require.NoError(t, err)
+	require.Equal(t, want, latest)
+}
+
 type OffchainReporting2OracleSpec100 struct {
 	ID                                int32              `toml:"-"`
 	ContractID                        string             `toml:"contractID"`
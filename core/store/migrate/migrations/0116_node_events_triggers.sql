@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_job_spec_error() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('node_events', json_build_array(json_strip_nulls(json_build_object(
+        'type', 'job_error',
+        'time', NEW.updated_at,
+        'jobID', NEW.job_id,
+        'error', left(NEW.description, 1000),
+        'occurrences', NEW.occurrences
+    )))::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_job_spec_error AFTER INSERT OR UPDATE ON job_spec_errors FOR EACH ROW EXECUTE PROCEDURE notify_job_spec_error();
+
+CREATE OR REPLACE FUNCTION notify_eth_tx_state() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.state = NEW.state THEN
+        RETURN NULL;
+    END IF;
+    PERFORM pg_notify('node_events', json_build_array(json_strip_nulls(json_build_object(
+        'type', 'eth_tx',
+        'time', now(),
+        'ethTxID', NEW.id,
+        'evmChainID', NEW.evm_chain_id::text,
+        'fromAddress', '0x' || encode(NEW.from_address, 'hex'),
+        'nonce', NEW.nonce,
+        'taskRunID', NEW.pipeline_task_run_id,
+        'state', NEW.state,
+        'error', left(NEW.error, 1000)
+    )))::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_eth_tx_state AFTER INSERT OR UPDATE OF state ON eth_txes FOR EACH ROW EXECUTE PROCEDURE notify_eth_tx_state();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS notify_eth_tx_state ON eth_txes;
+DROP FUNCTION IF EXISTS notify_eth_tx_state();
+DROP TRIGGER IF EXISTS notify_job_spec_error ON job_spec_errors;
+DROP FUNCTION IF EXISTS notify_job_spec_error();
+-- +goose StatementEnd
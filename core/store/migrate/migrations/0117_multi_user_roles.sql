@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TYPE user_roles AS ENUM ('admin', 'edit', 'view');
+
+-- The existing user keeps full access
+ALTER TABLE users ADD COLUMN role user_roles NOT NULL DEFAULT 'view';
+UPDATE users SET role = 'admin';
+
+CREATE UNIQUE INDEX idx_users_lower_email ON users (lower(email));
+CREATE UNIQUE INDEX idx_users_token_key ON users (token_key) WHERE token_key IS NOT NULL AND token_key != '';
+
+-- Sessions cannot be attributed to a user retroactively
+DELETE FROM sessions;
+ALTER TABLE sessions ADD COLUMN email text NOT NULL REFERENCES users (email) ON DELETE CASCADE;
+CREATE INDEX idx_sessions_email ON sessions (email);
+
+-- +goose Down
+ALTER TABLE sessions DROP COLUMN email;
+DROP INDEX idx_users_token_key;
+DROP INDEX idx_users_lower_email;
+ALTER TABLE users DROP COLUMN role;
+DROP TYPE user_roles;
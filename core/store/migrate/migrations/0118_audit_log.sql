@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text,
+    api_token_key text,
+    external_initiator text,
+    source_ip text NOT NULL,
+    action text NOT NULL,
+    resource text NOT NULL,
+    params jsonb NOT NULL DEFAULT '{}',
+    status_code integer NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+CREATE INDEX idx_audit_log_user_email ON audit_log (user_email) WHERE user_email IS NOT NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log FOR EACH ROW EXECUTE PROCEDURE audit_log_append_only();
+
+-- +goose Down
+DROP TRIGGER audit_log_append_only ON audit_log;
+DROP FUNCTION audit_log_append_only();
+DROP TABLE audit_log;
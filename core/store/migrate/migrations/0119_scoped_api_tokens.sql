@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL CHECK (name <> ''),
+    access_key text NOT NULL,
+    salt text NOT NULL,
+    hashed_secret text NOT NULL,
+    scopes text[] NOT NULL DEFAULT '{}',
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    created_at timestamptz NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_api_tokens_access_key ON api_tokens (access_key);
+CREATE UNIQUE INDEX idx_api_tokens_user_email_name ON api_tokens (user_email, name);
+
+-- +goose Down
+DROP TABLE api_tokens;
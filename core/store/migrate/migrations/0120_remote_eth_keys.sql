@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_key_states ADD COLUMN is_remote boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE eth_key_states DROP COLUMN is_remote;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE eth_key_policies (
+	address bytea PRIMARY KEY REFERENCES eth_key_states (address) ON DELETE CASCADE,
+	max_tx_value numeric(78,0),
+	max_daily_gas_spend numeric(78,0),
+	allowed_destinations bytea[] NOT NULL DEFAULT '{}',
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	CONSTRAINT chk_max_tx_value CHECK (max_tx_value >= 0),
+	CONSTRAINT chk_max_daily_gas_spend CHECK (max_daily_gas_spend >= 0)
+);
+
+-- +goose Down
+DROP TABLE eth_key_policies;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Upkeep IDs of KeeperRegistry 1.2 are hashes, which do not fit in a bigint
+ALTER TABLE upkeep_registrations ALTER COLUMN upkeep_id TYPE numeric(78,0);
+
+-- +goose Down
+ALTER TABLE upkeep_registrations ALTER COLUMN upkeep_id TYPE bigint;
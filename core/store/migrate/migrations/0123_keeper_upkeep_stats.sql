@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE upkeep_registrations
+	ADD COLUMN checks_count bigint NOT NULL DEFAULT 0,
+	ADD COLUMN eligible_count bigint NOT NULL DEFAULT 0,
+	ADD COLUMN performs_count bigint NOT NULL DEFAULT 0,
+	ADD COLUMN performs_succeeded_count bigint NOT NULL DEFAULT 0,
+	ADD COLUMN perform_gas_used bigint NOT NULL DEFAULT 0,
+	ADD COLUMN perform_gas_used_count bigint NOT NULL DEFAULT 0,
+	ADD COLUMN link_payment numeric(78,0) NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE upkeep_registrations
+	DROP COLUMN checks_count,
+	DROP COLUMN eligible_count,
+	DROP COLUMN performs_count,
+	DROP COLUMN performs_succeeded_count,
+	DROP COLUMN perform_gas_used,
+	DROP COLUMN perform_gas_used_count,
+	DROP COLUMN link_payment;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE vrf_v2_requests (
+	id BIGSERIAL PRIMARY KEY,
+	job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+	evm_chain_id numeric(78,0) NOT NULL,
+	request_id numeric(78,0) NOT NULL,
+	sub_id numeric(20,0) NOT NULL,
+	sender bytea NOT NULL CHECK (octet_length(sender) = 20),
+	callback_gas_limit bigint NOT NULL,
+	num_words bigint NOT NULL,
+	min_request_confirmations bigint NOT NULL,
+	request_tx_hash bytea NOT NULL CHECK (octet_length(request_tx_hash) = 32),
+	request_block_number bigint NOT NULL,
+	raw_log jsonb NOT NULL,
+	confirmed_at_block bigint NOT NULL,
+	state text NOT NULL,
+	error text,
+	attempts bigint NOT NULL DEFAULT 0,
+	last_try_at timestamptz,
+	eth_tx_id bigint REFERENCES eth_txes(id) ON DELETE SET NULL,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT chk_vrf_v2_requests_state CHECK (
+		state IN ('waiting_for_confirmations', 'insufficient_balance', 'simulation_reverted', 'fulfilled', 'expired')
+	)
+);
+
+CREATE UNIQUE INDEX idx_vrf_v2_requests_job_id_request_id ON vrf_v2_requests (job_id, request_id);
+CREATE INDEX idx_vrf_v2_requests_pending ON vrf_v2_requests (job_id) WHERE state NOT IN ('fulfilled', 'expired');
+CREATE INDEX idx_vrf_v2_requests_sub_id ON vrf_v2_requests (evm_chain_id, sub_id);
+CREATE INDEX idx_vrf_v2_requests_request_tx_hash ON vrf_v2_requests (request_tx_hash);
+
+-- +goose Down
+DROP TABLE vrf_v2_requests;
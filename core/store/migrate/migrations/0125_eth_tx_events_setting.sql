@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_eth_tx_state() RETURNS TRIGGER AS $$
+BEGIN
+    -- Only sessions opened with EVENTS_ETH_TX_ENABLED set this
+    IF current_setting('chainlink.eth_tx_events', true) IS DISTINCT FROM 'on' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP = 'UPDATE' AND OLD.state = NEW.state THEN
+        RETURN NULL;
+    END IF;
+    PERFORM pg_notify('node_events', json_build_array(json_strip_nulls(json_build_object(
+        'type', 'eth_tx',
+        'time', now(),
+        'ethTxID', NEW.id,
+        'evmChainID', NEW.evm_chain_id::text,
+        'fromAddress', '0x' || encode(NEW.from_address, 'hex'),
+        'nonce', NEW.nonce,
+        'taskRunID', NEW.pipeline_task_run_id,
+        'state', NEW.state,
+        'error', left(NEW.error, 1000)
+    )))::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_eth_tx_state() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.state = NEW.state THEN
+        RETURN NULL;
+    END IF;
+    PERFORM pg_notify('node_events', json_build_array(json_strip_nulls(json_build_object(
+        'type', 'eth_tx',
+        'time', now(),
+        'ethTxID', NEW.id,
+        'evmChainID', NEW.evm_chain_id::text,
+        'fromAddress', '0x' || encode(NEW.from_address, 'hex'),
+        'nonce', NEW.nonce,
+        'taskRunID', NEW.pipeline_task_run_id,
+        'state', NEW.state,
+        'error', left(NEW.error, 1000)
+    )))::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE eth_key_usage (
+    eth_tx_id bigint PRIMARY KEY,
+    tx_hash bytea NOT NULL,
+    from_address bytea NOT NULL,
+    to_address bytea NOT NULL,
+    value numeric(78, 0) NOT NULL,
+    nonce bigint,
+    evm_chain_id numeric(78, 0) NOT NULL,
+    subject uuid,
+    job_id int,
+    job_name varchar(255),
+    gas_used bigint NOT NULL,
+    effective_gas_price numeric(78, 0),
+    fee_estimated boolean NOT NULL,
+    broadcast_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_eth_key_usage_from_address_broadcast_at ON eth_key_usage (from_address, broadcast_at);
+
+-- The usage of the transactions which have not been reaped yet
+INSERT INTO eth_key_usage (eth_tx_id, tx_hash, from_address, to_address, value, nonce, evm_chain_id, subject, job_id, job_name, gas_used, effective_gas_price, fee_estimated, broadcast_at, created_at)
+SELECT DISTINCT ON (eth_txes.id) eth_txes.id, eth_tx_attempts.hash, eth_txes.from_address, eth_txes.to_address, eth_txes.value, eth_txes.nonce, eth_txes.evm_chain_id, eth_txes.subject, j.id, j.name,
+    r.gas_used, COALESCE(r.effective_gas_price, eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap),
+    r.effective_gas_price IS NULL AND eth_tx_attempts.gas_price IS NULL,
+    COALESCE(eth_txes.broadcast_at, eth_txes.created_at), NOW()
+FROM eth_receipts
+JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+CROSS JOIN LATERAL (
+    SELECT ('x' || lpad(substr(eth_receipts.receipt->>'gasUsed', 3), 16, '0'))::bit(64)::bigint AS gas_used,
+        ('x' || lpad(substr(eth_receipts.receipt->>'effectiveGasPrice', 3), 16, '0'))::bit(64)::bigint::numeric AS effective_gas_price
+) r
+LEFT JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+LEFT JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+LEFT JOIN LATERAL (
+    SELECT jobs.id, jobs.name FROM jobs
+    WHERE jobs.id = (eth_txes.meta->>'JobID')::int
+    OR jobs.external_job_id = eth_txes.subject
+    OR jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+    ORDER BY jobs.id
+    LIMIT 1
+) j ON true
+ORDER BY eth_txes.id, eth_receipts.block_number DESC;
+
+-- +goose Down
+DROP TABLE eth_key_usage;
@@ -0,0 +1,147 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/trace"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+// auditRequests is middleware recording the mutating requests of a route
+// group in the audit log once they are handled, including the ones rejected
+// for lack of a role. It must come after the authentication middleware.
+func auditRequests(app chainlink.Application) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			if body, err = ioutil.ReadAll(c.Request.Body); err != nil {
+				jsonAPIError(c, http.StatusBadRequest, err)
+				c.Abort()
+				return
+			}
+			c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+		}
+
+		c.Next()
+
+		entry := audit.Entry{
+			SourceIP:   c.ClientIP(),
+			Action:     fmt.Sprintf("%s %s", c.Request.Method, c.FullPath()),
+			Resource:   c.Request.URL.Path,
+			Params:     auditParams(c, body),
+			StatusCode: c.Writer.Status(),
+		}
+		if user, ok := auth.GetAuthenticatedUser(c); ok {
+			entry.UserEmail = null.StringFrom(user.Email)
+			if key, ok := auth.GetAuthenticatedAPITokenKey(c); ok {
+				entry.APITokenKey = null.StringFrom(key)
+			}
+		} else if ei, ok := auth.GetAuthenticatedExternalInitiator(c); ok {
+			entry.ExternalInitiator = null.StringFrom(ei.Name)
+		}
+		app.GetAuditLogger().Record(&entry)
+	}
+}
+
+// auditParams returns the query parameters and body of a request. Bodies
+// which are not JSON objects are only described, as they may be anything
+// from a job spec to an encrypted key.
+func auditParams(c *gin.Context, body []byte) audit.Params {
+	params := audit.Params{}
+	if query := c.Request.URL.Query(); len(query) > 0 {
+		q := map[string]interface{}{}
+		for k, v := range query {
+			if len(v) == 1 {
+				q[k] = v[0]
+			} else {
+				q[k] = v
+			}
+		}
+		params["query"] = q
+	}
+	if len(body) > 0 {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(body, &obj); err != nil {
+			params["body"] = fmt.Sprintf("non-JSON body of %d bytes", len(body))
+		} else {
+			params["body"] = obj
+		}
+	}
+	return params
+}
+
+type clientIPKey struct{}
+
+// withClientIP stores the client IP in the request context, for the audit
+// of GraphQL mutations.
+func withClientIP(c *gin.Context) {
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), clientIPKey{}, c.ClientIP()))
+}
+
+// auditTracer records GraphQL mutations in the audit log. It embeds the
+// default tracer of the schema, so tracing is otherwise unchanged.
+type auditTracer struct {
+	trace.OpenTracingTracer
+	app chainlink.Application
+}
+
+func (t auditTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	ctx, finish := t.OpenTracingTracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+	if typeName != "Mutation" {
+		return ctx, finish
+	}
+
+	return ctx, func(err *errors.QueryError) {
+		finish(err)
+
+		entry := audit.Entry{
+			Action:     "mutation " + fieldName,
+			Params:     audit.Params(args),
+			StatusCode: gqlStatusCode(err),
+		}
+		if id, ok := args["id"].(string); ok {
+			entry.Resource = id
+		}
+		if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+			entry.SourceIP = ip
+		}
+		if session, ok := auth.GetGQLAuthenticatedSession(ctx); ok {
+			entry.UserEmail = null.StringFrom(session.User.Email)
+		}
+		t.app.GetAuditLogger().Record(&entry)
+	}
+}
+
+// gqlStatusCode returns the HTTP status equivalent to the outcome of a
+// GraphQL field.
+func gqlStatusCode(err *errors.QueryError) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	switch err.Extensions["code"] {
+	case "UNAUTHORIZED":
+		return http.StatusUnauthorized
+	case "FORBIDDEN":
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,22 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AuditController lists the audit log
+type AuditController struct {
+	App chainlink.Application
+}
+
+// Index lists the audit log entries, most recent first.
+// Example:
+//  "GET <application>/audit?size=25&page=1"
+func (ac *AuditController) Index(c *gin.Context, size, page, offset int) {
+	entries, count, err := ac.App.GetAuditLogger().Entries(offset, size)
+
+	paginatedResponse(c, "auditEntries", size, page, presenters.NewAuditEntryResources(entries), count, err)
+}
@@ -0,0 +1,88 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAuditController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+	viewer := app.NewHTTPClientForUser(cltest.APIEmailViewOnly)
+
+	// Audited
+	resp, cleanup := client.Post("/v2/bridge_types", bytes.NewBufferString(`{"name": "auditbridge", "url": "http://localhost:8080"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = viewer.Delete("/v2/bridge_types/auditbridge")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = client.Patch("/v2/user/password", bytes.NewBufferString(`{"oldPassword": "wrong", "newPassword": "wrong"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Post("/query", bytes.NewBufferString(`{"query": "mutation { deleteBridge(id: \"missing\") { ... on NotFoundError { message } } }"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	// Not audited
+	resp, cleanup = client.Get("/v2/bridge_types")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	require.Eventually(t, func() bool {
+		_, count, err := app.GetAuditLogger().Entries(0, 10)
+		return err == nil && count == 4
+	}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+
+	resp, cleanup = viewer.Get("/v2/audit")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = client.Get("/v2/audit?size=10")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var entries []presenters.AuditEntryResource
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParseJSONAPIResponse(body, &entries))
+	require.Len(t, entries, 4)
+
+	mutation, password, forbidden, create := entries[0], entries[1], entries[2], entries[3]
+
+	assert.Equal(t, "mutation deleteBridge", mutation.Action)
+	assert.Equal(t, "missing", mutation.Resource)
+	assert.Equal(t, cltest.APIEmail, mutation.UserEmail.String)
+	assert.Equal(t, http.StatusOK, mutation.StatusCode)
+
+	assert.Equal(t, "PATCH /v2/user/password", password.Action)
+	assert.Equal(t, map[string]interface{}{"oldPassword": "*REDACTED*", "newPassword": "*REDACTED*"}, password.Params["body"])
+	assert.Equal(t, http.StatusConflict, password.StatusCode)
+
+	assert.Equal(t, "DELETE /v2/bridge_types/:BridgeName", forbidden.Action)
+	assert.Equal(t, "/v2/bridge_types/auditbridge", forbidden.Resource)
+	assert.Equal(t, cltest.APIEmailViewOnly, forbidden.UserEmail.String)
+	assert.Equal(t, http.StatusForbidden, forbidden.StatusCode)
+
+	assert.Equal(t, "POST /v2/bridge_types", create.Action)
+	assert.Equal(t, cltest.APIEmail, create.UserEmail.String)
+	assert.False(t, create.APITokenKey.Valid)
+	assert.NotEmpty(t, create.SourceIP)
+	assert.Equal(t, "auditbridge", create.Params["body"].(map[string]interface{})["name"])
+	assert.Equal(t, http.StatusOK, create.StatusCode)
+}
@@ -2,7 +2,10 @@ package auth
 
 import (
 	"database/sql"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -32,6 +35,10 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAPITokenKey is the API token access key in the session map, set
+	// when a user authenticated with an API token
+	SessionAPITokenKey = "api_token_key"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -39,7 +46,10 @@ const (
 type Authenticator interface {
 	AuthorizedUserWithSession(sessionID string) (clsessions.User, error)
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
-	FindUser() (clsessions.User, error)
+	FindUserByAPIToken(apiToken string) (clsessions.User, error)
+	FindUser(email string) (clsessions.User, error)
+	FindAPIToken(accessKey string) (clsessions.APIToken, error)
+	MarkAPITokenUsed(id int64) error
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -69,7 +79,9 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateBySession
 
-// AuthenticateByToken authenticates a User by their API token.
+// AuthenticateByToken authenticates a User by their API token, or by one of
+// their named API tokens. Named tokens must not have expired, and their
+// scopes must allow the request.
 //
 // Implements authMethod
 func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
@@ -78,10 +90,10 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	user, err := authr.FindUser()
+	user, err := authr.FindUserByAPIToken(token.AccessKey)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return auth.ErrorAuthFailed
+			return authenticateByScopedToken(c, authr, token)
 		}
 
 		return err
@@ -96,12 +108,88 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, token.AccessKey)
 
 	return nil
 }
 
 var _ authMethod = AuthenticateByToken
 
+// authenticateByScopedToken authenticates a User by one of their named API
+// tokens.
+func authenticateByScopedToken(c *gin.Context, authr Authenticator, token *auth.Token) error {
+	apiToken, err := authr.FindAPIToken(token.AccessKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrorAuthFailed
+		}
+
+		return err
+	}
+
+	ok, err := clsessions.AuthenticateAPIToken(token, apiToken)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrorAuthFailed
+	}
+	if apiToken.Expired(time.Now()) {
+		return errors.Errorf("API token %s has expired", apiToken.Name)
+	}
+
+	resource := ScopeResource(c.FullPath())
+	required := clsessions.ScopeWrite
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		required = clsessions.ScopeRead
+	}
+	if !apiToken.TokenScopes().Allows(resource, required) {
+		return &scopeError{token: apiToken.Name, resource: resource, required: required}
+	}
+	if includesKeys(c) && !apiToken.TokenScopes().Allows("keys", clsessions.ScopeWrite) {
+		return &scopeError{token: apiToken.Name, resource: "keys", required: clsessions.ScopeWrite}
+	}
+
+	user, err := authr.FindUser(apiToken.UserEmail)
+	if err != nil {
+		return err
+	}
+	if err = authr.MarkAPITokenUsed(apiToken.ID); err != nil {
+		return err
+	}
+
+	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, token.AccessKey)
+
+	return nil
+}
+
+// includesKeys returns true if the request exports or imports a bundle with
+// keys, which takes write access to keys as well as to bundles.
+func includesKeys(c *gin.Context) bool {
+	switch c.FullPath() {
+	case "/v2/bundle/export":
+		include, _ := strconv.ParseBool(c.Query("includeKeys"))
+		return include
+	case "/v2/bundle/import":
+		return c.Query("oldpassword") != ""
+	default:
+		return false
+	}
+}
+
+// scopeError is returned when the scopes of an API token do not allow a
+// request.
+type scopeError struct {
+	token    string
+	resource string
+	required clsessions.ScopeLevel
+}
+
+func (e *scopeError) Error() string {
+	return fmt.Sprintf("forbidden: API token %s does not have %s access to %s", e.token, e.required, e.resource)
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -148,7 +236,12 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 		}
 		if err != nil {
 			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, err)
+			var scopeErr *scopeError
+			if errors.As(err, &scopeErr) {
+				jsonAPIError(c, http.StatusForbidden, err)
+			} else {
+				jsonAPIError(c, http.StatusUnauthorized, err)
+			}
 
 			return
 		}
@@ -157,6 +250,41 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 	}
 }
 
+// RequiresEditRole wraps handler so that it is only called for users with at
+// least the edit role. It responds with 403 Forbidden otherwise.
+func RequiresEditRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return requiresRole(clsessions.UserRoleEdit, handler)
+}
+
+// RequiresAdminRole wraps handler so that it is only called for users with the
+// admin role. It responds with 403 Forbidden otherwise.
+func RequiresAdminRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return requiresRole(clsessions.UserRoleAdmin, handler)
+}
+
+// requiresRole checks the role of the authenticated user. External initiators
+// have no role, they are only authenticated on the routes they may call.
+func requiresRole(role clsessions.UserRole, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			if _, ok = GetAuthenticatedExternalInitiator(c); ok {
+				handler(c)
+				return
+			}
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, auth.ErrorAuthFailed)
+			return
+		}
+		if !user.Role.Includes(role) {
+			c.Abort()
+			jsonAPIError(c, http.StatusForbidden, errors.Errorf("forbidden: requires the %s role, user %s has the %s role", role, user.Email, user.Role))
+			return
+		}
+		handler(c)
+	}
+}
+
 // GetAuthenticatedUser extracts the authentication user from the context.
 func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	obj, ok := c.Get(SessionUserKey)
@@ -179,3 +307,16 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 
 	return obj.(*bridges.ExternalInitiator), ok
 }
+
+// GetAuthenticatedAPITokenKey extracts the access key of the API token the
+// user authenticated with from the context.
+func GetAuthenticatedAPITokenKey(c *gin.Context) (string, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return "", false
+	}
+
+	key, ok := obj.(string)
+
+	return key, ok
+}
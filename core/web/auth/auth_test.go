@@ -1,16 +1,22 @@
 package auth_test
 
 import (
+	"database/sql"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
@@ -33,7 +39,7 @@ type userFindFailer struct {
 	err error
 }
 
-func (u userFindFailer) FindUser() (sessions.User, error) {
+func (u userFindFailer) FindUserByAPIToken(string) (sessions.User, error) {
 	return sessions.User{}, u.err
 }
 
@@ -42,10 +48,38 @@ type userFindSuccesser struct {
 	user sessions.User
 }
 
-func (u userFindSuccesser) FindUser() (sessions.User, error) {
+func (u userFindSuccesser) FindUserByAPIToken(string) (sessions.User, error) {
 	return u.user, nil
 }
 
+// scopedTokenAuthenticator has no user tokens, only the given named token
+type scopedTokenAuthenticator struct {
+	sessions.ORM
+	user     sessions.User
+	apiToken sessions.APIToken
+	used     bool
+}
+
+func (a *scopedTokenAuthenticator) FindUserByAPIToken(string) (sessions.User, error) {
+	return sessions.User{}, sql.ErrNoRows
+}
+
+func (a *scopedTokenAuthenticator) FindAPIToken(accessKey string) (sessions.APIToken, error) {
+	if accessKey != a.apiToken.AccessKey {
+		return sessions.APIToken{}, sql.ErrNoRows
+	}
+	return a.apiToken, nil
+}
+
+func (a *scopedTokenAuthenticator) FindUser(string) (sessions.User, error) {
+	return a.user, nil
+}
+
+func (a *scopedTokenAuthenticator) MarkAPITokenUsed(int64) error {
+	a.used = true
+	return nil
+}
+
 func TestAuthenticateByToken_Success(t *testing.T) {
 	user := cltest.MustRandomUser(t)
 	apiToken := auth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret}
@@ -164,3 +198,184 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.False(t, called)
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
+
+func TestRequiresRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     sessions.UserRole
+		wrap     func(gin.HandlerFunc) gin.HandlerFunc
+		wantCode int
+	}{
+		{"view cannot edit", sessions.UserRoleView, webauth.RequiresEditRole, http.StatusForbidden},
+		{"edit can edit", sessions.UserRoleEdit, webauth.RequiresEditRole, http.StatusOK},
+		{"admin can edit", sessions.UserRoleAdmin, webauth.RequiresEditRole, http.StatusOK},
+		{"view cannot admin", sessions.UserRoleView, webauth.RequiresAdminRole, http.StatusForbidden},
+		{"edit cannot admin", sessions.UserRoleEdit, webauth.RequiresAdminRole, http.StatusForbidden},
+		{"admin can admin", sessions.UserRoleAdmin, webauth.RequiresAdminRole, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			user := cltest.MustRandomUser(t)
+			user.Role = tt.role
+			apiToken := auth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret}
+			require.NoError(t, user.SetAuthToken(&apiToken))
+			authr := userFindSuccesser{user: user}
+
+			called := false
+			router := gin.New()
+			router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+			router.GET("/", tt.wrap(func(c *gin.Context) {
+				called = true
+				c.String(http.StatusOK, "")
+			}))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			req.Header.Set(webauth.APIKey, cltest.APIKey)
+			req.Header.Set(webauth.APISecret, cltest.APISecret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode == http.StatusOK, called)
+			assert.Equal(t, http.StatusText(tt.wantCode), http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestRequiresRole_ExternalInitiator(t *testing.T) {
+	called := false
+	var authr webauth.Authenticator
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, func(c *gin.Context, _ webauth.Authenticator) error {
+		c.Set(webauth.SessionExternalInitiatorKey, &bridges.ExternalInitiator{Name: "ei"})
+		return nil
+	}))
+	router.GET("/", webauth.RequiresEditRole(func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	}))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	router.ServeHTTP(w, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+}
+
+func newScopedToken(t *testing.T, scopes sessions.TokenScopes, expiresAt null.Time) (sessions.APIToken, *auth.Token) {
+	token := auth.NewToken()
+	salt := "salt"
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	require.NoError(t, err)
+	return sessions.APIToken{
+		ID:           1,
+		Name:         "ci",
+		AccessKey:    token.AccessKey,
+		Salt:         salt,
+		HashedSecret: hashedSecret,
+		Scopes:       pq.StringArray(scopes),
+		ExpiresAt:    expiresAt,
+	}, token
+}
+
+func TestAuthenticateByToken_Scoped(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		scopes     sessions.TokenScopes
+		expiresAt  null.Time
+		secret     string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{"unscoped", nil, null.Time{}, "", http.MethodPost, "/v2/keys/eth", http.StatusOK},
+		{"read-only read", sessions.TokenScopes{"*:read"}, null.Time{}, "", http.MethodGet, "/v2/keys/eth", http.StatusOK},
+		{"read-only write", sessions.TokenScopes{"*:read"}, null.Time{}, "", http.MethodPost, "/v2/keys/eth", http.StatusForbidden},
+		{"jobs write", sessions.TokenScopes{"*:read", "jobs:write"}, null.Time{}, "", http.MethodPost, "/v2/jobs", http.StatusOK},
+		{"keys none", sessions.TokenScopes{"*:read", "keys:none"}, null.Time{}, "", http.MethodGet, "/v2/keys/eth", http.StatusForbidden},
+		{"unlisted resource", sessions.TokenScopes{"jobs:write"}, null.Time{}, "", http.MethodGet, "/v2/bridge_types", http.StatusForbidden},
+		{"bundle none", sessions.TokenScopes{"*:write", "bundle:none"}, null.Time{}, "", http.MethodPost, "/v2/bundle/export", http.StatusForbidden},
+		{"bundle export without keys", sessions.TokenScopes{"*:write", "keys:none"}, null.Time{}, "", http.MethodPost, "/v2/bundle/export?includeKeys=false", http.StatusOK},
+		{"bundle export with keys", sessions.TokenScopes{"*:write", "keys:none"}, null.Time{}, "", http.MethodPost, "/v2/bundle/export?includeKeys=true&newpassword=secret", http.StatusForbidden},
+		{"bundle export with keys write", sessions.TokenScopes{"bundle:write", "keys:write"}, null.Time{}, "", http.MethodPost, "/v2/bundle/export?includeKeys=true&newpassword=secret", http.StatusOK},
+		{"bundle import with keys", sessions.TokenScopes{"*:write", "keys:read"}, null.Time{}, "", http.MethodPost, "/v2/bundle/import?oldpassword=secret", http.StatusForbidden},
+		{"not expired", nil, null.TimeFrom(time.Now().Add(time.Hour)), "", http.MethodGet, "/v2/jobs", http.StatusOK},
+		{"expired", nil, null.TimeFrom(time.Now().Add(-time.Hour)), "", http.MethodGet, "/v2/jobs", http.StatusUnauthorized},
+		{"wrong secret", nil, null.Time{}, "wrong", http.MethodGet, "/v2/jobs", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiToken, token := newScopedToken(t, tt.scopes, tt.expiresAt)
+			authr := &scopedTokenAuthenticator{user: cltest.MustRandomUser(t), apiToken: apiToken}
+
+			called := false
+			handler := func(c *gin.Context) {
+				called = true
+				user, ok := webauth.GetAuthenticatedUser(c)
+				assert.True(t, ok)
+				assert.Equal(t, authr.user.Email, user.Email)
+				key, ok := webauth.GetAuthenticatedAPITokenKey(c)
+				assert.True(t, ok)
+				assert.Equal(t, token.AccessKey, key)
+				c.String(http.StatusOK, "")
+			}
+			router := gin.New()
+			router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+			router.Handle(tt.method, strings.Split(tt.path, "?")[0], handler)
+
+			secret := token.Secret
+			if tt.secret != "" {
+				secret = tt.secret
+			}
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set(webauth.APIKey, token.AccessKey)
+			req.Header.Set(webauth.APISecret, secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantStatus == http.StatusOK, called)
+			assert.Equal(t, tt.wantStatus == http.StatusOK, authr.used)
+		})
+	}
+}
+
+func TestScopeResource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		route string
+		want  string
+	}{
+		{"/v2/jobs", "jobs"},
+		{"/v2/jobs/:ID/runs", "jobs"},
+		{"/v2/pipeline/runs/:runID/replay", "jobs"},
+		{"/v2/keys/eth/:keyID", "keys"},
+		{"/v2/transfers/evm", "keys"},
+		{"/v2/bridge_types/:BridgeName", "bridges"},
+		{"/v2/nodes/evm/forwarders", "chains"},
+		{"/v2/user/tokens", "users"},
+		{"/v2/vrf/requests", "vrf"},
+		{"/v2/vrf/subscriptions/:subID", "vrf"},
+		{"/v2/bundle/export", "bundle"},
+		{"/v2/keeper/upkeeps", "keeper"},
+		{"/v2/keeper/simulate", "keeper"},
+		{"/v2/ping", "node"},
+		{"/v2/diagnostics", "node"},
+		{"/v2/unknown", "*"},
+		{"/query", "*"},
+		{"", "*"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, webauth.ScopeResource(tt.route), tt.route)
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"strings"
+
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// scopeResources maps the first segment of /v2 routes to the resource
+// scoping API tokens' access to them. See clsessions.ScopeResources.
+var scopeResources = map[string]string{
+	"bridge_types":        "bridges",
+	"bundle":              "bundle",
+	"external_initiators": "bridges",
+	"chains":              "chains",
+	"nodes":               "chains",
+	"replay_from_block":   "chains",
+	"config":              "config",
+	"log":                 "config",
+	"feeds_managers":      "feeds",
+	"keeper":              "keeper",
+	"events":              "jobs",
+	"jobs":                "jobs",
+	"pipeline":            "jobs",
+	"keys":                "keys",
+	"transfers":           "keys",
+	"build_info":          "node",
+	"debug":               "node",
+	"diagnostics":         "node",
+	"features":            "node",
+	"ping":                "node",
+	"transactions":        "txs",
+	"tx_attempts":         "txs",
+	"audit":               "users",
+	"enroll_webauthn":     "users",
+	"user":                "users",
+	"users":               "users",
+	"vrf":                 "vrf",
+}
+
+// ScopeResource returns the resource of a route, e.g. "jobs" for
+// "/v2/jobs/:ID". Unknown routes are only covered by the "*" scope.
+func ScopeResource(route string) string {
+	segments := strings.Split(strings.TrimPrefix(route, "/"), "/")
+	if len(segments) < 2 || segments[0] != "v2" {
+		return clsessions.ScopeAllResources
+	}
+	if resource, ok := scopeResources[segments[1]]; ok {
+		return resource
+	}
+	return clsessions.ScopeAllResources
+}
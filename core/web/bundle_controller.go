@@ -0,0 +1,123 @@
+package web
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/bundle"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/job/lint"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// BundleMediaType is the content type of bundle archives.
+const BundleMediaType = "application/gzip"
+
+// BundleController exports the jobs, bridges, external initiators, chains and
+// nodes of the node to a bundle, and imports bundles exported by other nodes.
+type BundleController struct {
+	App chainlink.Application
+}
+
+func (bc *BundleController) service() bundle.Service {
+	app := bc.App
+	createJob := func(ctx context.Context, toml string) (job.Job, error) {
+		jb, err := validateJobSpec(app, toml)
+		if err != nil {
+			return jb, err
+		}
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		err = app.AddJobV2(ctx, &jb)
+		return jb, err
+	}
+	return bundle.NewService(app.BridgeORM(), app.EVMORM(), app.JobORM(), app.GetExternalInitiatorManager(),
+		app.GetKeyStore(), createJob, app.GetLogger())
+}
+
+// Export returns a bundle of the node, with its keys encrypted with the
+// newpassword query param if includeKeys is set.
+// Example:
+// "POST <application>/bundle/export?includeKeys=true&newpassword=secret"
+func (bc *BundleController) Export(c *gin.Context) {
+	defer bc.App.GetLogger().ErrorIfClosing(c.Request.Body, "Export request body")
+
+	var opts bundle.ExportOpts
+	if s := c.Query("includeKeys"); s != "" {
+		includeKeys, err := strconv.ParseBool(s)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid includeKeys"))
+			return
+		}
+		if includeKeys {
+			opts.KeyPassword = c.Query("newpassword")
+			if opts.KeyPassword == "" {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("newpassword is required to include keys"))
+				return
+			}
+		}
+	}
+
+	b, err := bc.service().Export(opts)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	archive, err := b.Bytes()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Data(http.StatusOK, BundleMediaType, archive)
+}
+
+// Import creates the items of the bundle in the request body which the node
+// does not have yet. Keys are decrypted with the oldpassword query param.
+// Example:
+// "POST <application>/bundle/import?oldpassword=secret"
+func (bc *BundleController) Import(c *gin.Context) {
+	defer bc.App.GetLogger().ErrorIfClosing(c.Request.Body, "Import request body")
+
+	b, err := bundle.Read(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	result, err := bc.service().Import(c.Request.Context(), b, c.Query("oldpassword"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewBundleImportResource(result), "bundleImport")
+}
+
+// validateJobSpec validates a TOML job spec of any type, as JobsController
+// does when creating a job.
+func validateJobSpec(app chainlink.Application, spec string) (jb job.Job, err error) {
+	jobType, err := job.ValidateSpec(spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to parse TOML")
+	}
+	config := app.GetConfig()
+	switch jobType {
+	case job.OffchainReporting:
+		if !config.Dev() && !config.FeatureOffchainReporting() {
+			return jb, errors.New("The Offchain Reporting feature is disabled by configuration")
+		}
+	case job.OffchainReporting2:
+		if !config.Dev() && !config.FeatureOffchainReporting2() {
+			return jb, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
+		}
+	}
+	return lint.Validate(lint.Config{
+		General:                  config,
+		ChainSet:                 app.GetChains().EVM,
+		ExternalInitiatorManager: app.GetExternalInitiatorManager(),
+	}, spec)
+}
@@ -0,0 +1,92 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/bundle"
+	"github.com/smartcontractkit/chainlink/core/services/cron"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestBundleController_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{})
+	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
+	jb, err := cron.ValidatedCronSpec(testspecs.CronSpec)
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(testutils.Context(t), &jb))
+
+	resp, cleanup := client.Post("/v2/bundle/export", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	archive := cltest.ParseResponseBody(t, resp)
+
+	b, err := bundle.Read(bytes.NewReader(archive))
+	require.NoError(t, err)
+	require.Len(t, b.Bridges, 1)
+	assert.Equal(t, bt.Name.String(), b.Bridges[0].Name)
+	assert.Equal(t, bt.OutgoingToken, b.Bridges[0].OutgoingToken)
+	require.Len(t, b.Jobs, 1)
+	assert.Equal(t, jb.ExternalJobID, b.Jobs[0].ExternalJobID)
+	assert.Empty(t, b.Keys)
+
+	// The job is recreated with its external job ID, and the bridge is kept
+	require.NoError(t, app.DeleteJob(testutils.Context(t), jb.ID))
+	importBundle := func() presenters.BundleImportResource {
+		resp, cleanup := client.Post("/v2/bundle/import", bytes.NewReader(archive))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var result presenters.BundleImportResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &result))
+		return result
+	}
+	result := importBundle()
+	assert.Zero(t, result.Failed)
+	assert.Contains(t, result.Items, bundle.ItemResult{Kind: "bridge", ID: bt.Name.String(), Status: bundle.StatusExists})
+	assert.Contains(t, result.Items, bundle.ItemResult{Kind: "job", ID: jb.ExternalJobID.String(), Status: bundle.StatusCreated})
+
+	_, err = app.JobORM().FindJobByExternalJobID(jb.ExternalJobID)
+	require.NoError(t, err)
+
+	// Importing again changes nothing
+	for _, item := range importBundle().Items {
+		assert.Equal(t, bundle.StatusExists, item.Status, item)
+	}
+}
+
+func TestBundleController_Export_KeysRequirePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/bundle/export?includeKeys=true", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestBundleController_Import_InvalidArchive(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/bundle/import", bytes.NewReader([]byte(`{"jobs":[]}`)))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
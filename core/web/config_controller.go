@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
 )
@@ -25,6 +26,37 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, cw, "config")
 }
 
+// Provenance returns the effective global settings and the settings of each
+// EVM chain, along with the source of each and any values they shadow
+// Example:
+//  "<application>/config/provenance"
+func (cc *ConfigController) Provenance(c *gin.Context) {
+	resources := []presenters.ConfigProvenanceResource{
+		presenters.NewGlobalConfigProvenanceResource(config.GlobalProvenance(cc.App.GetConfig())),
+	}
+	if cs := cc.App.GetChains().EVM; cs != nil {
+		for _, chain := range cs.Chains() {
+			resources = append(resources, presenters.NewEVMChainConfigProvenanceResource(*utils.NewBig(chain.ID()), chain.Config().Provenance()))
+		}
+	}
+
+	jsonAPIResponse(c, resources, "configProvenance")
+}
+
+// Reload re-reads the config files and applies the changed settings that can
+// safely change while the node is running
+// Example:
+//  "<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	result, err := cc.App.ReloadConfig()
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewConfigReloadResource(result), "configReload")
+}
+
 type configPatchRequest struct {
 	EvmGasPriceDefault *utils.Big `json:"ethGasPriceDefault"`
 	EVMChainID         *utils.Big `json:"evmChainID"`
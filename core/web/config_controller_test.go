@@ -12,8 +12,63 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
+func TestConfigController_Provenance(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/config/provenance")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resources []presenters.ConfigProvenanceResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+	require.Len(t, resources, 2)
+
+	global := resources[0]
+	assert.Equal(t, "global", global.ID)
+	assert.Nil(t, global.EVMChainID)
+	assert.NotEmpty(t, global.Settings)
+	for _, s := range global.Settings {
+		assert.NotEmpty(t, s.Name)
+		assert.NotEmpty(t, s.Source)
+	}
+
+	chain := resources[1]
+	require.NotNil(t, chain.EVMChainID)
+	assert.Equal(t, "evm/"+chain.EVMChainID.String(), chain.ID)
+	var found bool
+	for _, s := range chain.Settings {
+		if s.Name == "ETH_FINALITY_DEPTH" {
+			found = true
+			assert.Equal(t, config.SourceChainDefault, s.Source)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestConfigController_Reload(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/config/reload", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var result presenters.ConfigReloadResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &result))
+	assert.Empty(t, result.Applied)
+	assert.Empty(t, result.RequiresRestart)
+}
+
 func TestConfigController_Show(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,31 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/diagnostics"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// DiagnosticsController runs health checks against the node.
+type DiagnosticsController struct {
+	App chainlink.Application
+}
+
+// Show runs the diagnostic checks and returns their results
+// Example:
+//  "<application>/diagnostics"
+func (dc *DiagnosticsController) Show(c *gin.Context) {
+	doctor := diagnostics.NewDoctor(
+		dc.App.GetSqlxDB(),
+		dc.App.GetConfig(),
+		dc.App.ID(),
+		dc.App.GetChains().EVM,
+		dc.App.GetKeyStore().Eth(),
+		dc.App.PipelineORM(),
+		dc.App.GetLogger(),
+	)
+
+	jsonAPIResponse(c, presenters.NewDiagnosticResources(doctor.Run(c.Request.Context())), "diagnostics")
+}
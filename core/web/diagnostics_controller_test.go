@@ -0,0 +1,38 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/diagnostics"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestDiagnosticsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/diagnostics")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var results []presenters.DiagnosticResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &results))
+
+	statuses := map[string]diagnostics.Status{}
+	for _, r := range results {
+		statuses[r.Check] = r.Status
+	}
+	assert.Equal(t, diagnostics.StatusPass, statuses["database"])
+	assert.Equal(t, diagnostics.StatusPass, statuses["migrations"])
+	assert.Equal(t, diagnostics.StatusPass, statuses["pipeline runs"])
+	assert.Contains(t, statuses, "disk space")
+}
@@ -1,16 +1,23 @@
 package web
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"math/big"
 	"net/http"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -75,9 +82,11 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 	jsonAPIResponse(c, resources, "keys")
 }
 
-// Create adds a new account
+// Create adds a new account, which is derived from the HD wallet if it is set
+// up, or a remote account held by the remote signer if remoteAddress is given
 // Example:
 //  "<application>/keys/eth"
+//  "<application>/keys/eth?remoteAddress=0x..."
 func (ekc *ETHKeysController) Create(c *gin.Context) {
 	ethKeyStore := ekc.App.GetKeyStore().Eth()
 
@@ -102,7 +111,16 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		}
 	}
 
-	key, err := ethKeyStore.Create(chain.ID())
+	var key ethkey.KeyV2
+	if remoteAddress := c.Query("remoteAddress"); remoteAddress != "" {
+		if !common.IsHexAddress(remoteAddress) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid remote address: %s", remoteAddress))
+			return
+		}
+		key, err = ethKeyStore.AddRemote(common.HexToAddress(remoteAddress), chain.ID())
+	} else {
+		key, err = ethKeyStore.Create(chain.ID())
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -296,6 +314,305 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 	c.Data(http.StatusOK, MediaType, bytes)
 }
 
+// CreateETHHDWalletRequest is the request to set up the HD wallet of ETH
+// keys. A new mnemonic is generated if none is given.
+type CreateETHHDWalletRequest struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// ShowHDWallet returns whether new ETH keys are derived from an HD wallet, and
+// the index of the next derived key.
+// Example:
+//  "GET <application>/keys/eth/hd"
+func (ekc *ETHKeysController) ShowHDWallet(c *gin.Context) {
+	nextIndex, enabled, err := ekc.App.GetKeyStore().Eth().HDWalletNextIndex()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewETHHDWalletResource(enabled, nextIndex, ""), "ethHDWallet")
+}
+
+// CreateHDWallet sets up the HD wallet from which new ETH keys are derived.
+// The mnemonic is only returned when it is generated by the node, and has to
+// be backed up to be able to recover the keys.
+// Example:
+//  "POST <application>/keys/eth/hd"
+func (ekc *ETHKeysController) CreateHDWallet(c *gin.Context) {
+	var request CreateETHHDWalletRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	mnemonic, err := ekc.App.GetKeyStore().Eth().CreateHDWallet(request.Mnemonic)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Mnemonic != "" {
+		mnemonic = ""
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewETHHDWalletResource(true, 0, mnemonic), "ethHDWallet", http.StatusCreated)
+}
+
+// RecoverHDKeys adds the first count keys derived from the HD wallet which
+// the node does not have yet, and returns them.
+// Example:
+//  "POST <application>/keys/eth/hd/recover?count=10&evmChainID=1"
+func (ekc *ETHKeysController) RecoverHDKeys(c *gin.Context) {
+	ethKeyStore := ekc.App.GetKeyStore().Eth()
+
+	count, err := strconv.ParseUint(c.Query("count"), 10, 32)
+	if err != nil || count == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("count must be a positive integer"))
+		return
+	}
+	chain, err := getChain(ekc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	keys, err := ethKeyStore.RecoverHDKeys(uint32(count), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := []presenters.ETHKeyResource{}
+	for _, key := range keys {
+		state, err := ethKeyStore.GetState(key.ID())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		r, err := presenters.NewETHKeyResource(key, state,
+			ekc.setEthBalance(c.Request.Context(), state),
+			ekc.setLinkBalance(state),
+		)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, *r)
+	}
+
+	jsonAPIResponse(c, resources, "keys")
+}
+
+// UpdateETHKeyPolicyRequest sets the policy of an ETH key. Nil limits are
+// unlimited, and an empty list of allowed destinations allows any
+// destination.
+type UpdateETHKeyPolicyRequest struct {
+	MaxTxValue          *assets.Eth      `json:"maxTxValue"`
+	MaxDailyGasSpend    *assets.Eth      `json:"maxDailyGasSpend"`
+	AllowedDestinations []common.Address `json:"allowedDestinations"`
+}
+
+// ShowPolicy returns the policy of an ETH key
+// Example:
+//  "GET <application>/keys/eth/:keyID/policy"
+func (ekc *ETHKeysController) ShowPolicy(c *gin.Context) {
+	key, ok := ekc.getKey(c)
+	if !ok {
+		return
+	}
+	policy, err := ekc.App.TxmORM().FindKeyPolicy(key.Address.Address())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if policy == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("ETH key %s has no policy", key.Address.Hex()))
+		return
+	}
+	jsonAPIResponse(c, presenters.NewETHKeyPolicyResource(*policy), "ethKeyPolicy")
+}
+
+// UpdatePolicy sets the policy which limits the transactions of an ETH key
+// Example:
+//  "PUT <application>/keys/eth/:keyID/policy"
+func (ekc *ETHKeysController) UpdatePolicy(c *gin.Context) {
+	key, ok := ekc.getKey(c)
+	if !ok {
+		return
+	}
+	var request UpdateETHKeyPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	for _, limit := range []*assets.Eth{request.MaxTxValue, request.MaxDailyGasSpend} {
+		if limit != nil && limit.ToInt().Sign() < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("limits cannot be negative"))
+			return
+		}
+	}
+
+	policy := txmgr.KeyPolicy{
+		Address:             key.Address.Address(),
+		MaxTxValue:          request.MaxTxValue,
+		MaxDailyGasSpend:    request.MaxDailyGasSpend,
+		AllowedDestinations: request.AllowedDestinations,
+	}
+	if err := ekc.App.TxmORM().UpsertKeyPolicy(&policy); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewETHKeyPolicyResource(policy), "ethKeyPolicy")
+}
+
+// DeletePolicy removes the policy of an ETH key, so that its transactions
+// are not limited
+// Example:
+//  "DELETE <application>/keys/eth/:keyID/policy"
+func (ekc *ETHKeysController) DeletePolicy(c *gin.Context) {
+	key, ok := ekc.getKey(c)
+	if !ok {
+		return
+	}
+	if err := ekc.App.TxmORM().DeleteKeyPolicy(key.Address.Address()); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "ethKeyPolicy", http.StatusNoContent)
+}
+
+// Usage returns the jobs which use an ETH key, and the transactions it
+// broadcast between since and until, with the fee each of them paid. The
+// period defaults to the 7 days before until, which defaults to now. With
+// format=csv the transactions are returned as CSV.
+// Example:
+//  "GET <application>/keys/eth/:keyID/usage"
+//  "GET <application>/keys/eth/:keyID/usage?since=2022-04-20T00:00:00Z&until=2022-04-27T00:00:00Z&evmChainID=1&format=csv"
+func (ekc *ETHKeysController) Usage(c *gin.Context) {
+	key, ok := ekc.getKey(c)
+	if !ok {
+		return
+	}
+
+	until := time.Now()
+	if v := c.Query("until"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid until"))
+			return
+		}
+		until = t
+	}
+	since := until.Add(-7 * 24 * time.Hour)
+	if v := c.Query("since"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid since"))
+			return
+		}
+		since = t
+	}
+	var chainID *big.Int
+	if v := c.Query("evmChainID"); v != "" {
+		var ok bool
+		if chainID, ok = new(big.Int).SetString(v, 10); !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, ErrInvalidChainID)
+			return
+		}
+	}
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid format %q, must be json or csv", format))
+		return
+	}
+
+	// OCR jobs without a transmitter address use OCR_TRANSMITTER_ADDRESS
+	transmitter, err := ekc.App.GetConfig().OCRTransmitterAddress()
+	isDefaultTransmitter := err == nil && transmitter.Address() == key.Address.Address()
+
+	usage, err := ekc.App.JobORM().FindEthKeyUsage(key.Address.Address(), chainID, since, until, isDefaultTransmitter)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if format == "csv" {
+		var b bytes.Buffer
+		if err = writeETHKeyUsageCSV(&b, usage); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-usage.csv"`, key.Address.Hex()))
+		c.Data(http.StatusOK, "text/csv", b.Bytes())
+		return
+	}
+	jsonAPIResponse(c, presenters.NewETHKeyUsageResource(usage), "ethKeyUsage")
+}
+
+// writeETHKeyUsageCSV writes the transactions of usage as CSV, with amounts
+// in wei. The fee columns are empty for transactions without a receipt.
+func writeETHKeyUsageCSV(w io.Writer, usage job.EthKeyUsage) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"id", "hash", "nonce", "to", "value", "state", "evm_chain_id", "job_id", "job_name", "subject",
+		"broadcast_at", "gas_used", "effective_gas_price", "fee", "fee_estimated"}); err != nil {
+		return err
+	}
+	for _, tx := range usage.Transactions {
+		var nonce, jobID, subject, broadcastAt, gasUsed, price, fee string
+		if tx.Nonce != nil {
+			nonce = strconv.FormatInt(*tx.Nonce, 10)
+		}
+		if tx.JobID != nil {
+			jobID = strconv.FormatInt(int64(*tx.JobID), 10)
+		}
+		if tx.Subject.Valid {
+			subject = tx.Subject.UUID.String()
+		}
+		if tx.BroadcastAt != nil {
+			broadcastAt = tx.BroadcastAt.UTC().Format(time.RFC3339)
+		}
+		if tx.GasUsed != nil {
+			gasUsed = strconv.FormatUint(*tx.GasUsed, 10)
+		}
+		if tx.EffectiveGasPrice != nil {
+			price = tx.EffectiveGasPrice.String()
+		}
+		if tx.Fee != nil {
+			fee = tx.Fee.ToInt().String()
+		}
+		err := cw.Write([]string{
+			strconv.FormatInt(tx.EthTxID, 10), tx.Hash.Hex(), nonce, tx.ToAddress.Hex(), tx.Value.ToInt().String(), tx.State,
+			tx.EVMChainID.String(), jobID, tx.JobName.String, subject, broadcastAt, gasUsed, price, fee, strconv.FormatBool(tx.FeeEstimated),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// getKey returns the key of the keyID param, or responds with an error if
+// there is no such key
+func (ekc *ETHKeysController) getKey(c *gin.Context) (ethkey.KeyV2, bool) {
+	keyID := c.Param("keyID")
+	if !common.IsHexAddress(keyID) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %s", keyID))
+		return ethkey.KeyV2{}, false
+	}
+	key, err := ekc.App.GetKeyStore().Eth().Get(common.HexToAddress(keyID).Hex())
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return ethkey.KeyV2{}, false
+	}
+	return key, true
+}
+
 // setEthBalance is a custom functional option for NewEthKeyResource which
 // queries the EthClient for the ETH balance at the address and sets it on the
 // resource.
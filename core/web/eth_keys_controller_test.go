@@ -3,6 +3,7 @@ package web_test
 import (
 	"math/big"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -152,6 +153,132 @@ func TestETHKeysController_CreateSuccess(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+func TestETHKeysController_Usage(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	verify := cltest.MockApplicationEthCalls(t, app, ethClient)
+	defer verify()
+
+	client := app.NewHTTPClient()
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	key, err := app.KeyStore.Eth().Create(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	borm := cltest.NewTxmORM(t, app.GetSqlxDB(), config)
+	etx := cltest.MustInsertConfirmedEthTxWithReceipt(t, borm, key.Address.Address(), 0, 1)
+	url := "/v2/keys/eth/" + key.Address.Hex() + "/usage"
+
+	resp, cleanup := client.Get(url)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var usage webpresenters.ETHKeyUsageResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &usage))
+	assert.Equal(t, key.Address.Hex(), usage.Address)
+	require.Len(t, usage.Transactions, 1)
+	assert.Equal(t, etx.ID, usage.Transactions[0].ID)
+	assert.Nil(t, usage.Transactions[0].JobID)
+
+	resp, cleanup = client.Get(url + "?format=csv")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	body := cltest.ParseResponseBody(t, resp)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(body)), "\n"), 2)
+
+	resp, cleanup = client.Get(url + "?until=2000-01-01T00:00:00Z")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &usage))
+	assert.Empty(t, usage.Transactions)
+
+	for _, query := range []string{"?format=xml", "?since=yesterday", "?evmChainID=one"} {
+		resp, cleanup = client.Get(url + query)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+
+	resp, cleanup = client.Get("/v2/keys/eth/" + testutils.NewAddress().Hex() + "/usage")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestETHKeysController_HDWallet(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	verify := cltest.MockApplicationEthCalls(t, app, ethClient)
+	defer verify()
+
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient()
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	resp, cleanup := client.Get("/v2/keys/eth/hd")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var hdWallet webpresenters.ETHHDWalletResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &hdWallet))
+	assert.False(t, hdWallet.Enabled)
+
+	resp, cleanup = client.Post("/v2/keys/eth/hd/recover?count=1", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusInternalServerError)
+
+	resp, cleanup = client.Post("/v2/keys/eth/hd", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &hdWallet))
+	assert.True(t, hdWallet.Enabled)
+	w, err := ethkey.HDWalletFromMnemonic(hdWallet.Mnemonic)
+	require.NoError(t, err)
+
+	resp, cleanup = client.Post("/v2/keys/eth/hd", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/eth", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var key webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &key))
+	derived, err := w.Derive(0)
+	require.NoError(t, err)
+	assert.Equal(t, derived.Address.Hex(), key.Address)
+
+	resp, cleanup = client.Post("/v2/keys/eth/hd/recover?count=0", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/eth/hd/recover?count=2", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var keys []webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &keys))
+	require.Len(t, keys, 1)
+	derived, err = w.Derive(1)
+	require.NoError(t, err)
+	assert.Equal(t, derived.Address.Hex(), keys[0].Address)
+
+	resp, cleanup = client.Get("/v2/keys/eth/hd")
+	defer cleanup()
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &hdWallet))
+	assert.Equal(t, uint32(2), hdWallet.NextIndex)
+	assert.Empty(t, hdWallet.Mnemonic)
+}
+
 func TestETHKeysController_UpdateSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,90 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// eventsKeepAliveInterval is how often a comment is sent on an idle event
+// stream, so that proxies do not close it.
+const eventsKeepAliveInterval = 15 * time.Second
+
+// EventsController streams node events
+type EventsController struct {
+	App chainlink.Application
+}
+
+// Stream sends run, task run, job error and eth_tx state changes as
+// server-sent events, named after the event type, with a JSON payload. The
+// events can be filtered with the query parameters:
+//  - type: comma-separated event types, e.g. "run,job_error"
+//  - jobID: only the events of this job. eth_tx events are never included.
+// The stream ends shortly before HTTP_SERVER_WRITE_TIMEOUT, after which
+// EventSource clients reconnect automatically.
+// Example:
+//  "GET <application>/events?type=run,task_run&jobID=1"
+func (ec *EventsController) Stream(c *gin.Context) {
+	var filter events.Filter
+	if types := c.Query("type"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			switch typ := events.Type(strings.TrimSpace(t)); typ {
+			case events.TypeRun, events.TypeTaskRun, events.TypeJobError, events.TypeEthTx:
+				filter.Types = append(filter.Types, typ)
+			default:
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid event type %q", t))
+				return
+			}
+		}
+	}
+	if id := c.Query("jobID"); id != "" {
+		jb := job.Job{}
+		if err := jb.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.JobID = &jb.ID
+	}
+
+	evs, unsubscribe := ec.App.GetEventStream().Subscribe(filter)
+	defer unsubscribe()
+
+	// End the stream before the server's write timeout would abort it
+	var deadline <-chan time.Time
+	if timeout := ec.App.GetConfig().HTTPServerWriteTimeout(); timeout > 0 {
+		timer := time.NewTimer(timeout * 9 / 10)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	keepAlive := time.NewTicker(eventsKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-deadline:
+			return false
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case ev, open := <-evs:
+			if !open {
+				return false
+			}
+			c.SSEvent(string(ev.Type), ev)
+			return true
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package web
+
+import (
+	"database/sql"
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeeperUpkeepsController lists the upkeeps of keeper jobs.
+type KeeperUpkeepsController struct {
+	App chainlink.Application
+}
+
+// Index lists the upkeeps of all keeper jobs, or of a single job with the
+// jobID query parameter, along with their stats.
+// Example:
+// "GET <application>/keeper/upkeeps?jobID=1"
+func (kuc *KeeperUpkeepsController) Index(c *gin.Context, size, page, offset int) {
+	var jobID *int32
+	if id := c.Query("jobID"); id != "" {
+		jb := job.Job{}
+		if err := jb.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jobID = &jb.ID
+	}
+
+	upkeeps, count, err := kuc.App.JobORM().FindKeeperUpkeeps(offset, size, jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "keeper_upkeeps", size, page, presenters.NewKeeperUpkeepResources(upkeeps), count, err)
+}
+
+// Simulate evaluates, without performing it, whether a keeper job would
+// perform an upkeep at the latest block, or at the block given by the block
+// query parameter.
+// Example:
+// "GET <application>/keeper/simulate?jobID=1&upkeepID=123&block=100"
+func (kuc *KeeperUpkeepsController) Simulate(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Query("jobID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	upkeepID, ok := new(big.Int).SetString(c.Query("upkeepID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid upkeepID: %q", c.Query("upkeepID")))
+		return
+	}
+	var blockNumber *big.Int
+	if block := c.Query("block"); block != "" {
+		if blockNumber, ok = new(big.Int).SetString(block, 10); !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid block: %q", block))
+			return
+		}
+	}
+
+	jb, err := kuc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if jb.Type != job.Keeper || jb.KeeperSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a keeper job", jb.ID))
+		return
+	}
+
+	chain, err := kuc.App.GetChains().EVM.Get(jb.KeeperSpec.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	orm := keeper.NewORM(kuc.App.GetSqlxDB(), kuc.App.GetLogger(), chain.Config(), nil)
+	simulator := keeper.NewUpkeepSimulator(jb, orm, chain.Client(), chain.TxManager().GetGasEstimator(), kuc.App.GetKeyStore().Eth(), chain.Config())
+
+	sim, err := simulator.Simulate(c.Request.Context(), upkeepID, blockNumber)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("upkeep %s not found for job %d", upkeepID, jb.ID))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewKeeperUpkeepSimulationResource(sim), "keeper_upkeep_simulations")
+}
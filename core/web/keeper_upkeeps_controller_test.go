@@ -0,0 +1,117 @@
+package web_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func Test_KeeperUpkeepsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	db := app.GetSqlxDB()
+	config := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), config, txmgr.SendEveryStrategy{})
+
+	registry1, jb1 := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth(), 0, 1, 20)
+	registry2, jb2 := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth(), 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry1)
+	cltest.MustInsertUpkeepForRegistry(t, db, config, registry2)
+
+	require.NoError(t, korm.RecordUpkeepChecks(map[int32]keeper.UpkeepCheckCounts{upkeep.ID: {Checks: 1, Eligible: 1}}))
+	_, err := korm.RecordUpkeepPerformed(jb1.ID, upkeep.UpkeepID, true, big.NewInt(1000), utils.NewHash())
+	require.NoError(t, err)
+
+	t.Run("all upkeeps", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/keeper/upkeeps")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body := cltest.ParseResponseBody(t, resp)
+		metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+		require.NoError(t, err)
+		assert.Equal(t, 2, metaCount)
+
+		var links jsonapi.Links
+		var resources []presenters.KeeperUpkeepResource
+		require.NoError(t, web.ParsePaginatedResponse(body, &resources, &links))
+		require.Len(t, resources, 2)
+		assert.Equal(t, jb1.ID, resources[0].JobID)
+		assert.Equal(t, int64(1), resources[0].ChecksCount)
+		assert.Equal(t, int64(1), resources[0].EligibleCount)
+		assert.Equal(t, int64(1), resources[0].PerformsCount)
+		assert.Equal(t, 1.0, resources[0].SuccessRate)
+		assert.Equal(t, "1000", resources[0].LinkPayment.String())
+	})
+
+	t.Run("upkeeps of a job", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/keeper/upkeeps?jobID=%d", jb2.ID))
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var links jsonapi.Links
+		var resources []presenters.KeeperUpkeepResource
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links))
+		require.Len(t, resources, 1)
+		assert.Equal(t, jb2.ID, resources[0].JobID)
+		assert.Equal(t, int64(0), resources[0].ChecksCount)
+	})
+
+	t.Run("invalid job ID", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/keeper/upkeeps?jobID=abc")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+}
+
+func Test_KeeperUpkeepsController_Simulate(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	db := app.GetSqlxDB()
+	config := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), config, txmgr.SendEveryStrategy{})
+	_, jb := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth(), 0, 1, 20)
+
+	tests := []struct {
+		name   string
+		query  string
+		status int
+	}{
+		{"missing job ID", "upkeepID=1", http.StatusUnprocessableEntity},
+		{"invalid upkeep ID", fmt.Sprintf("jobID=%d&upkeepID=abc", jb.ID), http.StatusUnprocessableEntity},
+		{"invalid block", fmt.Sprintf("jobID=%d&upkeepID=1&block=abc", jb.ID), http.StatusUnprocessableEntity},
+		{"job not found", "jobID=12345&upkeepID=1", http.StatusNotFound},
+		{"upkeep not found", fmt.Sprintf("jobID=%d&upkeepID=1000", jb.ID), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/keeper/simulate?" + tt.query)
+			t.Cleanup(cleanup)
+			assert.Equal(t, tt.status, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package web
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeyringBackupMediaType is the content type of keyring backups.
+const KeyringBackupMediaType = "application/json"
+
+// KeyringController changes the keystore password, and backs up and restores
+// all of the keys of the node at once.
+type KeyringController struct {
+	App chainlink.Application
+}
+
+// ChangeKeystorePasswordRequest is the body of a keystore password change.
+type ChangeKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+// ChangePassword re-encrypts the keyring with a new password.
+// Example:
+// "PATCH <application>/keys/password"
+func (kc *KeyringController) ChangePassword(c *gin.Context) {
+	var request ChangeKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.NewPassword == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("newPassword is required"))
+		return
+	}
+	if err := kc.App.GetKeyStore().ChangePassword(request.OldPassword, request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "keystore password", http.StatusNoContent)
+}
+
+// Backup returns all of the keys of the node, encrypted with the newpassword
+// query param.
+// Example:
+// "POST <application>/keys/backup?newpassword=secret"
+func (kc *KeyringController) Backup(c *gin.Context) {
+	password := c.Query("newpassword")
+	if password == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("newpassword is required"))
+		return
+	}
+	backup, err := kc.App.GetKeyStore().Backup(password)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Data(http.StatusOK, KeyringBackupMediaType, backup)
+}
+
+// Restore adds the keys of the backup in the request body which the node does
+// not have yet. The backup is decrypted with the oldpassword query param.
+// Example:
+// "POST <application>/keys/restore?oldpassword=secret"
+func (kc *KeyringController) Restore(c *gin.Context) {
+	defer kc.App.GetLogger().ErrorIfClosing(c.Request.Body, "Restore request body")
+
+	backup, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	result, err := kc.App.GetKeyStore().Restore(backup, c.Query("oldpassword"))
+	if errors.Is(err, keystore.ErrLocked) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyringRestoreResource(result), "keyringRestore")
+}
@@ -0,0 +1,69 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestKeyringController_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	csaKey, err := app.KeyStore.CSA().Create()
+	require.NoError(t, err)
+
+	resp, cleanup := client.Post("/v2/keys/backup", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/backup?newpassword=secret", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	backup := cltest.ParseResponseBody(t, resp)
+
+	resp, cleanup = client.Post("/v2/keys/restore?oldpassword=wrong", bytes.NewReader(backup))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/restore?oldpassword=secret", bytes.NewReader(backup))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var result presenters.KeyringRestoreResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &result))
+	assert.Empty(t, result.Restored)
+	assert.Contains(t, result.Skipped["CSA"], csaKey.ID())
+}
+
+func TestKeyringController_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	changePassword := func(oldPassword, newPassword string) *http.Response {
+		body, err := json.Marshal(web.ChangeKeystorePasswordRequest{OldPassword: oldPassword, NewPassword: newPassword})
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/keys/password", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	cltest.AssertServerResponse(t, changePassword("wrong", "new password"), http.StatusConflict)
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, ""), http.StatusUnprocessableEntity)
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, "new password"), http.StatusNoContent)
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, "other password"), http.StatusConflict)
+}
@@ -63,6 +63,23 @@ func (ocr2kc *OCR2KeysController) Delete(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
 }
 
+// Usage returns the jobs which sign with an OCR2 key bundle
+// Example:
+// "GET <application>/keys/ocr2/:keyID/usage"
+func (ocr2kc *OCR2KeysController) Usage(c *gin.Context) {
+	id := c.Param("keyID")
+	if _, err := ocr2kc.App.GetKeyStore().OCR2().Get(id); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	jobs, err := ocr2kc.App.JobORM().FindJobsByOCRKeyBundle(id, false)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyUsageResource(id, jobs), "keyUsage")
+}
+
 // Import imports an OCR2 key bundle
 // Example:
 // "Post <application>/keys/ocr/import"
@@ -57,6 +57,25 @@ func (ocrkc *OCRKeysController) Delete(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
+// Usage returns the jobs which sign with an OCR key bundle
+// Example:
+// "GET <application>/keys/ocr/:keyID/usage"
+func (ocrkc *OCRKeysController) Usage(c *gin.Context) {
+	id := c.Param("keyID")
+	if _, err := ocrkc.App.GetKeyStore().OCR().Get(id); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	// OCR jobs without a key bundle ID use OCR_KEY_BUNDLE_ID
+	defaultID, err := ocrkc.App.GetConfig().OCRKeyBundleID()
+	jobs, err := ocrkc.App.JobORM().FindJobsByOCRKeyBundle(id, err == nil && defaultID == id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyUsageResource(id, jobs), "keyUsage")
+}
+
 // Import imports an OCR key bundle
 // Example:
 // "Post <application>/keys/ocr/import"
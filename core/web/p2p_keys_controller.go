@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -63,6 +64,33 @@ func (p2pkc *P2PKeysController) Delete(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
+// Usage returns the jobs which use a P2P key. These are the OCR, OCR2 and
+// bootstrap jobs if it is the key of the node, set by P2P_PEER_ID, and none
+// otherwise.
+// Example:
+// "GET <application>/keys/p2p/:keyID/usage"
+func (p2pkc *P2PKeysController) Usage(c *gin.Context) {
+	keyID, err := p2pkey.MakePeerID(c.Param("keyID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	key, err := p2pkc.App.GetKeyStore().P2P().Get(keyID)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	jobs := []job.KeyBinding{}
+	nodeKey, err := p2pkc.App.GetKeyStore().P2P().GetOrFirst(p2pkc.App.GetConfig().P2PPeerID())
+	if err == nil && nodeKey.ID() == key.ID() {
+		if jobs, err = p2pkc.App.JobORM().FindJobsUsingP2P(); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	jsonAPIResponse(c, presenters.NewKeyUsageResource(key.ID(), jobs), "keyUsage")
+}
+
 // Import imports a P2P key
 // Example:
 // "Post <application>/keys/p2p/import"
@@ -2,10 +2,13 @@ package web
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
@@ -24,34 +27,30 @@ type PipelineRunsController struct {
 	App chainlink.Application
 }
 
-// Index returns all pipeline runs for a job.
+// Index returns all pipeline runs for a job, or for all jobs, latest first.
+// The runs can be filtered with the query parameters:
+//  - jobID: only when not given in the path
+//  - state: comma-separated run states, e.g. "errored,suspended"
+//  - createdAfter, createdBefore: RFC3339 timestamps
+//  - failedTask: dot ID of a task that errored
+//  - error: substring of a task error, ignoring case
+//  - minDuration: minimum run duration, e.g. "5s"
 // Example:
 // "GET <application>/jobs/:ID/runs"
+// "GET <application>/pipeline/runs?failedTask=ds&error=429&createdAfter=2022-04-20T00:00:00Z"
 func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int) {
-	id := c.Param("ID")
-
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
 	if c.Query("size") == "" {
 		size = 1000
 	}
 
-	var pipelineRuns []pipeline.Run
-	var count int
-	var err error
-
-	if id == "" {
-		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(nil, offset, size)
-	} else {
-		jobSpec := job.Job{}
-		err = jobSpec.SetID(c.Param("ID"))
-		if err != nil {
-			jsonAPIError(c, http.StatusUnprocessableEntity, err)
-			return
-		}
-
-		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(&jobSpec.ID, offset, size)
+	filter, err := pipelineRunsFilterFromQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
 	}
 
+	pipelineRuns, count, err := prc.App.JobORM().FilterPipelineRuns(filter, offset, size)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -61,6 +60,77 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, res, count, err)
 }
 
+// Stats returns error counts and latency percentiles for each task of the
+// pipeline runs matching the same query parameters as Index. Without
+// createdAfter, only the runs of the last day are aggregated.
+// Example:
+// "GET <application>/pipeline/runs/stats?jobID=1&createdAfter=2022-04-20T00:00:00Z"
+func (prc *PipelineRunsController) Stats(c *gin.Context) {
+	filter, err := pipelineRunsFilterFromQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	stats, err := prc.App.JobORM().PipelineTaskRunStats(filter)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTaskRunStatsResources(stats), "pipelineTaskRunStats")
+}
+
+func pipelineRunsFilterFromQuery(c *gin.Context) (filter job.PipelineRunsFilter, err error) {
+	id := c.Param("ID")
+	if id == "" {
+		id = c.Query("jobID")
+	}
+	if id != "" {
+		jobSpec := job.Job{}
+		if err = jobSpec.SetID(id); err != nil {
+			return filter, err
+		}
+		filter.JobID = &jobSpec.ID
+	}
+
+	if states := c.Query("state"); states != "" {
+		for _, state := range strings.Split(states, ",") {
+			switch s := pipeline.RunStatus(strings.TrimSpace(state)); s {
+			case pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted:
+				filter.States = append(filter.States, s)
+			default:
+				return filter, errors.Errorf("invalid state %q", state)
+			}
+		}
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"createdAfter":  &filter.CreatedAfter,
+		"createdBefore": &filter.CreatedBefore,
+	} {
+		if v := c.Query(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, errors.Wrapf(err, "invalid %s", param)
+			}
+			*dst = &t
+		}
+	}
+
+	if v := c.Query("minDuration"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid minDuration")
+		}
+		filter.MinDuration = &d
+	}
+
+	filter.FailedTaskDotID = c.Query("failedTask")
+	filter.ErrorContains = c.Query("error")
+	return filter, nil
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -181,3 +251,73 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// ReplayPipelineRunRequest optionally selects the spec a run is replayed
+// against. Either JobID, to use that job's current pipeline spec, or
+// DotDagSource may be set. If neither is, the run's own spec is used.
+type ReplayPipelineRunRequest struct {
+	JobID        *int32 `json:"jobID"`
+	DotDagSource string `json:"dotDagSource"`
+}
+
+// Replay re-executes a stored pipeline run, using the recorded results of its
+// http, bridge and other external tasks, and returns a per-task diff against
+// the original run. Nothing is persisted.
+// Example:
+// "POST <application>/pipeline/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	bodyBytes, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var request ReplayPipelineRunRequest
+	if len(bodyBytes) > 0 {
+		if err = json.Unmarshal(bodyBytes, &request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to unmarshal JSON body"))
+			return
+		}
+	}
+
+	var spec *pipeline.Spec
+	switch {
+	case request.JobID != nil && request.DotDagSource != "":
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("only one of jobID and dotDagSource may be set"))
+		return
+	case request.JobID != nil:
+		jb, err2 := prc.App.JobORM().FindJob(c.Request.Context(), *request.JobID)
+		if errors.Is(err2, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.Errorf("job %d not found", *request.JobID))
+			return
+		} else if err2 != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err2)
+			return
+		}
+		spec = jb.PipelineSpec
+	case request.DotDagSource != "":
+		if _, err = pipeline.Parse(request.DotDagSource); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		spec = &pipeline.Spec{DotDagSource: request.DotDagSource}
+	}
+
+	result, err := prc.App.ReplayPipelineRun(c.Request.Context(), pipelineRun.ID, spec)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("pipeline run %d not found", pipelineRun.ID))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunReplayResource(result, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRunReplay")
+}
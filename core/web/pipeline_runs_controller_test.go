@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -191,6 +192,64 @@ func TestPipelineRunsController_Index_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse[1].TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Index_Filtered(t *testing.T) {
+	client, jobID, _ := setupPipelineRunsControllerTests(t)
+
+	tests := []struct {
+		name      string
+		query     string
+		wantCount int
+	}{
+		{"failed task and error", fmt.Sprintf("jobID=%d&failedTask=ds3&error=UH+OH", jobID), 2},
+		{"task that did not fail", "failedTask=ds1", 0},
+		{"state", "state=completed,errored", 2},
+		{"time range", "createdBefore=2000-01-01T00:00:00Z", 0},
+		{"duration", "minDuration=1h", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, cleanup := client.Get("/v2/pipeline/runs?" + test.query)
+			defer cleanup()
+			cltest.AssertServerResponse(t, response, http.StatusOK)
+
+			var parsedResponse []presenters.PipelineRunResource
+			err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+			require.NoError(t, err)
+			assert.Len(t, parsedResponse, test.wantCount)
+		})
+	}
+
+	for _, query := range []string{"state=done", "createdAfter=yesterday", "minDuration=5"} {
+		response, cleanup := client.Get("/v2/pipeline/runs?" + query)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+		cleanup()
+	}
+}
+
+func TestPipelineRunsController_Stats(t *testing.T) {
+	client, jobID, _ := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/pipeline/runs/stats?jobID=%d", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse []presenters.PipelineTaskRunStatsResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+
+	require.Len(t, parsedResponse, 8)
+	for _, stats := range parsedResponse {
+		assert.Equal(t, jobID, stats.JobID)
+		assert.Equal(t, int64(2), stats.Count, stats.DotID)
+		if stats.DotID == "ds3" {
+			assert.Equal(t, int64(2), stats.Errors)
+		} else {
+			assert.Equal(t, int64(0), stats.Errors, stats.DotID)
+		}
+	}
+}
+
 func TestPipelineRunsController_Index_Pagination(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -243,6 +302,73 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Replay(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	url := fmt.Sprintf("/v2/pipeline/runs/%v/replay", runIDs[0])
+
+	t.Run("against the original spec", func(t *testing.T) {
+		response, cleanup := client.Post(url, nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunReplayResource
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+
+		assert.Equal(t, strconv.Itoa(int(runIDs[0])), parsedResponse.ID)
+		assert.False(t, parsedResponse.Changed)
+		require.Len(t, parsedResponse.Diffs, 8)
+	})
+
+	t.Run("against the job's current spec", func(t *testing.T) {
+		body, err := json.Marshal(web.ReplayPipelineRunRequest{JobID: &jobID})
+		require.NoError(t, err)
+		response, cleanup := client.Post(url, bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+	})
+
+	t.Run("against a modified pipeline", func(t *testing.T) {
+		body, err := json.Marshal(web.ReplayPipelineRunRequest{DotDagSource: `
+		ds1          [type=memo value=<"{\"USD\": 1}">];
+		ds1_parse    [type=jsonparse path="USD"];
+		ds1_multiply [type=multiply times=4];
+		ds1 -> ds1_parse -> ds1_multiply;
+		`})
+		require.NoError(t, err)
+		response, cleanup := client.Post(url, bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunReplayResource
+		err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+
+		assert.True(t, parsedResponse.Changed)
+		changed := map[string]bool{}
+		for _, diff := range parsedResponse.Diffs {
+			changed[diff.DotID] = diff.Changed
+		}
+		assert.False(t, changed["ds1_parse"])
+		assert.True(t, changed["ds1_multiply"])
+		assert.True(t, changed["answer"])
+	})
+
+	t.Run("with an invalid pipeline", func(t *testing.T) {
+		body, err := json.Marshal(web.ReplayPipelineRunRequest{DotDagSource: "a -> "})
+		require.NoError(t, err)
+		response, cleanup := client.Post(url, bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("with a missing run", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/pipeline/runs/999999/replay", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
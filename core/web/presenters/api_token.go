@@ -0,0 +1,64 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// APITokenResource represents a named API token JSONAPI resource.
+type APITokenResource struct {
+	JAID
+	Name      string `json:"name"`
+	AccessKey string `json:"accessKey"`
+	// The Secret is only provided when creating a token
+	Secret     string    `json:"secret,omitempty"`
+	Scopes     []string  `json:"scopes"`
+	ExpiresAt  null.Time `json:"expiresAt"`
+	LastUsedAt null.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource. The token is
+// identified by its name, which is unique per user.
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	scopes := []string(t.Scopes)
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &APITokenResource{
+		JAID:       NewJAID(t.Name),
+		Name:       t.Name,
+		AccessKey:  t.AccessKey,
+		Scopes:     scopes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// NewCreatedAPITokenResource constructs a new APITokenResource including the
+// secret of a token which was just created.
+func NewCreatedAPITokenResource(t sessions.APIToken, token *auth.Token) *APITokenResource {
+	r := NewAPITokenResource(t)
+	r.Secret = token.Secret
+	return r
+}
+
+// NewAPITokenResources initializes a slice of JSONAPI API token resources
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+
+	return rs
+}
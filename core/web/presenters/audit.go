@@ -0,0 +1,54 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+// AuditEntryResource represents an audit log entry JSONAPI resource.
+type AuditEntryResource struct {
+	JAID
+	UserEmail         null.String  `json:"userEmail"`
+	APITokenKey       null.String  `json:"apiTokenKey"`
+	ExternalInitiator null.String  `json:"externalInitiator"`
+	SourceIP          string       `json:"sourceIP"`
+	Action            string       `json:"action"`
+	Resource          string       `json:"resource"`
+	Params            audit.Params `json:"params"`
+	StatusCode        int          `json:"statusCode"`
+	CreatedAt         time.Time    `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditEntryResource) GetName() string {
+	return "auditEntries"
+}
+
+// NewAuditEntryResource constructs a new AuditEntryResource
+func NewAuditEntryResource(e audit.Entry) AuditEntryResource {
+	return AuditEntryResource{
+		JAID:              NewJAIDInt64(e.ID),
+		UserEmail:         e.UserEmail,
+		APITokenKey:       e.APITokenKey,
+		ExternalInitiator: e.ExternalInitiator,
+		SourceIP:          e.SourceIP,
+		Action:            e.Action,
+		Resource:          e.Resource,
+		Params:            e.Params,
+		StatusCode:        e.StatusCode,
+		CreatedAt:         e.CreatedAt,
+	}
+}
+
+// NewAuditEntryResources constructs a slice of AuditEntryResources
+func NewAuditEntryResources(entries []audit.Entry) []AuditEntryResource {
+	rs := []AuditEntryResource{}
+	for _, e := range entries {
+		rs = append(rs, NewAuditEntryResource(e))
+	}
+
+	return rs
+}
@@ -0,0 +1,27 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/bundle"
+)
+
+// BundleImportResource represents the outcome of importing each item of a
+// bundle.
+type BundleImportResource struct {
+	JAID
+	bundle.ImportResult
+	Failed int `json:"failed"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BundleImportResource) GetName() string {
+	return "bundleImport"
+}
+
+// NewBundleImportResource constructs a BundleImportResource.
+func NewBundleImportResource(result bundle.ImportResult) BundleImportResource {
+	return BundleImportResource{
+		JAID:         NewJAID("import"),
+		ImportResult: result,
+		Failed:       result.Failed(),
+	}
+}
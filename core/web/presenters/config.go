@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ConfigProvenanceResource represents the effective settings of the node, or
+// of one of its EVM chains, and where each came from.
+type ConfigProvenanceResource struct {
+	JAID
+	EVMChainID *utils.Big          `json:"evmChainID"`
+	Settings   []config.Provenance `json:"settings"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ConfigProvenanceResource) GetName() string {
+	return "configProvenance"
+}
+
+// NewGlobalConfigProvenanceResource constructs the ConfigProvenanceResource
+// of the global settings.
+func NewGlobalConfigProvenanceResource(settings []config.Provenance) ConfigProvenanceResource {
+	return ConfigProvenanceResource{
+		JAID:     NewJAID("global"),
+		Settings: settings,
+	}
+}
+
+// NewEVMChainConfigProvenanceResource constructs the ConfigProvenanceResource
+// of the settings of an EVM chain.
+func NewEVMChainConfigProvenanceResource(chainID utils.Big, settings []config.Provenance) ConfigProvenanceResource {
+	return ConfigProvenanceResource{
+		JAID:       NewJAID("evm/" + chainID.String()),
+		EVMChainID: &chainID,
+		Settings:   settings,
+	}
+}
+
+// ConfigReloadResource represents the settings changed by reloading the
+// config.
+type ConfigReloadResource struct {
+	JAID
+	config.ReloadResult
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ConfigReloadResource) GetName() string {
+	return "configReload"
+}
+
+// NewConfigReloadResource constructs a ConfigReloadResource.
+func NewConfigReloadResource(result config.ReloadResult) ConfigReloadResource {
+	return ConfigReloadResource{
+		JAID:         NewJAID("reload"),
+		ReloadResult: result,
+	}
+}
@@ -0,0 +1,31 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/services/diagnostics"
+)
+
+// DiagnosticResource represents the result of a single diagnostic check.
+type DiagnosticResource struct {
+	JAID
+	diagnostics.Result
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DiagnosticResource) GetName() string {
+	return "diagnostics"
+}
+
+// NewDiagnosticResources constructs a DiagnosticResource for each result, in
+// order.
+func NewDiagnosticResources(results []diagnostics.Result) []DiagnosticResource {
+	rs := []DiagnosticResource{}
+	for i, result := range results {
+		rs = append(rs, DiagnosticResource{
+			JAID:   NewJAID(strconv.Itoa(i)),
+			Result: result,
+		})
+	}
+	return rs
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -17,6 +18,7 @@ type ETHKeyResource struct {
 	EthBalance     *assets.Eth  `json:"ethBalance"`
 	LinkBalance    *assets.Link `json:"linkBalance"`
 	IsFunding      bool         `json:"isFunding"`
+	IsRemote       bool         `json:"isRemote"`
 	CreatedAt      time.Time    `json:"createdAt"`
 	UpdatedAt      time.Time    `json:"updatedAt"`
 	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
@@ -45,6 +47,7 @@ func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOpti
 		EthBalance:  nil,
 		LinkBalance: nil,
 		IsFunding:   state.IsFunding,
+		IsRemote:    state.IsRemote,
 		CreatedAt:   state.CreatedAt,
 		UpdatedAt:   state.UpdatedAt,
 	}
@@ -83,3 +86,64 @@ func SetETHKeyMaxGasPriceWei(maxGasPriceWei utils.Big) NewETHKeyOption {
 		return nil
 	}
 }
+
+// ETHHDWalletResource represents the HD wallet from which new ETH keys are
+// derived. The mnemonic is only set when the HD wallet is created with a new
+// mnemonic.
+type ETHHDWalletResource struct {
+	JAID
+	Enabled   bool   `json:"enabled"`
+	NextIndex uint32 `json:"nextIndex"`
+	Mnemonic  string `json:"mnemonic,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHHDWalletResource) GetName() string {
+	return "ethHDWallets"
+}
+
+// NewETHHDWalletResource constructs a new ETHHDWalletResource
+func NewETHHDWalletResource(enabled bool, nextIndex uint32, mnemonic string) *ETHHDWalletResource {
+	return &ETHHDWalletResource{
+		JAID:      NewJAID("eth"),
+		Enabled:   enabled,
+		NextIndex: nextIndex,
+		Mnemonic:  mnemonic,
+	}
+}
+
+// ETHKeyPolicyResource represents the policy which limits the transactions
+// of an ETH key. Nil limits are unlimited, and empty allowed destinations
+// allow any destination.
+type ETHKeyPolicyResource struct {
+	JAID
+	Address             string      `json:"address"`
+	MaxTxValue          *assets.Eth `json:"maxTxValue"`
+	MaxDailyGasSpend    *assets.Eth `json:"maxDailyGasSpend"`
+	AllowedDestinations []string    `json:"allowedDestinations"`
+	CreatedAt           time.Time   `json:"createdAt"`
+	UpdatedAt           time.Time   `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHKeyPolicyResource) GetName() string {
+	return "ethKeyPolicies"
+}
+
+// NewETHKeyPolicyResource constructs a new ETHKeyPolicyResource
+func NewETHKeyPolicyResource(policy txmgr.KeyPolicy) *ETHKeyPolicyResource {
+	destinations := []string{}
+	for _, a := range policy.AllowedDestinations {
+		destinations = append(destinations, a.Hex())
+	}
+	address := ethkey.EIP55AddressFromAddress(policy.Address).Hex()
+	return &ETHKeyPolicyResource{
+		JAID:                NewJAID(address),
+		Address:             address,
+		MaxTxValue:          policy.MaxTxValue,
+		MaxDailyGasSpend:    policy.MaxDailyGasSpend,
+		AllowedDestinations: destinations,
+		CreatedAt:           policy.CreatedAt,
+		UpdatedAt:           policy.UpdatedAt,
+	}
+}
@@ -61,6 +61,7 @@ func TestETHKeyResource(t *testing.T) {
 			  "ethBalance":"1",
 			  "linkBalance":"1",
 			  "isFunding":true,
+			  "isRemote":false,
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
 			  "maxGasPriceWei":"12345"
@@ -91,6 +92,7 @@ func TestETHKeyResource(t *testing.T) {
 				"ethBalance":"1",
 				"linkBalance":"1",
 				"isFunding":true,
+				"isRemote":false,
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
 				"maxGasPriceWei":"12345"
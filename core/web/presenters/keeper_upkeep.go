@@ -0,0 +1,158 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeeperUpkeepResource is an upkeep of a keeper job, with the stats of its
+// checks and performs by this node.
+type KeeperUpkeepResource struct {
+	JAID
+	JobID              int32               `json:"jobID"`
+	JobName            string              `json:"jobName"`
+	EVMChainID         *utils.Big          `json:"evmChainID"`
+	RegistryAddress    ethkey.EIP55Address `json:"registryAddress"`
+	UpkeepID           utils.Big           `json:"upkeepID"`
+	ExecuteGas         uint64              `json:"executeGas"`
+	LastRunBlockHeight int64               `json:"lastRunBlockHeight"`
+	ChecksCount        int64               `json:"checksCount"`
+	EligibleCount      int64               `json:"eligibleCount"`
+	PerformsCount      int64               `json:"performsCount"`
+	PerformsSucceeded  int64               `json:"performsSucceededCount"`
+	SuccessRate        float64             `json:"successRate"`
+	AverageGasUsed     int64               `json:"averageGasUsed"`
+	LinkPayment        *assets.Link        `json:"linkPayment"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperUpkeepResource) GetName() string {
+	return "keeper_upkeeps"
+}
+
+// NewKeeperUpkeepResource returns a new KeeperUpkeepResource for upkeep.
+func NewKeeperUpkeepResource(upkeep job.KeeperUpkeep) KeeperUpkeepResource {
+	return KeeperUpkeepResource{
+		JAID:               NewJAIDInt32(upkeep.ID),
+		JobID:              upkeep.JobID,
+		JobName:            upkeep.JobName.ValueOrZero(),
+		EVMChainID:         upkeep.EVMChainID,
+		RegistryAddress:    upkeep.RegistryAddress,
+		UpkeepID:           upkeep.UpkeepID,
+		ExecuteGas:         upkeep.ExecuteGas,
+		LastRunBlockHeight: upkeep.LastRunBlockHeight,
+		ChecksCount:        upkeep.ChecksCount,
+		EligibleCount:      upkeep.EligibleCount,
+		PerformsCount:      upkeep.PerformsCount,
+		PerformsSucceeded:  upkeep.PerformsSucceededCount,
+		SuccessRate:        upkeep.SuccessRate(),
+		AverageGasUsed:     upkeep.AverageGasUsed(),
+		LinkPayment:        &upkeep.LinkPayment,
+	}
+}
+
+// NewKeeperUpkeepResources returns a slice of KeeperUpkeepResources for upkeeps.
+func NewKeeperUpkeepResources(upkeeps []job.KeeperUpkeep) []KeeperUpkeepResource {
+	rs := []KeeperUpkeepResource{}
+	for _, upkeep := range upkeeps {
+		rs = append(rs, NewKeeperUpkeepResource(upkeep))
+	}
+	return rs
+}
+
+// KeeperUpkeepSimulationResource is the outcome of the turn taking and the
+// checks with which a keeper job decides whether to perform an upkeep.
+type KeeperUpkeepSimulationResource struct {
+	JAID
+	JobID           int32               `json:"jobID"`
+	RegistryAddress ethkey.EIP55Address `json:"registryAddress"`
+	BlockNumber     int64               `json:"blockNumber"`
+
+	TurnFlagEnabled     bool         `json:"turnFlagEnabled"`
+	KeeperIndex         int32        `json:"keeperIndex"`
+	NumKeepers          int32        `json:"numKeepers"`
+	BlockCountPerTurn   int32        `json:"blockCountPerTurn"`
+	PositioningConstant int32        `json:"positioningConstant"`
+	TurnBlockNumber     int64        `json:"turnBlockNumber"`
+	TurnLookBack        int64        `json:"turnLookBack"`
+	TurnBlockHash       *common.Hash `json:"turnBlockHash"`
+	TurnKeeperIndex     null.Int64   `json:"turnKeeperIndex"`
+	LastRunBlockHeight  int64        `json:"lastRunBlockHeight"`
+	LastKeeperIndex     null.Int64   `json:"lastKeeperIndex"`
+	GracePeriod         int64        `json:"gracePeriod"`
+	Eligible            bool         `json:"eligible"`
+	EligibilityReason   string       `json:"eligibilityReason"`
+
+	GasPrice           *utils.Big `json:"gasPrice"`
+	GasTipCap          *utils.Big `json:"gasTipCap"`
+	GasFeeCap          *utils.Big `json:"gasFeeCap"`
+	GasEstimationError string     `json:"gasEstimationError,omitempty"`
+
+	CheckUpkeepGasLimit     uint64        `json:"checkUpkeepGasLimit"`
+	CheckUpkeepSuccess      bool          `json:"checkUpkeepSuccess"`
+	CheckUpkeepError        string        `json:"checkUpkeepError,omitempty"`
+	CheckUpkeepRevertReason string        `json:"checkUpkeepRevertReason,omitempty"`
+	PerformData             hexutil.Bytes `json:"performData"`
+	MaxLinkPayment          *assets.Link  `json:"maxLinkPayment"`
+
+	PerformUpkeepGasLimit         uint64  `json:"performUpkeepGasLimit"`
+	PerformUpkeepGasEstimate      *uint64 `json:"performUpkeepGasEstimate"`
+	PerformUpkeepGasEstimateError string  `json:"performUpkeepGasEstimateError,omitempty"`
+
+	FromAddress        ethkey.EIP55Address `json:"fromAddress"`
+	FromAddressBalance *assets.Eth         `json:"fromAddressBalance"`
+	FromAddressError   string              `json:"fromAddressError,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperUpkeepSimulationResource) GetName() string {
+	return "keeper_upkeep_simulations"
+}
+
+// NewKeeperUpkeepSimulationResource returns a new KeeperUpkeepSimulationResource
+// for sim, identified by the ID of its upkeep.
+func NewKeeperUpkeepSimulationResource(sim keeper.UpkeepSimulation) KeeperUpkeepSimulationResource {
+	return KeeperUpkeepSimulationResource{
+		JAID:                          NewJAID(sim.UpkeepID.String()),
+		JobID:                         sim.JobID,
+		RegistryAddress:               sim.RegistryAddress,
+		BlockNumber:                   sim.BlockNumber,
+		TurnFlagEnabled:               sim.TurnFlagEnabled,
+		KeeperIndex:                   sim.KeeperIndex,
+		NumKeepers:                    sim.NumKeepers,
+		BlockCountPerTurn:             sim.BlockCountPerTurn,
+		PositioningConstant:           sim.PositioningConstant,
+		TurnBlockNumber:               sim.TurnBlockNumber,
+		TurnLookBack:                  sim.TurnLookBack,
+		TurnBlockHash:                 sim.TurnBlockHash,
+		TurnKeeperIndex:               sim.TurnKeeperIndex,
+		LastRunBlockHeight:            sim.LastRunBlockHeight,
+		LastKeeperIndex:               sim.LastKeeperIndex,
+		GracePeriod:                   sim.GracePeriod,
+		Eligible:                      sim.Eligible,
+		EligibilityReason:             sim.EligibilityReason,
+		GasPrice:                      (*utils.Big)(sim.GasPrice),
+		GasTipCap:                     (*utils.Big)(sim.GasTipCap),
+		GasFeeCap:                     (*utils.Big)(sim.GasFeeCap),
+		GasEstimationError:            sim.GasEstimationError,
+		CheckUpkeepGasLimit:           sim.CheckUpkeepGasLimit,
+		CheckUpkeepSuccess:            sim.CheckUpkeepSuccess,
+		CheckUpkeepError:              sim.CheckUpkeepError,
+		CheckUpkeepRevertReason:       sim.CheckUpkeepRevertReason,
+		PerformData:                   sim.PerformData,
+		MaxLinkPayment:                (*assets.Link)(sim.MaxLinkPayment),
+		PerformUpkeepGasLimit:         sim.PerformUpkeepGasLimit,
+		PerformUpkeepGasEstimate:      sim.PerformUpkeepGasEstimate,
+		PerformUpkeepGasEstimateError: sim.PerformUpkeepGasEstimateError,
+		FromAddress:                   sim.FromAddress,
+		FromAddressBalance:            sim.FromAddressBalance,
+		FromAddressError:              sim.FromAddressError,
+	}
+}
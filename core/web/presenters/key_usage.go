@@ -0,0 +1,149 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeyJobResource represents a job which is configured to use a key
+type KeyJobResource struct {
+	ID            int32       `json:"id"`
+	Name          null.String `json:"name"`
+	Type          job.Type    `json:"type"`
+	ExternalJobID string      `json:"externalJobID"`
+}
+
+func newKeyJobResources(bindings []job.KeyBinding) []KeyJobResource {
+	rs := []KeyJobResource{}
+	for _, b := range bindings {
+		rs = append(rs, KeyJobResource{
+			ID:            b.JobID,
+			Name:          b.JobName,
+			Type:          b.JobType,
+			ExternalJobID: b.ExternalJobID.String(),
+		})
+	}
+	return rs
+}
+
+// KeyUsageResource represents the jobs which use an OCR or P2P key
+type KeyUsageResource struct {
+	JAID
+	Jobs []KeyJobResource `json:"jobs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyUsageResource) GetName() string {
+	return "keyUsages"
+}
+
+// NewKeyUsageResource constructs a new KeyUsageResource
+func NewKeyUsageResource(keyID string, bindings []job.KeyBinding) *KeyUsageResource {
+	return &KeyUsageResource{
+		JAID: NewJAID(keyID),
+		Jobs: newKeyJobResources(bindings),
+	}
+}
+
+// ETHKeyTxResource represents a transaction signed by an ETH key. The gas
+// used, effective gas price and fee are null until it has a receipt.
+type ETHKeyTxResource struct {
+	ID                int64       `json:"id"`
+	Hash              string      `json:"hash"`
+	Nonce             *int64      `json:"nonce"`
+	To                string      `json:"to"`
+	Value             assets.Eth  `json:"value"`
+	State             string      `json:"state"`
+	EVMChainID        utils.Big   `json:"evmChainID"`
+	JobID             *int32      `json:"jobID"`
+	JobName           null.String `json:"jobName"`
+	Subject           null.String `json:"subject"`
+	BroadcastAt       *time.Time  `json:"broadcastAt"`
+	GasUsed           *uint64     `json:"gasUsed"`
+	EffectiveGasPrice *utils.Big  `json:"effectiveGasPrice"`
+	Fee               *assets.Eth `json:"fee"`
+	FeeEstimated      bool        `json:"feeEstimated"`
+}
+
+// ETHKeySpendResource totals the transactions of an ETH key created by one
+// job, or by no job if the job ID is null
+type ETHKeySpendResource struct {
+	JobID   *int32      `json:"jobID"`
+	JobName null.String `json:"jobName"`
+	TxCount int         `json:"txCount"`
+	GasUsed uint64      `json:"gasUsed"`
+	Fee     assets.Eth  `json:"fee"`
+}
+
+// ETHKeyUsageResource represents the jobs which use an ETH key, and the
+// transactions it broadcast over a period
+type ETHKeyUsageResource struct {
+	JAID
+	Address      string                `json:"address"`
+	Since        time.Time             `json:"since"`
+	Until        time.Time             `json:"until"`
+	Jobs         []KeyJobResource      `json:"jobs"`
+	Transactions []ETHKeyTxResource    `json:"transactions"`
+	Spend        []ETHKeySpendResource `json:"spend"`
+	TotalFee     assets.Eth            `json:"totalFee"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHKeyUsageResource) GetName() string {
+	return "ethKeyUsages"
+}
+
+// NewETHKeyUsageResource constructs a new ETHKeyUsageResource
+func NewETHKeyUsageResource(usage job.EthKeyUsage) *ETHKeyUsageResource {
+	address := ethkey.EIP55AddressFromAddress(usage.Address).Hex()
+	r := &ETHKeyUsageResource{
+		JAID:         NewJAID(address),
+		Address:      address,
+		Since:        usage.Since,
+		Until:        usage.Until,
+		Jobs:         newKeyJobResources(usage.Jobs),
+		Transactions: []ETHKeyTxResource{},
+		Spend:        []ETHKeySpendResource{},
+		TotalFee:     assets.NewEthValue(0),
+	}
+	for _, tx := range usage.Transactions {
+		var subject null.String
+		if tx.Subject.Valid {
+			subject = null.StringFrom(tx.Subject.UUID.String())
+		}
+		r.Transactions = append(r.Transactions, ETHKeyTxResource{
+			ID:                tx.EthTxID,
+			Hash:              tx.Hash.Hex(),
+			Nonce:             tx.Nonce,
+			To:                tx.ToAddress.Hex(),
+			Value:             tx.Value,
+			State:             tx.State,
+			EVMChainID:        tx.EVMChainID,
+			JobID:             tx.JobID,
+			JobName:           tx.JobName,
+			Subject:           subject,
+			BroadcastAt:       tx.BroadcastAt,
+			GasUsed:           tx.GasUsed,
+			EffectiveGasPrice: tx.EffectiveGasPrice,
+			Fee:               tx.Fee,
+			FeeEstimated:      tx.FeeEstimated,
+		})
+	}
+	for _, s := range usage.Spend {
+		r.Spend = append(r.Spend, ETHKeySpendResource{
+			JobID:   s.JobID,
+			JobName: s.JobName,
+			TxCount: s.TxCount,
+			GasUsed: s.GasUsed,
+			Fee:     s.Fee,
+		})
+		r.TotalFee.ToInt().Add(r.TotalFee.ToInt(), s.Fee.ToInt())
+	}
+	return r
+}
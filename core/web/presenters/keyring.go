@@ -0,0 +1,27 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// KeyringRestoreResource lists the IDs of the keys of each type which were
+// restored from a backup, or skipped because the node already has them.
+type KeyringRestoreResource struct {
+	JAID
+	Restored map[string][]string `json:"restored"`
+	Skipped  map[string][]string `json:"skipped"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyringRestoreResource) GetName() string {
+	return "keyringRestores"
+}
+
+// NewKeyringRestoreResource constructs a KeyringRestoreResource.
+func NewKeyringRestoreResource(result keystore.RestoreResult) KeyringRestoreResource {
+	return KeyringRestoreResource{
+		JAID:     NewJAID("restore"),
+		Restored: result.Restored,
+		Skipped:  result.Skipped,
+	}
+}
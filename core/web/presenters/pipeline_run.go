@@ -1,9 +1,11 @@
 package presenters
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
@@ -101,3 +103,102 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource represents the result of replaying a pipeline run.
+// Its ID is the ID of the original run.
+type PipelineRunReplayResource struct {
+	JAID
+	Changed  bool                          `json:"changed"`
+	Original PipelineRunResource           `json:"original"`
+	Replayed PipelineRunResource           `json:"replayed"`
+	Diffs    []PipelineTaskRunDiffResource `json:"diffs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+// PipelineTaskRunDiffResource compares a task's result in the original run
+// with its result in the replay.
+type PipelineTaskRunDiffResource struct {
+	DotID    string                   `json:"dotId"`
+	Type     pipeline.TaskType        `json:"type"`
+	Recorded bool                     `json:"recorded"`
+	Changed  bool                     `json:"changed"`
+	Original *PipelineTaskRunResource `json:"original"`
+	Replayed *PipelineTaskRunResource `json:"replayed"`
+}
+
+func NewPipelineRunReplayResource(rr pipeline.ReplayResult, lggr logger.Logger) PipelineRunReplayResource {
+	diffs := []PipelineTaskRunDiffResource{}
+	for _, diff := range rr.Diffs {
+		d := PipelineTaskRunDiffResource{
+			DotID:    diff.DotID,
+			Type:     diff.Type,
+			Recorded: diff.Recorded,
+			Changed:  diff.Changed,
+		}
+		if diff.Original != nil {
+			tr := NewPipelineTaskRunResource(*diff.Original)
+			d.Original = &tr
+		}
+		if diff.Replayed != nil {
+			tr := NewPipelineTaskRunResource(*diff.Replayed)
+			d.Replayed = &tr
+		}
+		diffs = append(diffs, d)
+	}
+
+	return PipelineRunReplayResource{
+		JAID:     NewJAIDInt64(rr.Original.ID),
+		Changed:  rr.Changed(),
+		Original: NewPipelineRunResource(rr.Original, lggr),
+		Replayed: NewPipelineRunResource(rr.Replayed, lggr),
+		Diffs:    diffs,
+	}
+}
+
+// PipelineTaskRunStatsResource represents the aggregated results of one task
+// of a job. Latencies are in milliseconds.
+type PipelineTaskRunStatsResource struct {
+	JAID
+	JobID      int32             `json:"jobID"`
+	DotID      string            `json:"dotId"`
+	Type       pipeline.TaskType `json:"type"`
+	Count      int64             `json:"count"`
+	Errors     int64             `json:"errors"`
+	LatencyP50 float64           `json:"latencyP50"`
+	LatencyP90 float64           `json:"latencyP90"`
+	LatencyP99 float64           `json:"latencyP99"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineTaskRunStatsResource) GetName() string {
+	return "pipelineTaskRunStats"
+}
+
+func NewPipelineTaskRunStatsResource(s job.PipelineTaskRunStats) PipelineTaskRunStatsResource {
+	ms := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+	return PipelineTaskRunStatsResource{
+		JAID:       NewJAID(fmt.Sprintf("%d-%s", s.JobID, s.DotID)),
+		JobID:      s.JobID,
+		DotID:      s.DotID,
+		Type:       s.Type,
+		Count:      s.Count,
+		Errors:     s.Errors,
+		LatencyP50: ms(s.LatencyP50),
+		LatencyP90: ms(s.LatencyP90),
+		LatencyP99: ms(s.LatencyP99),
+	}
+}
+
+func NewPipelineTaskRunStatsResources(stats []job.PipelineTaskRunStats) []PipelineTaskRunStatsResource {
+	out := []PipelineTaskRunStatsResource{}
+	for _, s := range stats {
+		out = append(out, NewPipelineTaskRunStatsResource(s))
+	}
+	return out
+}
@@ -17,6 +17,7 @@ func TestUserResource(t *testing.T) {
 
 	user := sessions.User{
 		Email:     "notreal@fakeemail.ch",
+		Role:      sessions.UserRoleView,
 		CreatedAt: ts,
 	}
 
@@ -32,6 +33,8 @@ func TestUserResource(t *testing.T) {
 		   "id": "notreal@fakeemail.ch",
 		   "attributes": {
 			  "email": "notreal@fakeemail.ch",
+			  "role": "view",
+			  "hasActiveApiToken": false,
 			  "createdAt": "2000-01-01T00:00:00Z"
 		   }
 		}
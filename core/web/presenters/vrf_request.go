@@ -0,0 +1,260 @@
+package presenters
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// VRFRequestV2Resource is a VRF v2 request received by a job, along with the
+// state of its processing.
+type VRFRequestV2Resource struct {
+	JAID
+	JobID                   int32            `json:"jobID"`
+	EVMChainID              utils.Big        `json:"evmChainID"`
+	RequestID               utils.Big        `json:"requestID"`
+	SubID                   uint64           `json:"subID"`
+	Sender                  common.Address   `json:"sender"`
+	CallbackGasLimit        uint32           `json:"callbackGasLimit"`
+	NumWords                uint32           `json:"numWords"`
+	MinRequestConfirmations uint16           `json:"minRequestConfirmations"`
+	RequestTxHash           common.Hash      `json:"requestTxHash"`
+	RequestBlockNumber      uint64           `json:"requestBlockNumber"`
+	ConfirmedAtBlock        uint64           `json:"confirmedAtBlock"`
+	State                   vrf.RequestState `json:"state"`
+	Error                   *string          `json:"error"`
+	Attempts                int64            `json:"attempts"`
+	LastTryAt               *time.Time       `json:"lastTryAt"`
+	EthTxID                 *int64           `json:"ethTxID"`
+	CreatedAt               time.Time        `json:"createdAt"`
+	UpdatedAt               time.Time        `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestV2Resource) GetName() string {
+	return "vrf_requests"
+}
+
+// NewVRFRequestV2Resource returns a new VRFRequestV2Resource for req.
+func NewVRFRequestV2Resource(req vrf.RequestV2) VRFRequestV2Resource {
+	return VRFRequestV2Resource{
+		JAID:                    NewJAIDInt64(req.ID),
+		JobID:                   req.JobID,
+		EVMChainID:              req.EVMChainID,
+		RequestID:               req.RequestID,
+		SubID:                   req.SubID,
+		Sender:                  req.Sender,
+		CallbackGasLimit:        req.CallbackGasLimit,
+		NumWords:                req.NumWords,
+		MinRequestConfirmations: req.MinRequestConfirmations,
+		RequestTxHash:           req.RequestTxHash,
+		RequestBlockNumber:      req.RequestBlockNumber,
+		ConfirmedAtBlock:        req.ConfirmedAtBlock,
+		State:                   req.State,
+		Error:                   req.Error,
+		Attempts:                req.Attempts,
+		LastTryAt:               req.LastTryAt,
+		EthTxID:                 req.EthTxID,
+		CreatedAt:               req.CreatedAt,
+		UpdatedAt:               req.UpdatedAt,
+	}
+}
+
+// NewVRFRequestV2Resources returns a slice of VRFRequestV2Resources for reqs.
+func NewVRFRequestV2Resources(reqs []vrf.RequestV2) []VRFRequestV2Resource {
+	rs := []VRFRequestV2Resource{}
+	for _, req := range reqs {
+		rs = append(rs, NewVRFRequestV2Resource(req))
+	}
+	return rs
+}
+
+// VRFFulfillment is a transaction sent by the node to fulfill VRF requests,
+// with the fee it paid once confirmed.
+type VRFFulfillment struct {
+	EthTxID           int64          `json:"ethTxID"`
+	State             string         `json:"state"`
+	FromAddress       common.Address `json:"fromAddress"`
+	Hash              *common.Hash   `json:"hash"`
+	Error             *string        `json:"error"`
+	BroadcastAt       *time.Time     `json:"broadcastAt"`
+	MaxLink           *assets.Link   `json:"maxLink"`
+	BlockNumber       *int64         `json:"blockNumber"`
+	GasUsed           *uint64        `json:"gasUsed"`
+	EffectiveGasPrice *utils.Big     `json:"effectiveGasPrice"`
+	Fee               *assets.Eth    `json:"fee"`
+}
+
+// NewVRFFulfillments returns the VRFFulfillments for fulfillments.
+func NewVRFFulfillments(fulfillments []vrf.Fulfillment) []VRFFulfillment {
+	fs := []VRFFulfillment{}
+	for _, f := range fulfillments {
+		fs = append(fs, VRFFulfillment{
+			EthTxID:           f.EthTxID,
+			State:             f.State,
+			FromAddress:       f.FromAddress,
+			Hash:              f.Hash,
+			Error:             f.Error,
+			BroadcastAt:       f.BroadcastAt,
+			MaxLink:           (*assets.Link)(f.MaxLink),
+			BlockNumber:       f.BlockNumber,
+			GasUsed:           f.GasUsed,
+			EffectiveGasPrice: f.EffectiveGasPrice,
+			Fee:               f.Fee,
+		})
+	}
+	return fs
+}
+
+// VRFSubscriptionBalance is the check of the balance of a subscription against
+// the LINK reserved for its pending fulfillments.
+type VRFSubscriptionBalance struct {
+	Balance           *assets.Link `json:"balance"`
+	ReservedLink      *assets.Link `json:"reservedLink"`
+	AvailableLink     *assets.Link `json:"availableLink"`
+	EstimatedFeeJuels *assets.Link `json:"estimatedFeeJuels,omitempty"`
+	Sufficient        *bool        `json:"sufficient,omitempty"`
+	Error             *string      `json:"error"`
+}
+
+// NewVRFSubscriptionBalance returns a new VRFSubscriptionBalance for balance.
+func NewVRFSubscriptionBalance(balance vrf.SubscriptionBalance) VRFSubscriptionBalance {
+	return VRFSubscriptionBalance{
+		Balance:           (*assets.Link)(balance.Balance),
+		ReservedLink:      (*assets.Link)(balance.ReservedLink),
+		AvailableLink:     (*assets.Link)(balance.AvailableLink),
+		EstimatedFeeJuels: (*assets.Link)(balance.EstimatedFeeJuels),
+		Sufficient:        balance.Sufficient,
+		Error:             balance.Error,
+	}
+}
+
+// VRFRequestLookupResource is what the node knows of a VRF v1 or v2 request,
+// to tell why it was or was not fulfilled.
+type VRFRequestLookupResource struct {
+	JAID
+	Version       int         `json:"version"`
+	JobID         int32       `json:"jobID"`
+	JobName       string      `json:"jobName"`
+	RequestID     string      `json:"requestID"`
+	RequestTxHash common.Hash `json:"requestTxHash"`
+	Status        string      `json:"status"`
+	Error         *string     `json:"error"`
+
+	// Only set for v2 requests
+	EVMChainID              *utils.Big              `json:"evmChainID,omitempty"`
+	SubID                   *uint64                 `json:"subID,omitempty"`
+	CallbackGasLimit        *uint32                 `json:"callbackGasLimit,omitempty"`
+	MinRequestConfirmations *uint16                 `json:"minRequestConfirmations,omitempty"`
+	RequestBlockNumber      *uint64                 `json:"requestBlockNumber,omitempty"`
+	ConfirmedAtBlock        *uint64                 `json:"confirmedAtBlock,omitempty"`
+	LatestBlockNumber       *int64                  `json:"latestBlockNumber,omitempty"`
+	Confirmations           *int64                  `json:"confirmations,omitempty"`
+	Attempts                *int64                  `json:"attempts,omitempty"`
+	LastTryAt               *time.Time              `json:"lastTryAt,omitempty"`
+	Balance                 *VRFSubscriptionBalance `json:"balance,omitempty"`
+
+	// Only set for v1 requests
+	PipelineRunID *int64 `json:"pipelineRunID,omitempty"`
+
+	Fulfillments []VRFFulfillment `json:"fulfillments"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestLookupResource) GetName() string {
+	return "vrf_request_lookups"
+}
+
+// NewVRFRequestLookupResource returns a new VRFRequestLookupResource for
+// lookup, identified by its job and its request ID, or its pipeline run for a
+// v1 request without a request ID.
+func NewVRFRequestLookupResource(lookup vrf.RequestLookup) VRFRequestLookupResource {
+	key := lookup.RequestID
+	if key == "" && lookup.PipelineRunID != nil {
+		key = fmt.Sprintf("run-%d", *lookup.PipelineRunID)
+	}
+	r := VRFRequestLookupResource{
+		JAID:              NewJAID(fmt.Sprintf("%d-%s", lookup.JobID, key)),
+		Version:           lookup.Version,
+		JobID:             lookup.JobID,
+		JobName:           lookup.JobName.ValueOrZero(),
+		RequestID:         lookup.RequestID,
+		RequestTxHash:     lookup.RequestTxHash,
+		Status:            lookup.Status,
+		Error:             lookup.Error,
+		LatestBlockNumber: lookup.LatestBlockNumber,
+		PipelineRunID:     lookup.PipelineRunID,
+		Fulfillments:      NewVRFFulfillments(lookup.Fulfillments),
+	}
+	if req := lookup.V2; req != nil {
+		r.EVMChainID = &req.EVMChainID
+		r.SubID = &req.SubID
+		r.CallbackGasLimit = &req.CallbackGasLimit
+		r.MinRequestConfirmations = &req.MinRequestConfirmations
+		r.RequestBlockNumber = &req.RequestBlockNumber
+		r.ConfirmedAtBlock = &req.ConfirmedAtBlock
+		r.Attempts = &req.Attempts
+		r.LastTryAt = req.LastTryAt
+		if lookup.LatestBlockNumber != nil {
+			confirmations := *lookup.LatestBlockNumber - int64(req.RequestBlockNumber)
+			if confirmations < 0 {
+				confirmations = 0
+			}
+			r.Confirmations = &confirmations
+		}
+	}
+	if lookup.Balance != nil {
+		balance := NewVRFSubscriptionBalance(*lookup.Balance)
+		r.Balance = &balance
+	}
+	return r
+}
+
+// NewVRFRequestLookupResources returns a slice of VRFRequestLookupResources
+// for lookups.
+func NewVRFRequestLookupResources(lookups []vrf.RequestLookup) []VRFRequestLookupResource {
+	rs := []VRFRequestLookupResource{}
+	for _, lookup := range lookups {
+		rs = append(rs, NewVRFRequestLookupResource(lookup))
+	}
+	return rs
+}
+
+// VRFSubscriptionResource is a VRF v2 subscription as seen by the node: its
+// balance, the LINK reserved for its pending fulfillments and its pending
+// requests.
+type VRFSubscriptionResource struct {
+	JAID
+	EVMChainID         utils.Big       `json:"evmChainID"`
+	SubID              uint64          `json:"subID"`
+	CoordinatorAddress *common.Address `json:"coordinatorAddress"`
+	Owner              *common.Address `json:"owner"`
+	VRFSubscriptionBalance
+	PendingRequests     []VRFRequestV2Resource `json:"pendingRequests"`
+	PendingFulfillments []VRFFulfillment       `json:"pendingFulfillments"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFSubscriptionResource) GetName() string {
+	return "vrf_subscriptions"
+}
+
+// NewVRFSubscriptionResource returns a new VRFSubscriptionResource for sub.
+func NewVRFSubscriptionResource(sub vrf.SubscriptionInsight) VRFSubscriptionResource {
+	return VRFSubscriptionResource{
+		JAID:                   NewJAID(strconv.FormatUint(sub.SubID, 10)),
+		EVMChainID:             sub.EVMChainID,
+		SubID:                  sub.SubID,
+		CoordinatorAddress:     sub.CoordinatorAddress,
+		Owner:                  sub.Owner,
+		VRFSubscriptionBalance: NewVRFSubscriptionBalance(sub.SubscriptionBalance),
+		PendingRequests:        NewVRFRequestV2Resources(sub.PendingRequests),
+		PendingFulfillments:    NewVRFFulfillments(sub.PendingFulfillments),
+	}
+}
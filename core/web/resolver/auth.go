@@ -2,7 +2,9 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
@@ -16,6 +18,30 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
+// Authenticates the user from the session cookie and checks they have at
+// least the edit role.
+func authenticateUserCanEdit(ctx context.Context) error {
+	return authenticateUserRole(ctx, clsessions.UserRoleEdit)
+}
+
+// Authenticates the user from the session cookie and checks they have the
+// admin role.
+func authenticateUserIsAdmin(ctx context.Context) error {
+	return authenticateUserRole(ctx, clsessions.UserRoleAdmin)
+}
+
+func authenticateUserRole(ctx context.Context, role clsessions.UserRole) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	if !session.User.Role.Includes(role) {
+		return forbiddenError{role: role}
+	}
+
+	return nil
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -27,3 +53,17 @@ func (e unauthorizedError) Extensions() map[string]interface{} {
 		"code": "UNAUTHORIZED",
 	}
 }
+
+type forbiddenError struct {
+	role clsessions.UserRole
+}
+
+func (e forbiddenError) Error() string {
+	return fmt.Sprintf("Forbidden: requires the %s role", e.role)
+}
+
+func (e forbiddenError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": "FORBIDDEN",
+	}
+}
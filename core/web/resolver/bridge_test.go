@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -195,6 +196,7 @@ func Test_CreateBridge(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createBridge"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleEdit, "createBridge"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -283,6 +285,7 @@ func Test_UpdateBridge(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateBridge"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleEdit, "updateBridge"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -404,6 +407,7 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteBridge"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleEdit, "deleteBridge"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/config"
@@ -114,3 +115,84 @@ func NewConfigPayload(cfg config.EnvPrinter) *ConfigPayloadResolver {
 func (r *ConfigPayloadResolver) Items() []*ConfigItemResolver {
 	return NewConfig(r.cfg).Items()
 }
+
+type ConfigSourceResolver struct {
+	sv config.SourcedValue
+}
+
+func (r *ConfigSourceResolver) Source() string {
+	return string(r.sv.Source)
+}
+
+func (r *ConfigSourceResolver) Value() string {
+	return r.sv.Value
+}
+
+type ConfigProvenanceResolver struct {
+	p config.Provenance
+}
+
+func NewConfigProvenances(ps []config.Provenance) []*ConfigProvenanceResolver {
+	var resolvers []*ConfigProvenanceResolver
+	for _, p := range ps {
+		resolvers = append(resolvers, &ConfigProvenanceResolver{p: p})
+	}
+	return resolvers
+}
+
+func (r *ConfigProvenanceResolver) Key() string {
+	return r.p.Name
+}
+
+func (r *ConfigProvenanceResolver) Value() string {
+	return r.p.Value
+}
+
+func (r *ConfigProvenanceResolver) Source() string {
+	return string(r.p.Source)
+}
+
+func (r *ConfigProvenanceResolver) Shadowed() []*ConfigSourceResolver {
+	resolvers := []*ConfigSourceResolver{}
+	for _, sv := range r.p.Shadowed {
+		resolvers = append(resolvers, &ConfigSourceResolver{sv: sv})
+	}
+	return resolvers
+}
+
+type ChainConfigProvenanceResolver struct {
+	chainID string
+	ps      []config.Provenance
+}
+
+func NewChainConfigProvenance(chainID string, ps []config.Provenance) *ChainConfigProvenanceResolver {
+	return &ChainConfigProvenanceResolver{chainID: chainID, ps: ps}
+}
+
+func (r *ChainConfigProvenanceResolver) ChainID() graphql.ID {
+	return graphql.ID(r.chainID)
+}
+
+func (r *ChainConfigProvenanceResolver) Items() []*ConfigProvenanceResolver {
+	return NewConfigProvenances(r.ps)
+}
+
+type ConfigProvenancePayloadResolver struct {
+	cfg    config.GeneralConfig
+	chains []*ChainConfigProvenanceResolver
+}
+
+func NewConfigProvenancePayload(cfg config.GeneralConfig, chains []*ChainConfigProvenanceResolver) *ConfigProvenancePayloadResolver {
+	if chains == nil {
+		chains = []*ChainConfigProvenanceResolver{}
+	}
+	return &ConfigProvenancePayloadResolver{cfg: cfg, chains: chains}
+}
+
+func (r *ConfigProvenancePayloadResolver) Global() []*ConfigProvenanceResolver {
+	return NewConfigProvenances(config.GlobalProvenance(r.cfg))
+}
+
+func (r *ConfigProvenancePayloadResolver) Chains() []*ChainConfigProvenanceResolver {
+	return r.chains
+}
@@ -6,7 +6,11 @@ import (
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestResolver_Config(t *testing.T) {
@@ -252,6 +256,14 @@ func TestResolver_Config(t *testing.T) {
         "key": "KEEPER_BASE_FEE_BUFFER_PERCENT",
         "value": "20"
       },
+      {
+        "key": "KEEPER_CHECK_UPKEEP_BATCH_SIZE",
+        "value": "50"
+      },
+      {
+        "key": "KEEPER_EXECUTION_QUEUE_SIZE",
+        "value": "10"
+      },
       {
         "key": "KEEPER_MAXIMUM_GRACE_PERIOD",
         "value": "0"
@@ -445,3 +457,67 @@ func TestResolver_Config(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_ConfigProvenance(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetConfigProvenance {
+			configProvenance {
+				chains {
+					chainID
+					items {
+						key
+						value
+						source
+						shadowed {
+							source
+							value
+						}
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "configProvenance"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(configtest.NewTestGeneralConfig(t))
+				f.Mocks.scfg.On("Provenance").Return([]config.Provenance{
+					config.NewProvenance("ETH_FINALITY_DEPTH", "20", []config.SourcedValue{
+						{Source: config.SourceEnv, Value: "20"},
+						{Source: config.SourceDB, Value: "30"},
+						{Source: config.SourceChainDefault, Value: "50"},
+					}),
+				})
+				f.Mocks.chain.On("ID").Return(utils.NewBigI(42).ToInt())
+				f.Mocks.chain.On("Config").Return(f.Mocks.scfg)
+				f.Mocks.chainSet.On("Chains").Return([]evm.Chain{f.Mocks.chain})
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+			},
+			query: query,
+			result: `
+				{
+					"configProvenance": {
+						"chains": [{
+							"chainID": "42",
+							"items": [{
+								"key": "ETH_FINALITY_DEPTH",
+								"value": "20",
+								"source": "env",
+								"shadowed": [
+									{"source": "db", "value": "30"},
+									{"source": "chain default", "value": "50"}
+								]
+							}]
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
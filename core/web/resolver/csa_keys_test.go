@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
 type expectedKey struct {
@@ -105,6 +106,7 @@ func Test_CreateCSAKey(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: query}, "createCSAKey"),
+		forbiddenTestCase(GQLTestCase{query: query}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "createCSAKey"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -174,6 +176,7 @@ func Test_DeleteCSAKey(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "deleteCSAKey"),
+		forbiddenTestCase(GQLTestCase{query: query, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "deleteCSAKey"),
 		{
 			name:          "success",
 			authenticated: true,
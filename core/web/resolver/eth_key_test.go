@@ -4,15 +4,18 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -487,3 +490,105 @@ func TestResolver_ETHKeys(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_ETHKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetETHKeyUsage {
+			ethKeyUsage(address: "0x5431F5F973781809D18643b87B44921b11355d81", since: "2021-01-01T00:00:00Z", until: "2021-01-08T00:00:00Z") {
+				... on EthKeyUsage {
+					address
+					jobs {
+						id
+						name
+						type
+					}
+					transactions {
+						id
+						jobID
+						gasUsed
+						effectiveGasPrice
+						fee
+					}
+					spend {
+						jobID
+						txCount
+						fee
+					}
+					totalFee
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	address := ethkey.EIP55Address("0x5431F5F973781809D18643b87B44921b11355d81")
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(7 * 24 * time.Hour)
+	jobID := int32(1)
+	gasUsed := uint64(21000)
+	usage := job.EthKeyUsage{
+		Address: address.Address(),
+		Since:   since,
+		Until:   until,
+		Jobs:    []job.KeyBinding{{JobID: jobID, JobName: null.StringFrom("feed"), JobType: job.OffchainReporting}},
+		Transactions: []job.EthKeyTx{{
+			EthTxID:           2,
+			JobID:             &jobID,
+			GasUsed:           &gasUsed,
+			EffectiveGasPrice: utils.NewBigI(2),
+			Fee:               assets.NewEth(42000),
+		}},
+		Spend: []job.EthKeySpend{{JobID: &jobID, TxCount: 1, GasUsed: gasUsed, Fee: assets.NewEthValue(42000)}},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "ethKeyUsage"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.ethKs.On("Get", address.Hex()).Return(ethkey.KeyV2{Address: address}, nil)
+				f.Mocks.keystore.On("Eth").Return(f.Mocks.ethKs)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+				f.Mocks.cfg.On("OCRTransmitterAddress").Return(address, nil)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.Mocks.jobORM.On("FindEthKeyUsage", address.Address(), (*big.Int)(nil), since, until, true).Return(usage, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query: query,
+			result: `
+				{
+					"ethKeyUsage": {
+						"address": "0x5431F5F973781809D18643b87B44921b11355d81",
+						"jobs": [{"id": "1", "name": "feed", "type": "offchainreporting"}],
+						"transactions": [{"id": "2", "jobID": "1", "gasUsed": "21000", "effectiveGasPrice": "2", "fee": "42000"}],
+						"spend": [{"jobID": "1", "txCount": 1, "fee": "42000"}],
+						"totalFee": "42000"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.ethKs.On("Get", address.Hex()).Return(ethkey.KeyV2{}, errors.New("unable to find eth key"))
+				f.Mocks.keystore.On("Eth").Return(f.Mocks.ethKs)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+			},
+			query: query,
+			result: `
+				{
+					"ethKeyUsage": {
+						"message": "unable to find eth key",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -0,0 +1,233 @@
+package resolver
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+type KeyJobResolver struct {
+	binding job.KeyBinding
+}
+
+func NewKeyJobs(bindings []job.KeyBinding) []*KeyJobResolver {
+	resolvers := []*KeyJobResolver{}
+	for _, b := range bindings {
+		resolvers = append(resolvers, &KeyJobResolver{binding: b})
+	}
+	return resolvers
+}
+
+func (r *KeyJobResolver) ID() graphql.ID {
+	return int32GQLID(r.binding.JobID)
+}
+
+func (r *KeyJobResolver) Name() *string {
+	return r.binding.JobName.Ptr()
+}
+
+func (r *KeyJobResolver) Type() string {
+	return string(r.binding.JobType)
+}
+
+func (r *KeyJobResolver) ExternalJobID() string {
+	return r.binding.ExternalJobID.String()
+}
+
+type EthKeyTransactionResolver struct {
+	tx job.EthKeyTx
+}
+
+func (r *EthKeyTransactionResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.tx.EthTxID))
+}
+
+func (r *EthKeyTransactionResolver) Hash() string {
+	return r.tx.Hash.Hex()
+}
+
+func (r *EthKeyTransactionResolver) Nonce() *string {
+	if r.tx.Nonce == nil {
+		return nil
+	}
+	value := stringutils.FromInt64(*r.tx.Nonce)
+	return &value
+}
+
+func (r *EthKeyTransactionResolver) To() string {
+	return r.tx.ToAddress.Hex()
+}
+
+func (r *EthKeyTransactionResolver) Value() string {
+	return r.tx.Value.ToInt().String()
+}
+
+func (r *EthKeyTransactionResolver) State() string {
+	return r.tx.State
+}
+
+func (r *EthKeyTransactionResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.tx.EVMChainID.String())
+}
+
+func (r *EthKeyTransactionResolver) JobID() *graphql.ID {
+	if r.tx.JobID == nil {
+		return nil
+	}
+	id := int32GQLID(*r.tx.JobID)
+	return &id
+}
+
+func (r *EthKeyTransactionResolver) JobName() *string {
+	return r.tx.JobName.Ptr()
+}
+
+func (r *EthKeyTransactionResolver) Subject() *string {
+	if !r.tx.Subject.Valid {
+		return nil
+	}
+	subject := r.tx.Subject.UUID.String()
+	return &subject
+}
+
+func (r *EthKeyTransactionResolver) BroadcastAt() *graphql.Time {
+	if r.tx.BroadcastAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.tx.BroadcastAt}
+}
+
+func (r *EthKeyTransactionResolver) GasUsed() *string {
+	if r.tx.GasUsed == nil {
+		return nil
+	}
+	value := strconv.FormatUint(*r.tx.GasUsed, 10)
+	return &value
+}
+
+func (r *EthKeyTransactionResolver) EffectiveGasPrice() *string {
+	if r.tx.EffectiveGasPrice == nil {
+		return nil
+	}
+	value := r.tx.EffectiveGasPrice.String()
+	return &value
+}
+
+func (r *EthKeyTransactionResolver) Fee() *string {
+	if r.tx.Fee == nil {
+		return nil
+	}
+	value := r.tx.Fee.ToInt().String()
+	return &value
+}
+
+func (r *EthKeyTransactionResolver) FeeEstimated() bool {
+	return r.tx.FeeEstimated
+}
+
+type EthKeySpendResolver struct {
+	spend job.EthKeySpend
+}
+
+func (r *EthKeySpendResolver) JobID() *graphql.ID {
+	if r.spend.JobID == nil {
+		return nil
+	}
+	id := int32GQLID(*r.spend.JobID)
+	return &id
+}
+
+func (r *EthKeySpendResolver) JobName() *string {
+	return r.spend.JobName.Ptr()
+}
+
+func (r *EthKeySpendResolver) TxCount() int32 {
+	return int32(r.spend.TxCount)
+}
+
+func (r *EthKeySpendResolver) GasUsed() string {
+	return strconv.FormatUint(r.spend.GasUsed, 10)
+}
+
+func (r *EthKeySpendResolver) Fee() string {
+	return r.spend.Fee.ToInt().String()
+}
+
+type EthKeyUsageResolver struct {
+	usage job.EthKeyUsage
+}
+
+func NewEthKeyUsage(usage job.EthKeyUsage) *EthKeyUsageResolver {
+	return &EthKeyUsageResolver{usage: usage}
+}
+
+func (r *EthKeyUsageResolver) Address() string {
+	return ethkey.EIP55AddressFromAddress(r.usage.Address).Hex()
+}
+
+func (r *EthKeyUsageResolver) Since() graphql.Time {
+	return graphql.Time{Time: r.usage.Since}
+}
+
+func (r *EthKeyUsageResolver) Until() graphql.Time {
+	return graphql.Time{Time: r.usage.Until}
+}
+
+func (r *EthKeyUsageResolver) Jobs() []*KeyJobResolver {
+	return NewKeyJobs(r.usage.Jobs)
+}
+
+func (r *EthKeyUsageResolver) Transactions() []*EthKeyTransactionResolver {
+	resolvers := []*EthKeyTransactionResolver{}
+	for _, tx := range r.usage.Transactions {
+		resolvers = append(resolvers, &EthKeyTransactionResolver{tx: tx})
+	}
+	return resolvers
+}
+
+func (r *EthKeyUsageResolver) Spend() []*EthKeySpendResolver {
+	resolvers := []*EthKeySpendResolver{}
+	for _, s := range r.usage.Spend {
+		resolvers = append(resolvers, &EthKeySpendResolver{spend: s})
+	}
+	return resolvers
+}
+
+func (r *EthKeyUsageResolver) TotalFee() string {
+	total := new(big.Int)
+	for _, s := range r.usage.Spend {
+		total.Add(total, s.Fee.ToInt())
+	}
+	return total.String()
+}
+
+// -- EthKeyUsage Query --
+
+type EthKeyUsagePayloadResolver struct {
+	usage job.EthKeyUsage
+	NotFoundErrorUnionType
+}
+
+func NewEthKeyUsagePayload(usage job.EthKeyUsage, err error) *EthKeyUsagePayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: err.Error(), isExpectedErrorFn: func(err error) bool {
+			return true
+		}}
+	}
+
+	return &EthKeyUsagePayloadResolver{usage: usage, NotFoundErrorUnionType: e}
+}
+
+func (r *EthKeyUsagePayloadResolver) ToEthKeyUsage() (*EthKeyUsageResolver, bool) {
+	if r.err == nil {
+		return NewEthKeyUsage(r.usage), true
+	}
+	return nil, false
+}
@@ -11,6 +11,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 )
 
@@ -261,6 +262,7 @@ func Test_CreateFeedsManager(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createFeedsManager"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "createFeedsManager"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -446,6 +448,7 @@ func Test_UpdateFeedsManager(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateFeedsManager"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "updateFeedsManager"),
 		{
 			name:          "success",
 			authenticated: true,
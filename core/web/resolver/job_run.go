@@ -2,11 +2,13 @@ package resolver
 
 import (
 	"context"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -195,6 +197,129 @@ func (r *JobRunsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
 
+// JobRunsFilterInput selects job runs
+type JobRunsFilterInput struct {
+	JobID         *graphql.ID
+	Status        *[]JobRunStatus
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+	FailedTask    *string
+	ErrorContains *string
+	MinDuration   *string
+}
+
+func (i *JobRunsFilterInput) toPipelineRunsFilter() (filter job.PipelineRunsFilter, err error) {
+	if i.JobID != nil {
+		id, err := stringutils.ToInt32(string(*i.JobID))
+		if err != nil {
+			return filter, err
+		}
+		filter.JobID = &id
+	}
+	if i.Status != nil {
+		for _, status := range *i.Status {
+			switch status {
+			case JobRunStatusRunning:
+				filter.States = append(filter.States, pipeline.RunStatusRunning)
+			case JobRunStatusSuspended:
+				filter.States = append(filter.States, pipeline.RunStatusSuspended)
+			case JobRunStatusErrored:
+				filter.States = append(filter.States, pipeline.RunStatusErrored)
+			case JobRunStatusCompleted:
+				filter.States = append(filter.States, pipeline.RunStatusCompleted)
+			default:
+				return filter, errors.Errorf("cannot filter by status %s", status)
+			}
+		}
+	}
+	if i.CreatedAfter != nil {
+		filter.CreatedAfter = &i.CreatedAfter.Time
+	}
+	if i.CreatedBefore != nil {
+		filter.CreatedBefore = &i.CreatedBefore.Time
+	}
+	if i.FailedTask != nil {
+		filter.FailedTaskDotID = *i.FailedTask
+	}
+	if i.ErrorContains != nil {
+		filter.ErrorContains = *i.ErrorContains
+	}
+	if i.MinDuration != nil {
+		d, err := time.ParseDuration(*i.MinDuration)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid minDuration")
+		}
+		filter.MinDuration = &d
+	}
+	return filter, nil
+}
+
+// -- JobRunTaskStats Query --
+
+// JobRunTaskStatsResolver resolves the aggregated runs of one task of a job
+type JobRunTaskStatsResolver struct {
+	stats job.PipelineTaskRunStats
+}
+
+func NewJobRunTaskStats(stats job.PipelineTaskRunStats) *JobRunTaskStatsResolver {
+	return &JobRunTaskStatsResolver{stats: stats}
+}
+
+func (r *JobRunTaskStatsResolver) JobID() graphql.ID {
+	return int32GQLID(r.stats.JobID)
+}
+
+func (r *JobRunTaskStatsResolver) DotID() string {
+	return r.stats.DotID
+}
+
+func (r *JobRunTaskStatsResolver) Type() string {
+	return string(r.stats.Type)
+}
+
+func (r *JobRunTaskStatsResolver) Count() int32 {
+	return int32(r.stats.Count)
+}
+
+func (r *JobRunTaskStatsResolver) Errors() int32 {
+	return int32(r.stats.Errors)
+}
+
+// LatencyP50 resolves the median latency in milliseconds.
+func (r *JobRunTaskStatsResolver) LatencyP50() float64 {
+	return durationMillis(r.stats.LatencyP50)
+}
+
+// LatencyP90 resolves the 90th percentile latency in milliseconds.
+func (r *JobRunTaskStatsResolver) LatencyP90() float64 {
+	return durationMillis(r.stats.LatencyP90)
+}
+
+// LatencyP99 resolves the 99th percentile latency in milliseconds.
+func (r *JobRunTaskStatsResolver) LatencyP99() float64 {
+	return durationMillis(r.stats.LatencyP99)
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+type JobRunTaskStatsPayloadResolver struct {
+	stats []job.PipelineTaskRunStats
+}
+
+func NewJobRunTaskStatsPayload(stats []job.PipelineTaskRunStats) *JobRunTaskStatsPayloadResolver {
+	return &JobRunTaskStatsPayloadResolver{stats: stats}
+}
+
+func (r *JobRunTaskStatsPayloadResolver) Results() []*JobRunTaskStatsResolver {
+	resolvers := []*JobRunTaskStatsResolver{}
+	for _, s := range r.stats {
+		resolvers = append(resolvers, NewJobRunTaskStats(s))
+	}
+	return resolvers
+}
+
 // -- RunJob Mutation --
 
 type RunJobPayloadResolver struct {
@@ -3,6 +3,7 @@ package resolver
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
@@ -82,6 +83,140 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestQuery_FilteredJobRuns(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobsRuns($filter: JobRunsFilter) {
+			jobRuns(filter: $filter) {
+				results {
+					id
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	jobID := int32(1)
+	createdAfter := time.Date(2022, 4, 20, 0, 0, 0, 0, time.UTC)
+	minDuration := 5 * time.Second
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FilterPipelineRuns", job.PipelineRunsFilter{
+					JobID:           &jobID,
+					States:          []pipeline.RunStatus{pipeline.RunStatusErrored},
+					CreatedAfter:    &createdAfter,
+					FailedTaskDotID: "ds",
+					ErrorContains:   "429",
+					MinDuration:     &minDuration,
+				}, PageDefaultOffset, PageDefaultLimit).Return([]pipeline.Run{
+					{
+						ID: int64(200),
+					},
+				}, 1, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{
+					"jobID":         "1",
+					"status":        []interface{}{"ERRORED"},
+					"createdAfter":  "2022-04-20T00:00:00Z",
+					"failedTask":    "ds",
+					"errorContains": "429",
+					"minDuration":   "5s",
+				},
+			},
+			result: `
+				{
+					"jobRuns": {
+						"results": [{
+							"id": "200"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestQuery_JobRunTaskStats(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobRunTaskStats($filter: JobRunsFilter) {
+			jobRunTaskStats(filter: $filter) {
+				results {
+					jobID
+					dotID
+					type
+					count
+					errors
+					latencyP50
+					latencyP90
+					latencyP99
+				}
+			}
+		}`
+
+	jobID := int32(1)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "jobRunTaskStats"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("PipelineTaskRunStats", job.PipelineRunsFilter{JobID: &jobID}).Return([]job.PipelineTaskRunStats{
+					{
+						JobID:      1,
+						DotID:      "ds",
+						Type:       pipeline.TaskTypeBridge,
+						Count:      100,
+						Errors:     7,
+						LatencyP50: 120 * time.Millisecond,
+						LatencyP90: 900 * time.Millisecond,
+						LatencyP99: 2500 * time.Millisecond,
+					},
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{
+					"jobID": "1",
+				},
+			},
+			result: `
+				{
+					"jobRunTaskStats": {
+						"results": [{
+							"jobID": "1",
+							"dotID": "ds",
+							"type": "bridge",
+							"count": 100,
+							"errors": 7,
+							"latencyP50": 120,
+							"latencyP90": 900,
+							"latencyP99": 2500
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_JobRun(t *testing.T) {
 	t.Parallel()
 
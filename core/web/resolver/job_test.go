@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -303,6 +304,7 @@ func TestResolver_CreateJob(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createJob"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleEdit, "createJob"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -404,6 +406,7 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteJob"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleEdit, "deleteJob"),
 		{
 			name:          "success",
 			authenticated: true,
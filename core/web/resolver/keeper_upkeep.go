@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+type KeeperUpkeepResolver struct {
+	upkeep job.KeeperUpkeep
+}
+
+func NewKeeperUpkeep(upkeep job.KeeperUpkeep) *KeeperUpkeepResolver {
+	return &KeeperUpkeepResolver{upkeep: upkeep}
+}
+
+func NewKeeperUpkeeps(upkeeps []job.KeeperUpkeep) []*KeeperUpkeepResolver {
+	resolvers := []*KeeperUpkeepResolver{}
+	for _, upkeep := range upkeeps {
+		resolvers = append(resolvers, NewKeeperUpkeep(upkeep))
+	}
+	return resolvers
+}
+
+func (r *KeeperUpkeepResolver) ID() graphql.ID {
+	return int32GQLID(r.upkeep.ID)
+}
+
+func (r *KeeperUpkeepResolver) JobID() graphql.ID {
+	return int32GQLID(r.upkeep.JobID)
+}
+
+func (r *KeeperUpkeepResolver) JobName() *string {
+	return r.upkeep.JobName.Ptr()
+}
+
+func (r *KeeperUpkeepResolver) EVMChainID() *graphql.ID {
+	if r.upkeep.EVMChainID == nil {
+		return nil
+	}
+	id := graphql.ID(r.upkeep.EVMChainID.String())
+	return &id
+}
+
+func (r *KeeperUpkeepResolver) RegistryAddress() string {
+	return r.upkeep.RegistryAddress.Hex()
+}
+
+func (r *KeeperUpkeepResolver) UpkeepID() string {
+	return r.upkeep.UpkeepID.String()
+}
+
+func (r *KeeperUpkeepResolver) ExecuteGas() int32 {
+	return int32(r.upkeep.ExecuteGas)
+}
+
+func (r *KeeperUpkeepResolver) LastRunBlockHeight() int32 {
+	return int32(r.upkeep.LastRunBlockHeight)
+}
+
+func (r *KeeperUpkeepResolver) ChecksCount() int32 {
+	return int32(r.upkeep.ChecksCount)
+}
+
+func (r *KeeperUpkeepResolver) EligibleCount() int32 {
+	return int32(r.upkeep.EligibleCount)
+}
+
+func (r *KeeperUpkeepResolver) PerformsCount() int32 {
+	return int32(r.upkeep.PerformsCount)
+}
+
+func (r *KeeperUpkeepResolver) PerformsSucceededCount() int32 {
+	return int32(r.upkeep.PerformsSucceededCount)
+}
+
+func (r *KeeperUpkeepResolver) SuccessRate() float64 {
+	return r.upkeep.SuccessRate()
+}
+
+func (r *KeeperUpkeepResolver) AverageGasUsed() int32 {
+	return int32(r.upkeep.AverageGasUsed())
+}
+
+func (r *KeeperUpkeepResolver) LinkPayment() string {
+	return r.upkeep.LinkPayment.String()
+}
+
+// -- KeeperUpkeeps Query --
+
+type KeeperUpkeepsPayloadResolver struct {
+	upkeeps []job.KeeperUpkeep
+	total   int32
+}
+
+func NewKeeperUpkeepsPayload(upkeeps []job.KeeperUpkeep, total int32) *KeeperUpkeepsPayloadResolver {
+	return &KeeperUpkeepsPayloadResolver{upkeeps: upkeeps, total: total}
+}
+
+func (r *KeeperUpkeepsPayloadResolver) Results() []*KeeperUpkeepResolver {
+	return NewKeeperUpkeeps(r.upkeeps)
+}
+
+func (r *KeeperUpkeepsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
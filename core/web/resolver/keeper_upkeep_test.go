@@ -0,0 +1,113 @@
+package resolver
+
+import (
+	"testing"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestResolver_KeeperUpkeeps(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetKeeperUpkeeps($jobID: ID) {
+				keeperUpkeeps(jobID: $jobID) {
+					results {
+						id
+						jobID
+						jobName
+						evmChainID
+						registryAddress
+						upkeepID
+						executeGas
+						checksCount
+						eligibleCount
+						performsCount
+						performsSucceededCount
+						successRate
+						averageGasUsed
+						linkPayment
+					}
+					metadata {
+						total
+					}
+				}
+			}`
+		jobID   = int32(1)
+		upkeeps = []job.KeeperUpkeep{{
+			ID:              2,
+			JobID:           jobID,
+			JobName:         null.StringFrom("keeper"),
+			EVMChainID:      utils.NewBigI(42),
+			RegistryAddress: ethkey.EIP55Address("0x5431F5F973781809D18643b87B44921b11355d81"),
+			UpkeepID:        *utils.NewBigI(3),
+			ExecuteGas:      100_000,
+			UpkeepStats: job.UpkeepStats{
+				ChecksCount:            10,
+				EligibleCount:          4,
+				PerformsCount:          4,
+				PerformsSucceededCount: 3,
+				PerformGasUsed:         150_000,
+				PerformGasUsedCount:    3,
+				LinkPayment:            *assets.NewLinkFromJuels(1000),
+			},
+		}}
+		result = `
+			{
+				"keeperUpkeeps": {
+					"results": [{
+						"id": "2",
+						"jobID": "1",
+						"jobName": "keeper",
+						"evmChainID": "42",
+						"registryAddress": "0x5431F5F973781809D18643b87B44921b11355d81",
+						"upkeepID": "3",
+						"executeGas": 100000,
+						"checksCount": 10,
+						"eligibleCount": 4,
+						"performsCount": 4,
+						"performsSucceededCount": 3,
+						"successRate": 0.75,
+						"averageGasUsed": 50000,
+						"linkPayment": "1000"
+					}],
+					"metadata": {
+						"total": 1
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "keeperUpkeeps"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindKeeperUpkeeps", PageDefaultOffset, PageDefaultLimit, (*int32)(nil)).Return(upkeeps, 1, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:  query,
+			result: result,
+		},
+		{
+			name:          "success filtered by job",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindKeeperUpkeeps", PageDefaultOffset, PageDefaultLimit, &jobID).Return(upkeeps, 1, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     query,
+			variables: map[string]interface{}{"jobID": "1"},
+			result:    result,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
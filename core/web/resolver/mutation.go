@@ -57,7 +57,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -100,7 +100,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +119,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -147,7 +147,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -206,7 +206,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -270,7 +270,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -325,7 +325,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -340,7 +340,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -358,7 +358,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) CreateNode(ctx context.Context, args struct {
 	Input *types.NewNode
 }) (*CreateNodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -379,7 +379,7 @@ func (r *Resolver) CreateNode(ctx context.Context, args struct {
 func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteNodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -415,7 +415,7 @@ func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -450,7 +450,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -465,7 +465,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -486,7 +486,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -501,7 +501,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -521,7 +521,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -557,7 +557,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -589,7 +589,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -622,7 +622,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -655,7 +655,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 func (r *Resolver) SetServicesLogLevels(ctx context.Context, args struct {
 	Input struct{ Config LogLevelConfig }
 }) (*SetServicesLogLevelsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -722,7 +722,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 		return nil, errors.New("couldn't retrieve user session")
 	}
 
-	dbUser, err := r.App.SessionORM().FindUser()
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -748,7 +748,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -764,7 +764,12 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	dbUser, err := r.App.SessionORM().FindUser()
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("couldn't retrieve user session")
+	}
+
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -790,7 +795,12 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	dbUser, err := r.App.SessionORM().FindUser()
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("couldn't retrieve user session")
+	}
+
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -818,7 +828,7 @@ func (r *Resolver) CreateChain(ctx context.Context, args struct {
 		KeySpecificConfigs []*KeySpecificChainConfigInput
 	}
 }) (*CreateChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -866,7 +876,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 		KeySpecificConfigs []*KeySpecificChainConfigInput
 	}
 }) (*UpdateChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -913,7 +923,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 func (r *Resolver) DeleteChain(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -945,7 +955,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1008,7 +1018,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1041,7 +1051,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1074,7 +1084,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1103,7 +1113,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1128,7 +1138,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1146,7 +1156,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"sort"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
@@ -13,9 +15,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
@@ -315,6 +319,37 @@ func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	return NewJobProposalPayload(jp, err), nil
 }
 
+// KeeperUpkeeps retrieves a paginated list of the upkeeps of keeper jobs,
+// optionally filtered by job, along with their stats.
+func (r *Resolver) KeeperUpkeeps(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+	JobID  *graphql.ID
+}) (*KeeperUpkeepsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	var jobID *int32
+	if args.JobID != nil {
+		id, err := stringutils.ToInt32(string(*args.JobID))
+		if err != nil {
+			return nil, err
+		}
+		jobID = &id
+	}
+
+	upkeeps, count, err := r.App.JobORM().FindKeeperUpkeeps(offset, limit, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeeperUpkeepsPayload(upkeeps, int32(count)), nil
+}
+
 // Nodes retrieves a paginated list of nodes.
 func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
@@ -338,6 +373,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
+	Filter *JobRunsFilterInput
 }) (*JobRunsPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -346,7 +382,18 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	limit := pageLimit(args.Limit)
 	offset := pageOffset(args.Offset)
 
-	runs, count, err := r.App.JobORM().PipelineRuns(nil, offset, limit)
+	var runs []pipeline.Run
+	var count int
+	var err error
+	if args.Filter == nil {
+		runs, count, err = r.App.JobORM().PipelineRuns(nil, offset, limit)
+	} else {
+		var filter job.PipelineRunsFilter
+		if filter, err = args.Filter.toPipelineRunsFilter(); err != nil {
+			return nil, err
+		}
+		runs, count, err = r.App.JobORM().FilterPipelineRuns(filter, offset, limit)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -354,6 +401,29 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	return NewJobRunsPayload(runs, int32(count), r.App), nil
 }
 
+func (r *Resolver) JobRunTaskStats(ctx context.Context, args struct {
+	Filter *JobRunsFilterInput
+}) (*JobRunTaskStatsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var filter job.PipelineRunsFilter
+	if args.Filter != nil {
+		var err error
+		if filter, err = args.Filter.toPipelineRunsFilter(); err != nil {
+			return nil, err
+		}
+	}
+
+	stats, err := r.App.JobORM().PipelineTaskRunStats(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobRunTaskStatsPayload(stats), nil
+}
+
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
@@ -435,6 +505,58 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 	return NewETHKeysPayload(ethKeys), nil
 }
 
+// ETHKeyUsage retrieves the jobs which use an ETH key, and the transactions
+// it broadcast between since and until, which default to the 7 days before
+// now.
+func (r *Resolver) ETHKeyUsage(ctx context.Context, args struct {
+	Address    string
+	Since      *graphql.Time
+	Until      *graphql.Time
+	EVMChainID *graphql.ID
+}) (*EthKeyUsagePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(args.Address) {
+		return nil, errors.Errorf("invalid address: %s", args.Address)
+	}
+	address := common.HexToAddress(args.Address)
+	if _, err := r.App.GetKeyStore().Eth().Get(address.Hex()); err != nil {
+		if errors.Is(err, keystore.ErrLocked) {
+			return nil, err
+		}
+		return NewEthKeyUsagePayload(job.EthKeyUsage{}, err), nil
+	}
+
+	until := time.Now()
+	if args.Until != nil {
+		until = args.Until.Time
+	}
+	since := until.Add(-7 * 24 * time.Hour)
+	if args.Since != nil {
+		since = args.Since.Time
+	}
+	var chainID *big.Int
+	if args.EVMChainID != nil {
+		var ok bool
+		if chainID, ok = new(big.Int).SetString(string(*args.EVMChainID), 10); !ok {
+			return nil, errors.Errorf("invalid evmChainID: %s", *args.EVMChainID)
+		}
+	}
+
+	// OCR jobs without a transmitter address use OCR_TRANSMITTER_ADDRESS
+	transmitter, err := r.App.GetConfig().OCRTransmitterAddress()
+	isDefaultTransmitter := err == nil && transmitter.Address() == address
+
+	usage, err := r.App.JobORM().FindEthKeyUsage(address, chainID, since, until, isDefaultTransmitter)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEthKeyUsagePayload(usage, nil), nil
+}
+
 // Config retrieves the Chainlink node's configuration
 func (r *Resolver) Config(ctx context.Context) (*ConfigPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -447,6 +569,23 @@ func (r *Resolver) Config(ctx context.Context) (*ConfigPayloadResolver, error) {
 	return NewConfigPayload(printer.EnvPrinter), nil
 }
 
+// ConfigProvenance retrieves the effective global and per chain settings,
+// along with the source of each.
+func (r *Resolver) ConfigProvenance(ctx context.Context) (*ConfigProvenancePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var chains []*ChainConfigProvenanceResolver
+	if cs := r.App.GetChains().EVM; cs != nil {
+		for _, chain := range cs.Chains() {
+			chains = append(chains, NewChainConfigProvenance(chain.ID().String(), chain.Config().Provenance()))
+		}
+	}
+
+	return NewConfigProvenancePayload(r.App.GetConfig(), chains), nil
+}
+
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
@@ -2,6 +2,7 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -161,11 +162,20 @@ func (f *gqlTestFramework) Timestamp() time.Time {
 	return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 }
 
-// injectAuthenticatedUser injects a session into the request context
+// injectAuthenticatedUser injects a session of an admin user into the request
+// context
 func (f *gqlTestFramework) injectAuthenticatedUser() {
 	f.t.Helper()
 
-	user := clsessions.User{Email: "gqltester@chain.link"}
+	f.injectAuthenticatedUserWithRole(clsessions.UserRoleAdmin)
+}
+
+// injectAuthenticatedUserWithRole injects a session of a user with the given
+// role into the request context
+func (f *gqlTestFramework) injectAuthenticatedUserWithRole(role clsessions.UserRole) {
+	f.t.Helper()
+
+	user := clsessions.User{Email: "gqltester@chain.link", Role: role}
 
 	f.Ctx = auth.SetGQLAuthenticatedSession(f.Ctx, user, "gqltesterSession")
 }
@@ -240,3 +250,28 @@ func unauthorizedTestCase(tc GQLTestCase, paths ...interface{}) GQLTestCase {
 
 	return tc
 }
+
+// forbiddenTestCase generates a test case from another test case, in which
+// the user does not have the required role.
+//
+// The paths will be the query/mutation definition name
+func forbiddenTestCase(tc GQLTestCase, userRole, requiredRole clsessions.UserRole, paths ...interface{}) GQLTestCase {
+	tc.name = fmt.Sprintf("forbidden for %s role", userRole)
+	tc.authenticated = false
+	tc.before = func(f *gqlTestFramework) {
+		f.injectAuthenticatedUserWithRole(userRole)
+	}
+	tc.result = "null"
+	tc.errors = []*gqlerrors.QueryError{
+		{
+			ResolverError: forbiddenError{role: requiredRole},
+			Path:          paths,
+			Message:       fmt.Sprintf("Forbidden: requires the %s role", requiredRole),
+			Extensions: map[string]interface{}{
+				"code": "FORBIDDEN",
+			},
+		},
+	}
+
+	return tc
+}
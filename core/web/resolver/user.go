@@ -1,6 +1,8 @@
 package resolver
 
 import (
+	"strings"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -32,6 +34,11 @@ func (r *UserResolver) Email() string {
 	return r.user.Email
 }
 
+// Role resolves the user's role
+func (r *UserResolver) Role() string {
+	return strings.ToUpper(string(r.user.Role))
+}
+
 // CreatedAt resolves the user's creation date
 func (r *UserResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.user.CreatedAt}
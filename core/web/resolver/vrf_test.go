@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
 func TestResolver_GetVRFKey(t *testing.T) {
@@ -194,6 +195,7 @@ func TestResolver_CreateVRFKey(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation}, "createVRFKey"),
+		forbiddenTestCase(GQLTestCase{query: mutation}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "createVRFKey"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -257,6 +259,7 @@ func TestResolver_DeleteVRFKey(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteVRFKey"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "deleteVRFKey"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -85,6 +85,7 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 
 	api.POST("/query",
 		auth.AuthenticateGQL(app.SessionORM()),
+		withClientIP,
 		loader.Middleware(app),
 		graphqlHandler(app),
 	)
@@ -97,7 +98,9 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 	rootSchema := schema.MustGetRootSchema()
 
 	// Disable introspection and set a max query depth in production.
-	schemaOpts := []graphql.SchemaOpt{}
+	schemaOpts := []graphql.SchemaOpt{
+		graphql.Tracer(auditTracer{app: app}),
+	}
 	if !app.GetConfig().Dev() {
 		schemaOpts = append(schemaOpts,
 			graphql.MaxDepth(10),
@@ -209,7 +212,7 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
-	auth := r.Group("/", auth.Authenticate(app.SessionORM(), auth.AuthenticateBySession))
+	auth := r.Group("/", auth.Authenticate(app.SessionORM(), auth.AuthenticateBySession), auditRequests(app))
 	auth.DELETE("/sessions", sc.Destroy)
 }
 
@@ -229,12 +232,22 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	authv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auditRequests(app))
 	{
 		uc := UserController{app}
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.GET("/user/tokens", uc.IndexAPITokens)
+		authv2.POST("/user/tokens", uc.CreateNamedAPIToken)
+		authv2.DELETE("/user/tokens/:name", uc.RevokeAPIToken)
+		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
+		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
+		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
+		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+
+		ac := AuditController{app}
+		authv2.GET("/audit", auth.RequiresAdminRole(paginatedRequest(ac.Index)))
 
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
@@ -242,33 +255,42 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", eia.Create)
-		authv2.DELETE("/external_initiators/:Name", eia.Destroy)
+		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", bt.Create)
+		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
-		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
 
 		ets := EVMTransfersController{app}
-		authv2.POST("/transfers", ets.Create)
-		authv2.POST("/transfers/evm", ets.Create)
+		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
+		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
 		tts := TerraTransfersController{app}
-		authv2.POST("/transfers/terra", tts.Create)
+		authv2.POST("/transfers/terra", auth.RequiresAdminRole(tts.Create))
 		sts := SolanaTransfersController{app}
-		authv2.POST("/transfers/solana", sts.Create)
+		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
-		authv2.PATCH("/config", cc.Patch)
+		authv2.GET("/config/provenance", cc.Provenance)
+		authv2.PATCH("/config", auth.RequiresAdminRole(cc.Patch))
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
+
+		bc := BundleController{app}
+		authv2.POST("/bundle/export", auth.RequiresAdminRole(bc.Export))
+		authv2.POST("/bundle/import", auth.RequiresAdminRole(bc.Import))
+
+		dc := DiagnosticsController{app}
+		authv2.GET("/diagnostics", dc.Show)
 
 		feedsMgrCtlr := FeedsManagerController{app}
 		authv2.GET("/feeds_managers", feedsMgrCtlr.List)
-		authv2.POST("/feeds_managers", feedsMgrCtlr.Create)
+		authv2.POST("/feeds_managers", auth.RequiresAdminRole(feedsMgrCtlr.Create))
 		authv2.GET("/feeds_managers/:id", feedsMgrCtlr.Show)
-		authv2.PATCH("/feeds_managers/:id", feedsMgrCtlr.Update)
+		authv2.PATCH("/feeds_managers/:id", auth.RequiresAdminRole(feedsMgrCtlr.Update))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -281,134 +303,163 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		authv2.POST("/replay_from_block/:number", auth.RequiresAdminRole(rc.ReplayFromBlock))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", csakc.Create)
-		authv2.POST("/keys/csa/import", csakc.Import)
-		authv2.POST("/keys/csa/export/:ID", csakc.Export)
+		authv2.POST("/keys/csa", auth.RequiresAdminRole(csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
 
 		ekc := ETHKeysController{app}
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", ekc.Create)
-		authv2.PUT("/keys/eth/:keyID", ekc.Update)
-		authv2.DELETE("/keys/eth/:keyID", ekc.Delete)
-		authv2.POST("/keys/eth/import", ekc.Import)
-		authv2.POST("/keys/eth/export/:address", ekc.Export)
+		authv2.POST("/keys/eth", auth.RequiresAdminRole(ekc.Create))
+		authv2.PUT("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Update))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
+		authv2.GET("/keys/eth/:keyID/policy", ekc.ShowPolicy)
+		authv2.GET("/keys/eth/:keyID/usage", ekc.Usage)
+		authv2.PUT("/keys/eth/:keyID/policy", auth.RequiresAdminRole(ekc.UpdatePolicy))
+		authv2.DELETE("/keys/eth/:keyID/policy", auth.RequiresAdminRole(ekc.DeletePolicy))
+		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.GET("/keys/eth/hd", ekc.ShowHDWallet)
+		authv2.POST("/keys/eth/hd", auth.RequiresAdminRole(ekc.CreateHDWallet))
+		authv2.POST("/keys/eth/hd/recover", auth.RequiresAdminRole(ekc.RecoverHDKeys))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", ocrkc.Create)
-		authv2.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
-		authv2.POST("/keys/ocr/import", ocrkc.Import)
-		authv2.POST("/keys/ocr/export/:ID", ocrkc.Export)
+		authv2.POST("/keys/ocr", auth.RequiresAdminRole(ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
+		authv2.GET("/keys/ocr/:keyID/usage", ocrkc.Usage)
+		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
 		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", ocr2kc.Create)
-		authv2.DELETE("/keys/ocr2/:keyID", ocr2kc.Delete)
-		authv2.POST("/keys/ocr2/import", ocr2kc.Import)
-		authv2.POST("/keys/ocr2/export/:ID", ocr2kc.Export)
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresAdminRole(ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
+		authv2.GET("/keys/ocr2/:keyID/usage", ocr2kc.Usage)
+		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", p2pkc.Create)
-		authv2.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
-		authv2.POST("/keys/p2p/import", p2pkc.Import)
-		authv2.POST("/keys/p2p/export/:ID", p2pkc.Export)
+		authv2.POST("/keys/p2p", auth.RequiresAdminRole(p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
+		authv2.GET("/keys/p2p/:keyID/usage", p2pkc.Usage)
+		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
 
 		solkc := SolanaKeysController{app}
 		authv2.GET("/keys/solana", solkc.Index)
-		authv2.POST("/keys/solana", solkc.Create)
-		authv2.DELETE("/keys/solana/:keyID", solkc.Delete)
-		authv2.POST("/keys/solana/import", solkc.Import)
-		authv2.POST("/keys/solana/export/:ID", solkc.Export)
+		authv2.POST("/keys/solana", auth.RequiresAdminRole(solkc.Create))
+		authv2.DELETE("/keys/solana/:keyID", auth.RequiresAdminRole(solkc.Delete))
+		authv2.POST("/keys/solana/import", auth.RequiresAdminRole(solkc.Import))
+		authv2.POST("/keys/solana/export/:ID", auth.RequiresAdminRole(solkc.Export))
 
 		terkc := TerraKeysController{app}
 		authv2.GET("/keys/terra", terkc.Index)
-		authv2.POST("/keys/terra", terkc.Create)
-		authv2.DELETE("/keys/terra/:keyID", terkc.Delete)
-		authv2.POST("/keys/terra/import", terkc.Import)
-		authv2.POST("/keys/terra/export/:ID", terkc.Export)
+		authv2.POST("/keys/terra", auth.RequiresAdminRole(terkc.Create))
+		authv2.DELETE("/keys/terra/:keyID", auth.RequiresAdminRole(terkc.Delete))
+		authv2.POST("/keys/terra/import", auth.RequiresAdminRole(terkc.Import))
+		authv2.POST("/keys/terra/export/:ID", auth.RequiresAdminRole(terkc.Export))
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", vrfkc.Create)
-		authv2.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
-		authv2.POST("/keys/vrf/import", vrfkc.Import)
-		authv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
+		authv2.POST("/keys/vrf", auth.RequiresAdminRole(vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+
+		krc := KeyringController{app}
+		authv2.PATCH("/keys/password", auth.RequiresAdminRole(krc.ChangePassword))
+		authv2.POST("/keys/backup", auth.RequiresAdminRole(krc.Backup))
+		authv2.POST("/keys/restore", auth.RequiresAdminRole(krc.Restore))
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", jc.Create)
-		authv2.DELETE("/jobs/:ID", jc.Delete)
+		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+
+		ec := EventsController{app}
+		authv2.GET("/events", ec.Stream)
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/stats", prc.Stats)
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/pipeline/runs/:runID/replay", auth.RequiresEditRole(prc.Replay))
 
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", lgc.Patch)
+		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
 
 		echc := EVMChainsController{app}
 		authv2.GET("/chains/evm", paginatedRequest(echc.Index))
-		authv2.POST("/chains/evm", echc.Create)
+		authv2.POST("/chains/evm", auth.RequiresAdminRole(echc.Create))
 		authv2.GET("/chains/evm/:ID", echc.Show)
-		authv2.PATCH("/chains/evm/:ID", echc.Update)
-		authv2.DELETE("/chains/evm/:ID", echc.Delete)
+		authv2.PATCH("/chains/evm/:ID", auth.RequiresAdminRole(echc.Update))
+		authv2.DELETE("/chains/evm/:ID", auth.RequiresAdminRole(echc.Delete))
 
 		schc := SolanaChainsController{app}
 		authv2.GET("/chains/solana", paginatedRequest(schc.Index))
-		authv2.POST("/chains/solana", schc.Create)
+		authv2.POST("/chains/solana", auth.RequiresAdminRole(schc.Create))
 		authv2.GET("/chains/solana/:ID", schc.Show)
-		authv2.PATCH("/chains/solana/:ID", schc.Update)
-		authv2.DELETE("/chains/solana/:ID", schc.Delete)
+		authv2.PATCH("/chains/solana/:ID", auth.RequiresAdminRole(schc.Update))
+		authv2.DELETE("/chains/solana/:ID", auth.RequiresAdminRole(schc.Delete))
 
 		tchc := TerraChainsController{app}
 		authv2.GET("/chains/terra", paginatedRequest(tchc.Index))
-		authv2.POST("/chains/terra", tchc.Create)
+		authv2.POST("/chains/terra", auth.RequiresAdminRole(tchc.Create))
 		authv2.GET("/chains/terra/:ID", tchc.Show)
-		authv2.PATCH("/chains/terra/:ID", tchc.Update)
-		authv2.DELETE("/chains/terra/:ID", tchc.Delete)
+		authv2.PATCH("/chains/terra/:ID", auth.RequiresAdminRole(tchc.Update))
+		authv2.DELETE("/chains/terra/:ID", auth.RequiresAdminRole(tchc.Delete))
 
 		enc := EVMNodesController{app}
 		// TODO still EVM only https://app.shortcut.com/chainlinklabs/story/26276/multi-chain-type-ui-node-chain-configuration
 		authv2.GET("/nodes", paginatedRequest(enc.Index))
-		authv2.POST("/nodes", enc.Create)
-		authv2.DELETE("/nodes/:ID", enc.Delete)
+		authv2.POST("/nodes", auth.RequiresAdminRole(enc.Create))
+		authv2.DELETE("/nodes/:ID", auth.RequiresAdminRole(enc.Delete))
 
 		authv2.GET("/nodes/evm", paginatedRequest(enc.Index))
 		authv2.GET("/chains/evm/:ID/nodes", paginatedRequest(enc.Index))
-		authv2.POST("/nodes/evm", enc.Create)
-		authv2.DELETE("/nodes/evm/:ID", enc.Delete)
+		authv2.POST("/nodes/evm", auth.RequiresAdminRole(enc.Create))
+		authv2.DELETE("/nodes/evm/:ID", auth.RequiresAdminRole(enc.Delete))
+
+		kuc := KeeperUpkeepsController{app}
+		authv2.GET("/keeper/upkeeps", paginatedRequest(kuc.Index))
+		authv2.GET("/keeper/simulate", kuc.Simulate)
+
+		vrc := VRFRequestsController{app}
+		authv2.GET("/vrf/requests", paginatedRequest(vrc.Index))
+		authv2.GET("/vrf/lookup", vrc.Lookup)
+		authv2.GET("/vrf/subscriptions/:subID", vrc.Subscription)
 
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders", efc.Create)
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", efc.Delete)
+		authv2.POST("/nodes/evm/forwarders", auth.RequiresAdminRole(efc.Create))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresAdminRole(efc.Delete))
 
 		snc := SolanaNodesController{app}
 		authv2.GET("/nodes/solana", paginatedRequest(snc.Index))
 		authv2.GET("/chains/solana/:ID/nodes", paginatedRequest(snc.Index))
-		authv2.POST("/nodes/solana", snc.Create)
-		authv2.DELETE("/nodes/solana/:ID", snc.Delete)
+		authv2.POST("/nodes/solana", auth.RequiresAdminRole(snc.Create))
+		authv2.DELETE("/nodes/solana/:ID", auth.RequiresAdminRole(snc.Delete))
 
 		tnc := TerraNodesController{app}
 		authv2.GET("/nodes/terra", paginatedRequest(tnc.Index))
 		authv2.GET("/chains/terra/:ID/nodes", paginatedRequest(tnc.Index))
-		authv2.POST("/nodes/terra", tnc.Create)
-		authv2.DELETE("/nodes/terra/:ID", tnc.Delete)
+		authv2.POST("/nodes/terra", auth.RequiresAdminRole(tnc.Create))
+		authv2.DELETE("/nodes/terra/:ID", auth.RequiresAdminRole(tnc.Delete))
 
 		build_info := BuildInfoController{app}
 		authv2.GET("/build_info", build_info.Show)
@@ -422,9 +473,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auditRequests(app))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", prc.Create)
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresEditRole(prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -617,6 +668,7 @@ var blacklist = map[string]struct{}{
 	"oldpassword":          {},
 	"current_password":     {},
 	"new_account_password": {},
+	"mnemonic":             {},
 }
 
 func isBlacklisted(k string) bool {
@@ -75,7 +75,8 @@ func TestSessionsController_Create(t *testing.T) {
 }
 
 func mustInsertSession(t *testing.T, q pg.Q, session *sessions.Session) {
-	err := q.GetNamed(`INSERT INTO sessions (id, last_used, created_at) VALUES (:id, :last_used, :created_at) RETURNING *`, session, session)
+	session.Email = cltest.APIEmail
+	err := q.GetNamed(`INSERT INTO sessions (id, email, last_used, created_at) VALUES (:id, :email, :last_used, :created_at) RETURNING *`, session, session)
 	require.NoError(t, err)
 }
 
@@ -1,12 +1,16 @@
 package web
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
@@ -16,7 +20,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
-// UserController manages the current Session's User.
+// UserController manages the current Session's User, and the other users for
+// admins.
 type UserController struct {
 	App chainlink.Application
 }
@@ -28,6 +33,120 @@ type UpdatePasswordRequest struct {
 	NewPassword string `json:"newPassword"`
 }
 
+// CreateUserRequest defines the request to create a new user.
+type CreateUserRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+}
+
+// UpdateUserRoleRequest defines the request to change the role of a user.
+type UpdateUserRoleRequest struct {
+	Email   string `json:"email"`
+	NewRole string `json:"newRole"`
+}
+
+// Index lists all users.
+// Example:
+//  "GET <application>/users"
+func (c *UserController) Index(ctx *gin.Context) {
+	users, err := c.App.SessionORM().ListUsers()
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResources(users), "users")
+}
+
+// Create creates a new user with the given role.
+// Example:
+//  "POST <application>/users"
+func (c *UserController) Create(ctx *gin.Context) {
+	var request CreateUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.GetUserRole(request.Role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+	user, err := clsession.NewUser(request.Email, request.Password, role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if _, err = c.App.SessionORM().FindUser(user.Email); err == nil {
+		jsonAPIError(ctx, http.StatusConflict, fmt.Errorf("user %s already exists", user.Email))
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if err = c.App.SessionORM().CreateUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, presenters.NewUserResource(user), "user", http.StatusCreated)
+}
+
+// UpdateRole changes the role of a user. Users cannot change their own role.
+// Example:
+//  "PATCH <application>/users"
+func (c *UserController) UpdateRole(ctx *gin.Context) {
+	var request UpdateUserRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.GetUserRole(request.NewRole)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if c.isCurrentUser(ctx, request.Email) {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, errors.New("you cannot change your own role"))
+		return
+	}
+	user, err := c.App.SessionORM().UpdateRole(request.Email, role)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, fmt.Errorf("user %s not found", request.Email))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// Delete deletes a user and ends their sessions. Users cannot delete
+// themselves.
+// Example:
+//  "DELETE <application>/users/:email"
+func (c *UserController) Delete(ctx *gin.Context) {
+	email := ctx.Param("email")
+	if c.isCurrentUser(ctx, email) {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, errors.New("you cannot delete yourself"))
+		return
+	}
+	err := c.App.SessionORM().DeleteUser(email)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, fmt.Errorf("user %s not found", email))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "user", http.StatusNoContent)
+}
+
 // UpdatePassword changes the password for the current User.
 func (c *UserController) UpdatePassword(ctx *gin.Context) {
 	var request UpdatePasswordRequest
@@ -36,7 +155,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -61,7 +180,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -87,7 +206,7 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -105,6 +224,104 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	}
 }
 
+// IndexAPITokens lists the named API tokens of the current user.
+func (c *UserController) IndexAPITokens(ctx *gin.Context) {
+	user, err := c.getCurrentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		return
+	}
+	tokens, err := c.App.SessionORM().ListAPITokens(user.Email)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewAPITokenResources(tokens), "api_token")
+}
+
+// CreateNamedAPIToken creates a named API token for the current user, limited
+// by scopes and an optional expiry. The user may have any number of them.
+func (c *UserController) CreateNamedAPIToken(ctx *gin.Context) {
+	var request clsession.CreateAPITokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := c.getCurrentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, errors.New("API tokens must have a name"))
+		return
+	}
+	scopes, err := clsession.ParseTokenScopes(request.Scopes)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var expiresAt null.Time
+	if request.ExpiresIn != "" {
+		expiresIn, err := time.ParseDuration(request.ExpiresIn)
+		if err != nil || expiresIn <= 0 {
+			jsonAPIError(ctx, http.StatusUnprocessableEntity, fmt.Errorf("invalid expiresIn %q, must be a positive duration such as 720h", request.ExpiresIn))
+			return
+		}
+		expiresAt = null.TimeFrom(time.Now().Add(expiresIn))
+	}
+
+	apiToken, token, err := c.App.SessionORM().CreateAPIToken(user.Email, name, scopes, expiresAt)
+	if errors.Is(err, clsession.ErrAPITokenNameTaken) {
+		jsonAPIError(ctx, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, presenters.NewCreatedAPITokenResource(apiToken, token), "api_token", http.StatusCreated)
+}
+
+// RevokeAPIToken deletes a named API token of the current user.
+func (c *UserController) RevokeAPIToken(ctx *gin.Context) {
+	user, err := c.getCurrentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		return
+	}
+	err = c.App.SessionORM().RevokeAPIToken(user.Email, ctx.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.New("API token not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "api_token", http.StatusNoContent)
+}
+
+func (c *UserController) isCurrentUser(ctx *gin.Context, email string) bool {
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	return ok && strings.EqualFold(user.Email, email)
+}
+
+func (c *UserController) getCurrentUser(ctx *gin.Context) (clsession.User, error) {
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		return clsession.User{}, errors.New("unable to get authenticated user")
+	}
+	return c.App.SessionORM().FindUser(user.Email)
+}
+
 func (c *UserController) getCurrentSessionID(ctx *gin.Context) (string, error) {
 	session := sessions.Default(ctx)
 	sessionID, ok := session.Get(webauth.SessionIDKey).(string)
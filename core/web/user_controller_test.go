@@ -11,6 +11,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -138,3 +141,179 @@ func TestUserController_DeleteAPIKey_unauthorized(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
+
+func TestUserController_ManageUsers(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+	email := "new-user@chain.link"
+
+	// Create
+	req, err := json.Marshal(web.CreateUserRequest{Email: email, Password: cltest.Password, Role: "view"})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/users", bytes.NewBuffer(req))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	resp, cleanup = client.Post("/v2/users", bytes.NewBuffer(req))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	// Update role
+	req, err = json.Marshal(web.UpdateUserRoleRequest{Email: email, NewRole: "edit"})
+	require.NoError(t, err)
+	resp, cleanup = client.Patch("/v2/users", bytes.NewBuffer(req))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	user, err := app.SessionORM().FindUser(email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, user.Role)
+
+	req, err = json.Marshal(web.UpdateUserRoleRequest{Email: cltest.APIEmail, NewRole: "view"})
+	require.NoError(t, err)
+	resp, cleanup = client.Patch("/v2/users", bytes.NewBuffer(req))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	// Index
+	resp, cleanup = client.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var users []presenters.UserResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &users))
+	roles := map[string]sessions.UserRole{}
+	for _, u := range users {
+		roles[u.Email] = u.Role
+	}
+	assert.Equal(t, sessions.UserRoleAdmin, roles[cltest.APIEmail])
+	assert.Equal(t, sessions.UserRoleEdit, roles[email])
+
+	// Delete
+	resp, cleanup = client.Delete("/v2/users/" + email)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	_, err = app.SessionORM().FindUser(email)
+	require.Error(t, err)
+
+	resp, cleanup = client.Delete("/v2/users/" + email)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestUserController_RequiresRole(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	viewer := app.NewHTTPClientForUser(cltest.APIEmailViewOnly)
+	editor := app.NewHTTPClientForUser(cltest.APIEmailEdit)
+
+	resp, cleanup := viewer.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = editor.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = viewer.Post("/v2/bridge_types", bytes.NewBufferString(`{}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = viewer.Get("/v2/bridge_types")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+}
+
+func TestUserController_NamedAPITokens(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+
+	tokenRequest := func(method, path string, token *presenters.APITokenResource) *http.Response {
+		req, err := http.NewRequest(method, app.Server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(webauth.APIKey, token.AccessKey)
+		req.Header.Set(webauth.APISecret, token.Secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	// Create
+	req, err := json.Marshal(sessions.CreateAPITokenRequest{
+		Password:  cltest.Password,
+		Name:      "ci",
+		Scopes:    []string{"read-only", "jobs:write"},
+		ExpiresIn: "24h",
+	})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var token presenters.APITokenResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &token))
+	assert.Equal(t, "ci", token.Name)
+	assert.NotEmpty(t, token.AccessKey)
+	assert.NotEmpty(t, token.Secret)
+	assert.Equal(t, []string{"*:read", "jobs:write"}, token.Scopes)
+	assert.True(t, token.ExpiresAt.Valid)
+
+	for _, tc := range []struct {
+		name       string
+		request    sessions.CreateAPITokenRequest
+		wantStatus int
+	}{
+		{"duplicate name", sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "ci"}, http.StatusConflict},
+		{"incorrect password", sessions.CreateAPITokenRequest{Password: "wrong", Name: "other"}, http.StatusUnauthorized},
+		{"missing name", sessions.CreateAPITokenRequest{Password: cltest.Password}, http.StatusUnprocessableEntity},
+		{"invalid scope", sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "other", Scopes: []string{"everything"}}, http.StatusUnprocessableEntity},
+		{"invalid expiry", sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "other", ExpiresIn: "-1h"}, http.StatusUnprocessableEntity},
+	} {
+		req, err = json.Marshal(tc.request)
+		require.NoError(t, err)
+		resp, cleanup = client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+		t.Cleanup(cleanup)
+		assert.Equal(t, tc.wantStatus, resp.StatusCode, tc.name)
+	}
+
+	// List
+	resp, cleanup = client.Get("/v2/user/tokens")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, token.AccessKey, tokens[0].AccessKey)
+	assert.Empty(t, tokens[0].Secret)
+
+	// Use
+	assert.Equal(t, http.StatusOK, tokenRequest(http.MethodGet, "/v2/bridge_types", &token).StatusCode)
+	assert.Equal(t, http.StatusForbidden, tokenRequest(http.MethodPost, "/v2/keys/eth", &token).StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, tokenRequest(http.MethodPost, "/v2/jobs", &token).StatusCode)
+
+	stored, err := app.SessionORM().ListAPITokens(cltest.APIEmail)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	assert.True(t, stored[0].LastUsedAt.Valid)
+
+	// Revoke
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	assert.Equal(t, http.StatusUnauthorized, tokenRequest(http.MethodGet, "/v2/bridge_types", &token).StatusCode)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -0,0 +1,139 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// VRFRequestsController lists the VRF v2 requests received by VRF jobs.
+type VRFRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists the VRF v2 requests, most recent first. The jobID, subID and
+// state query parameters filter them, by job, subscription and state.
+// Example:
+// "GET <application>/vrf/requests?jobID=1&subID=5&state=insufficient_balance"
+func (vrc *VRFRequestsController) Index(c *gin.Context, size, page, offset int) {
+	var filter vrf.RequestsV2Filter
+	if id := c.Query("jobID"); id != "" {
+		jb := job.Job{}
+		if err := jb.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.JobID = &jb.ID
+	}
+	if id := c.Query("subID"); id != "" {
+		subID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid subID: %q", id))
+			return
+		}
+		filter.SubID = &subID
+	}
+	if s := c.Query("state"); s != "" {
+		state, err := vrf.ParseRequestState(s)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.State = &state
+	}
+
+	orm := vrf.NewORM(vrc.App.GetSqlxDB(), vrc.App.GetLogger(), vrc.App.GetConfig())
+	reqs, count, err := orm.RequestsV2(filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "vrf_requests", size, page, presenters.NewVRFRequestV2Resources(reqs), count, err)
+}
+
+// Lookup finds the VRF v1 and v2 requests with a request ID, or sent in a
+// transaction, and tells whether they were fulfilled, and if not why. The
+// request ID is a decimal number, or a 0x prefixed hex number.
+// Example:
+// "GET <application>/vrf/lookup?requestID=123"
+// "GET <application>/vrf/lookup?txHash=0x..."
+func (vrc *VRFRequestsController) Lookup(c *gin.Context) {
+	var reqID *big.Int
+	if id := c.Query("requestID"); id != "" {
+		var err error
+		if reqID, err = vrf.ParseRequestID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	var txHash *common.Hash
+	if h := c.Query("txHash"); h != "" {
+		b, err := hexutil.Decode(h)
+		if err != nil || len(b) != common.HashLength {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid txHash: %q", h))
+			return
+		}
+		hash := common.BytesToHash(b)
+		txHash = &hash
+	}
+	if reqID == nil && txHash == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("requestID or txHash is required"))
+		return
+	}
+
+	inspector := vrf.NewInspector(vrc.App.GetSqlxDB(), vrc.App.GetChains().EVM, vrc.App.GetLogger(), vrc.App.GetConfig())
+	lookups, err := inspector.LookupRequests(c.Request.Context(), reqID, txHash)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(lookups) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no VRF request found"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewVRFRequestLookupResources(lookups), "vrf_request_lookups")
+}
+
+// Subscription shows a VRF v2 subscription: its balance, the LINK reserved
+// for its pending fulfillments, and its pending requests.
+// Example:
+// "GET <application>/vrf/subscriptions/5?evmChainID=1"
+func (vrc *VRFRequestsController) Subscription(c *gin.Context) {
+	subID, err := strconv.ParseUint(c.Param("subID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid subID: %q", c.Param("subID")))
+		return
+	}
+	chain, err := getChain(vrc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	inspector := vrf.NewInspector(vrc.App.GetSqlxDB(), vrc.App.GetChains().EVM, vrc.App.GetLogger(), vrc.App.GetConfig())
+	sub, err := inspector.Subscription(c.Request.Context(), chain.ID(), subID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewVRFSubscriptionResource(sub), "vrf_subscriptions")
+}
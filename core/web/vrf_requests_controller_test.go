@@ -0,0 +1,197 @@
+package web_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func Test_VRFRequestsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+	require.NoError(t, err)
+	require.NoError(t, app.JobORM().CreateJob(&jb))
+
+	orm := vrf.NewORM(app.GetSqlxDB(), logger.TestLogger(t), app.Config)
+	for i, subID := range []uint64{5, 5, 6} {
+		req := vrf.NewRequestV2(jb.ID, &cltest.FixtureChainID, &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId:        big.NewInt(int64(i + 1)),
+			SubId:            subID,
+			CallbackGasLimit: 100_000,
+			NumWords:         1,
+			Sender:           testutils.NewAddress(),
+			Raw: types.Log{
+				Topics: []common.Hash{testutils.Random32Byte()},
+				Data:   []byte{1},
+				TxHash: testutils.Random32Byte(),
+			},
+		}, 10)
+		require.NoError(t, orm.UpsertRequestV2(&req))
+	}
+	require.NoError(t, orm.UpdateRequestsStateV2(jb.ID, []*big.Int{big.NewInt(2)}, vrf.RequestStateInsufficientBalance, "balance too low"))
+
+	t.Run("all requests", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/vrf/requests")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body := cltest.ParseResponseBody(t, resp)
+		metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+		require.NoError(t, err)
+		assert.Equal(t, 3, metaCount)
+
+		var links jsonapi.Links
+		var resources []presenters.VRFRequestV2Resource
+		require.NoError(t, web.ParsePaginatedResponse(body, &resources, &links))
+		require.Len(t, resources, 3)
+		assert.Equal(t, "3", resources[0].RequestID.String())
+		assert.Equal(t, jb.ID, resources[0].JobID)
+		assert.Equal(t, vrf.RequestStateWaitingForConfirmations, resources[0].State)
+	})
+
+	t.Run("filtered requests", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/vrf/requests?jobID=%d&subID=5&state=insufficient_balance", jb.ID))
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var links jsonapi.Links
+		var resources []presenters.VRFRequestV2Resource
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links))
+		require.Len(t, resources, 1)
+		assert.Equal(t, "2", resources[0].RequestID.String())
+		assert.Equal(t, uint64(5), resources[0].SubID)
+		require.NotNil(t, resources[0].Error)
+		assert.Equal(t, "balance too low", *resources[0].Error)
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		for _, query := range []string{"jobID=abc", "subID=-1", "state=pending"} {
+			resp, cleanup := client.Get("/v2/vrf/requests?" + query)
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, query)
+		}
+	})
+}
+
+func Test_VRFRequestsController_LookupAndSubscription(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+	require.NoError(t, err)
+	require.NoError(t, app.JobORM().CreateJob(&jb))
+
+	orm := vrf.NewORM(app.GetSqlxDB(), logger.TestLogger(t), app.Config)
+	requestTxHash := common.Hash(testutils.Random32Byte())
+	req := vrf.NewRequestV2(jb.ID, &cltest.FixtureChainID, &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId:                   big.NewInt(7),
+		SubId:                       5,
+		MinimumRequestConfirmations: 3,
+		CallbackGasLimit:            100_000,
+		NumWords:                    1,
+		Sender:                      testutils.NewAddress(),
+		Raw: types.Log{
+			Topics:      []common.Hash{testutils.Random32Byte()},
+			Data:        []byte{1},
+			BlockNumber: 10,
+			TxHash:      requestTxHash,
+		},
+	}, 13)
+	require.NoError(t, orm.UpsertRequestV2(&req))
+	require.NoError(t, orm.UpdateRequestsStateV2(jb.ID, []*big.Int{big.NewInt(7)}, vrf.RequestStateSimulationReverted, "execution reverted: bad callback"))
+
+	t.Run("lookup by request ID", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/vrf/lookup?requestID=7")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var lookups []presenters.VRFRequestLookupResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &lookups))
+		require.Len(t, lookups, 1)
+		assert.Equal(t, 2, lookups[0].Version)
+		assert.Equal(t, jb.ID, lookups[0].JobID)
+		assert.Equal(t, "7", lookups[0].RequestID)
+		assert.Equal(t, requestTxHash, lookups[0].RequestTxHash)
+		assert.Equal(t, string(vrf.RequestStateSimulationReverted), lookups[0].Status)
+		require.NotNil(t, lookups[0].Error)
+		assert.Equal(t, "execution reverted: bad callback", *lookups[0].Error)
+		require.NotNil(t, lookups[0].ConfirmedAtBlock)
+		assert.Equal(t, uint64(13), *lookups[0].ConfirmedAtBlock)
+		assert.NotNil(t, lookups[0].Balance)
+		assert.Empty(t, lookups[0].Fulfillments)
+	})
+
+	t.Run("lookup by tx hash", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/vrf/lookup?txHash=" + requestTxHash.Hex())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var lookups []presenters.VRFRequestLookupResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &lookups))
+		require.Len(t, lookups, 1)
+		assert.Equal(t, "7", lookups[0].RequestID)
+	})
+
+	t.Run("lookup of an unknown request", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/vrf/lookup?requestID=99")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("invalid lookups", func(t *testing.T) {
+		for _, path := range []string{"/v2/vrf/lookup", "/v2/vrf/lookup?requestID=abc", "/v2/vrf/lookup?txHash=0x12"} {
+			resp, cleanup := client.Get(path)
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, path)
+		}
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/vrf/subscriptions/5")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var sub presenters.VRFSubscriptionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &sub))
+		assert.Equal(t, uint64(5), sub.SubID)
+		require.NotNil(t, sub.CoordinatorAddress)
+		assert.Equal(t, jb.VRFSpec.CoordinatorAddress.Address(), *sub.CoordinatorAddress)
+		require.NotNil(t, sub.ReservedLink)
+		assert.Equal(t, "0", sub.ReservedLink.String())
+		require.Len(t, sub.PendingRequests, 1)
+		assert.Equal(t, "7", sub.PendingRequests[0].RequestID.String())
+		assert.Empty(t, sub.PendingFulfillments)
+	})
+
+	t.Run("invalid subscriptions", func(t *testing.T) {
+		for _, path := range []string{"/v2/vrf/subscriptions/abc", "/v2/vrf/subscriptions/5?evmChainID=abc"} {
+			resp, cleanup := client.Get(path)
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, path)
+		}
+	})
+}
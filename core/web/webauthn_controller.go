@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	sqlxTypes "github.com/smartcontractkit/sqlx/types"
 )
@@ -31,9 +32,9 @@ func NewWebAuthnController(app chainlink.Application) WebAuthnController {
 
 func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
-	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
 		return
 	}
 
@@ -45,7 +46,7 @@ func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 
 	webAuthnConfig := c.App.GetWebAuthnConfiguration()
 
-	options, err := c.inProgressRegistrationsStore.BeginWebAuthnRegistration(user, uwas, webAuthnConfig)
+	options, err := c.inProgressRegistrationsStore.BeginWebAuthnRegistration(*user, uwas, webAuthnConfig)
 	if err != nil {
 		c.App.GetLogger().Errorf("error in BeginWebAuthnRegistration: %s", err)
 		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("internal Server Error"))
@@ -59,10 +60,9 @@ func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 
 func (c *WebAuthnController) FinishRegistration(ctx *gin.Context) {
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
-	if err != nil {
-		c.App.GetLogger().Errorf("error finding user: %s", err)
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
 		return
 	}
 
@@ -75,14 +75,14 @@ func (c *WebAuthnController) FinishRegistration(ctx *gin.Context) {
 
 	webAuthnConfig := c.App.GetWebAuthnConfiguration()
 
-	credential, err := c.inProgressRegistrationsStore.FinishWebAuthnRegistration(user, uwas, ctx.Request, webAuthnConfig)
+	credential, err := c.inProgressRegistrationsStore.FinishWebAuthnRegistration(*user, uwas, ctx.Request, webAuthnConfig)
 	if err != nil {
 		c.App.GetLogger().Errorf("error in FinishWebAuthnRegistration: %s", err)
 		jsonAPIError(ctx, http.StatusBadRequest, errors.New("registration was unsuccessful"))
 		return
 	}
 
-	if c.addCredentialToUser(user, credential) != nil {
+	if c.addCredentialToUser(*user, credential) != nil {
 		c.App.GetLogger().Errorf("Could not save WebAuthn credential to DB for user: %s", user.Email)
 		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("internal Server Error"))
 		return